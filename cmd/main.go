@@ -2,6 +2,8 @@ package main
 
 import (
 	"log"
+	// Embed the timezone database for recurrence expansion in minimal images.
+	_ "time/tzdata"
 
 	"github.com/rm-ryou/sample_todo_app/internal/api"
 	"github.com/rm-ryou/sample_todo_app/internal/config"
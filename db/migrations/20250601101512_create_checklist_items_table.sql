@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `checklist_items` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `todo_id` INT NOT NULL,
+  `text` VARCHAR(100) NOT NULL,
+  `checked` BOOLEAN NOT NULL DEFAULT false,
+  `position` INT NOT NULL DEFAULT 0,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_todo_id_position` (`todo_id`, `position`),
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `checklist_items`;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `todo_dependencies` (
+  `todo_id` INT NOT NULL,
+  `blocker_id` INT NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`todo_id`, `blocker_id`),
+  INDEX `idx_blocker_id` (`blocker_id`),
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`blocker_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `todo_dependencies`;
+-- +goose StatementEnd
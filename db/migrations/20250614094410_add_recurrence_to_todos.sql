@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  ADD COLUMN `recurrence_rule` VARCHAR(255) AFTER `due_date`,
+  ADD COLUMN `recurrence_timezone` VARCHAR(64) AFTER `recurrence_rule`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  DROP COLUMN `recurrence_rule`,
+  DROP COLUMN `recurrence_timezone`;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `reminders` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `todo_id` INT NOT NULL,
+  `remind_at` DATETIME,
+  `offset_minutes` INT,
+  `channel` VARCHAR(20) NOT NULL DEFAULT 'log',
+  `claimed_at` DATETIME,
+  `sent_at` DATETIME,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_todo_id` (`todo_id`),
+  INDEX `idx_sent_at_remind_at` (`sent_at`, `remind_at`),
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `reminders`;
+-- +goose StatementEnd
@@ -0,0 +1,73 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `statuses` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `room_id` INT NOT NULL,
+  `name` VARCHAR(30) NOT NULL,
+  `category` ENUM('todo', 'doing', 'done') NOT NULL,
+  `position` INT NOT NULL DEFAULT 0,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_room_id_position` (`room_id`, `position`),
+  FOREIGN KEY (`room_id`) REFERENCES rooms(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO `statuses` (`room_id`, `name`, `category`, `position`)
+  SELECT `id`, 'To Do', 'todo', 0 FROM `rooms`
+  UNION ALL SELECT `id`, 'In Progress', 'doing', 1 FROM `rooms`
+  UNION ALL SELECT `id`, 'Done', 'done', 2 FROM `rooms`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  ADD COLUMN `status_id` INT AFTER `title`,
+  ADD COLUMN `completed_at` DATETIME AFTER `due_date`;
+-- +goose StatementEnd
+
+-- Done todos have no better completion time than their last update.
+-- +goose StatementBegin
+UPDATE `todos` t
+  INNER JOIN `boards` b ON b.id = t.board_id
+  INNER JOIN `statuses` s ON s.room_id = b.room_id AND s.category = IF(t.done, 'done', 'todo')
+SET
+  t.status_id = s.id,
+  t.completed_at = IF(t.done, t.updated_at, NULL),
+  t.updated_at = t.updated_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  MODIFY COLUMN `status_id` INT NOT NULL,
+  ADD INDEX `idx_status_id` (`status_id`),
+  ADD CONSTRAINT `fk_todos_status_id` FOREIGN KEY (`status_id`) REFERENCES statuses(`id`) ON DELETE CASCADE,
+  DROP COLUMN `done`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  ADD COLUMN `done` BOOLEAN NOT NULL DEFAULT false AFTER `title`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE `todos` t
+  INNER JOIN `statuses` s ON s.id = t.status_id
+SET
+  t.done = (s.category = 'done'),
+  t.updated_at = t.updated_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  DROP FOREIGN KEY `fk_todos_status_id`,
+  DROP INDEX `idx_status_id`,
+  DROP COLUMN `status_id`,
+  DROP COLUMN `completed_at`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `statuses`;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  ADD COLUMN `rank` VARCHAR(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '' AFTER `priority`;
+-- +goose StatementEnd
+
+-- Existing todos keep their creation order; the trailing digit leaves room to insert before them.
+-- +goose StatementBegin
+UPDATE `todos` t
+  INNER JOIN (
+    SELECT `id`, ROW_NUMBER() OVER (PARTITION BY `board_id` ORDER BY `id`) AS rn FROM `todos`
+  ) o ON o.id = t.id
+SET
+  t.`rank` = CONCAT(LPAD(CONV(o.rn, 10, 36), 5, '0'), 'V'),
+  t.updated_at = t.updated_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  ALTER COLUMN `rank` DROP DEFAULT,
+  ADD INDEX `idx_board_id_rank` (`board_id`, `rank`);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  DROP INDEX `idx_board_id_rank`,
+  DROP COLUMN `rank`;
+-- +goose StatementEnd
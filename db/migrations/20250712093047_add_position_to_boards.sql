@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `boards`
+  ADD COLUMN `position` INT NOT NULL DEFAULT 0 AFTER `priority`,
+  ADD INDEX `idx_room_id_position` (`room_id`, `position`);
+-- +goose StatementEnd
+
+-- Existing boards keep their creation order within the room.
+-- +goose StatementBegin
+UPDATE `boards` b
+  INNER JOIN (
+    SELECT `id`, ROW_NUMBER() OVER (PARTITION BY `room_id` ORDER BY `id`) - 1 AS pos FROM `boards`
+  ) o ON o.id = b.id
+SET
+  b.position = o.pos,
+  b.updated_at = b.updated_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `boards`
+  DROP INDEX `idx_room_id_position`,
+  DROP COLUMN `position`;
+-- +goose StatementEnd
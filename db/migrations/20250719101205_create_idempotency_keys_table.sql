@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `idempotency_keys` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `user_id` VARCHAR(64) NOT NULL DEFAULT '',
+  `idempotency_key` VARCHAR(255) NOT NULL,
+  `request_hash` CHAR(64) NOT NULL,
+  `status_code` INT,
+  `response_headers` JSON,
+  `response_body` MEDIUMBLOB,
+  `expires_at` DATETIME NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_user_id_idempotency_key` (`user_id`, `idempotency_key`),
+  INDEX `idx_expires_at` (`expires_at`)
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `idempotency_keys`;
+-- +goose StatementEnd
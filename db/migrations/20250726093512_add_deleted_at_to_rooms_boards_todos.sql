@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `rooms`
+  ADD COLUMN `deleted_at` DATETIME AFTER `updated_at`,
+  ADD INDEX `idx_deleted_at` (`deleted_at`);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `boards`
+  ADD COLUMN `deleted_at` DATETIME AFTER `updated_at`,
+  ADD INDEX `idx_deleted_at` (`deleted_at`);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  ADD COLUMN `deleted_at` DATETIME AFTER `updated_at`,
+  ADD INDEX `idx_deleted_at` (`deleted_at`);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  DROP INDEX `idx_deleted_at`,
+  DROP COLUMN `deleted_at`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `boards`
+  DROP INDEX `idx_deleted_at`,
+  DROP COLUMN `deleted_at`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `rooms`
+  DROP INDEX `idx_deleted_at`,
+  DROP COLUMN `deleted_at`;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `rooms`
+  ADD COLUMN `archived_at` DATETIME AFTER `name`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `boards`
+  ADD COLUMN `archived_at` DATETIME AFTER `position`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `boards`
+  DROP COLUMN `archived_at`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `rooms`
+  DROP COLUMN `archived_at`;
+-- +goose StatementEnd
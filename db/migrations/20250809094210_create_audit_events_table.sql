@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `audit_events` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `actor` VARCHAR(64) NOT NULL DEFAULT '',
+  `entity_type` VARCHAR(20) NOT NULL,
+  `entity_id` INT NOT NULL,
+  `action` VARCHAR(10) NOT NULL,
+  `before` JSON,
+  `after` JSON,
+  `request_id` VARCHAR(64) NOT NULL DEFAULT '',
+  `ip` VARCHAR(45) NOT NULL DEFAULT '',
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_entity_type_entity_id` (`entity_type`, `entity_id`),
+  INDEX `idx_actor` (`actor`),
+  INDEX `idx_created_at` (`created_at`)
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `audit_events`;
+-- +goose StatementEnd
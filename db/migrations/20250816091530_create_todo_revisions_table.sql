@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `todo_revisions` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `todo_id` INT NOT NULL,
+  `version` INT NOT NULL,
+  `title` VARCHAR(50) NOT NULL,
+  `status_id` INT NOT NULL,
+  `priority` INT NOT NULL,
+  `due_date` DATETIME,
+  `recurrence_rule` VARCHAR(255),
+  `recurrence_timezone` VARCHAR(64),
+  `actor` VARCHAR(64) NOT NULL DEFAULT '',
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_todo_id_version` (`todo_id`, `version`),
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO `todo_revisions` (`todo_id`, `version`, `title`, `status_id`, `priority`, `due_date`, `recurrence_rule`, `recurrence_timezone`, `created_at`)
+SELECT `id`, 1, `title`, `status_id`, `priority`, `due_date`, `recurrence_rule`, `recurrence_timezone`, `updated_at`
+FROM `todos`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `todo_revisions`;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `activities` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `room_id` INT NOT NULL,
+  `board_id` INT,
+  `todo_id` INT,
+  `from_board_id` INT,
+  `kind` VARCHAR(20) NOT NULL,
+  `actor` VARCHAR(64) NOT NULL DEFAULT '',
+  `title` VARCHAR(50) NOT NULL DEFAULT '',
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_room_id_id` (`room_id`, `id`),
+  FOREIGN KEY (`room_id`) REFERENCES rooms(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `activities`;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `feed_tokens` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `actor` VARCHAR(64) NOT NULL,
+  `token_hash` CHAR(64) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_actor` (`actor`),
+  UNIQUE INDEX `uk_token_hash` (`token_hash`)
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `feed_tokens`;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `event_outbox` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `room_id` INT NOT NULL,
+  `type` VARCHAR(32) NOT NULL,
+  `payload` JSON NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`)
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `event_relays` (
+  `id` CHAR(32) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+  `last_event_id` BIGINT NOT NULL,
+  `seen_at` DATETIME NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `event_relays`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `event_outbox`;
+-- +goose StatementEnd
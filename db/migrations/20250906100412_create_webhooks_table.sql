@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `webhooks` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `room_id` INT NOT NULL,
+  `url` VARCHAR(2048) NOT NULL,
+  `secret` VARCHAR(255) NOT NULL,
+  `event_types` JSON NOT NULL,
+  `failure_count` INT NOT NULL DEFAULT 0,
+  `disabled_at` DATETIME,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`room_id`) REFERENCES rooms(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `webhook_deliveries` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `webhook_id` INT NOT NULL,
+  `event_id` BIGINT NOT NULL DEFAULT 0,
+  `event_type` VARCHAR(32) NOT NULL,
+  `payload` JSON NOT NULL,
+  `attempts` INT NOT NULL DEFAULT 0,
+  `status_code` INT,
+  `last_error` VARCHAR(255) NOT NULL DEFAULT '',
+  `next_attempt_at` DATETIME,
+  `claimed_at` DATETIME,
+  `delivered_at` DATETIME,
+  `failed_at` DATETIME,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_webhook_id_id` (`webhook_id`, `id`),
+  INDEX `idx_next_attempt_at` (`next_attempt_at`),
+  FOREIGN KEY (`webhook_id`) REFERENCES webhooks(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `webhook_deliveries`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `webhooks`;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `incoming_webhooks` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `board_id` INT NOT NULL,
+  `token_hash` CHAR(64) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_token_hash` (`token_hash`),
+  FOREIGN KEY (`board_id`) REFERENCES boards(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `incoming_webhook_keys` (
+  `incoming_webhook_id` INT NOT NULL,
+  `external_key` VARCHAR(255) NOT NULL,
+  `todo_id` INT NOT NULL,
+  PRIMARY KEY (`incoming_webhook_id`, `external_key`),
+  FOREIGN KEY (`incoming_webhook_id`) REFERENCES incoming_webhooks(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `incoming_webhook_keys`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `incoming_webhooks`;
+-- +goose StatementEnd
@@ -0,0 +1,80 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `change_sequence` (
+  `id` TINYINT NOT NULL,
+  `value` BIGINT NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO `change_sequence` (`id`, `value`) VALUES (1, 1);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `rooms`
+  ADD COLUMN `change_seq` BIGINT NOT NULL DEFAULT 0 AFTER `deleted_at`,
+  ADD INDEX `idx_change_seq` (`change_seq`);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `boards`
+  ADD COLUMN `change_seq` BIGINT NOT NULL DEFAULT 0 AFTER `deleted_at`,
+  ADD INDEX `idx_change_seq` (`change_seq`);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  ADD COLUMN `change_seq` BIGINT NOT NULL DEFAULT 0 AFTER `deleted_at`,
+  ADD INDEX `idx_change_seq` (`change_seq`);
+-- +goose StatementEnd
+
+-- Rows written before the sequence existed all count as its first change.
+-- +goose StatementBegin
+UPDATE `rooms` SET `change_seq` = 1;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE `boards` SET `change_seq` = 1;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE `todos` SET `change_seq` = 1;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `tombstones` (
+  `entity_type` VARCHAR(20) NOT NULL,
+  `entity_id` INT NOT NULL,
+  `change_seq` BIGINT NOT NULL,
+  PRIMARY KEY (`entity_type`, `entity_id`),
+  INDEX `idx_change_seq` (`change_seq`)
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `tombstones`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  DROP INDEX `idx_change_seq`,
+  DROP COLUMN `change_seq`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `boards`
+  DROP INDEX `idx_change_seq`,
+  DROP COLUMN `change_seq`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `rooms`
+  DROP INDEX `idx_change_seq`,
+  DROP COLUMN `change_seq`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `change_sequence`;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Deleting a status must not take its todos with it past the trash, the audit log and the
+-- outbox, so a status is only deleted once no todo is in it.
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  DROP FOREIGN KEY `fk_todos_status_id`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  ADD CONSTRAINT `fk_todos_status_id` FOREIGN KEY (`status_id`) REFERENCES statuses(`id`) ON DELETE RESTRICT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  DROP FOREIGN KEY `fk_todos_status_id`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  ADD CONSTRAINT `fk_todos_status_id` FOREIGN KEY (`status_id`) REFERENCES statuses(`id`) ON DELETE CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `status_transitions` (
+  `from_status_id` INT NOT NULL,
+  `to_status_id` INT NOT NULL,
+  PRIMARY KEY (`from_status_id`, `to_status_id`),
+  FOREIGN KEY (`from_status_id`) REFERENCES statuses(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`to_status_id`) REFERENCES statuses(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `status_transitions`;
+-- +goose StatementEnd
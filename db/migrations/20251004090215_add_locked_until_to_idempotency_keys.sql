@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `idempotency_keys`
+  ADD COLUMN `locked_until` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP AFTER `response_body`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `idempotency_keys`
+  DROP COLUMN `locked_until`;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `boards`
+  ADD COLUMN `deleted_by_cascade` BOOLEAN NOT NULL DEFAULT FALSE AFTER `deleted_at`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  ADD COLUMN `deleted_by_cascade` BOOLEAN NOT NULL DEFAULT FALSE AFTER `deleted_at`;
+-- +goose StatementEnd
+
+-- Rows trashed before the column existed went with their parent when they share its deleted_at.
+-- +goose StatementBegin
+UPDATE `boards` b
+    INNER JOIN `rooms` r ON r.`id` = b.`room_id`
+  SET b.`deleted_by_cascade` = TRUE
+  WHERE b.`deleted_at` = r.`deleted_at`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE `todos` t
+    INNER JOIN `boards` b ON b.`id` = t.`board_id`
+  SET t.`deleted_by_cascade` = TRUE
+  WHERE t.`deleted_at` = b.`deleted_at`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `todos`
+  DROP COLUMN `deleted_by_cascade`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `boards`
+  DROP COLUMN `deleted_by_cascade`;
+-- +goose StatementEnd
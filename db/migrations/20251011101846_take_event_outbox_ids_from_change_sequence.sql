@@ -0,0 +1,18 @@
+-- +goose Up
+-- Event ids are taken from the change sequence from now on, so it moves past the ids in use.
+-- +goose StatementBegin
+UPDATE `change_sequence`
+  SET `value` = GREATEST(`value`, (SELECT COALESCE(MAX(`id`), 0) FROM `event_outbox`))
+  WHERE `id` = 1;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `event_outbox`
+  MODIFY `id` BIGINT NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `event_outbox`
+  MODIFY `id` BIGINT NOT NULL AUTO_INCREMENT;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- A key is claimed before its todo is created, in the same transaction.
+-- +goose StatementBegin
+ALTER TABLE `incoming_webhook_keys`
+  MODIFY `todo_id` INT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM `incoming_webhook_keys` WHERE `todo_id` IS NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `incoming_webhook_keys`
+  MODIFY `todo_id` INT NOT NULL;
+-- +goose StatementEnd
@@ -4,15 +4,21 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/rm-ryou/sample_todo_app/internal/api/controllers"
 	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	"github.com/rm-ryou/sample_todo_app/internal/notifiers"
 	"github.com/rm-ryou/sample_todo_app/internal/repositories"
+	"github.com/rm-ryou/sample_todo_app/internal/services"
 )
 
 func Run(cfg *config.Config) {
@@ -22,10 +28,57 @@ func Run(cfg *config.Config) {
 	}
 	defer db.Close()
 
+	// Requests still running when the shutdown timeout expires are canceled with their queries.
+	requestCtx, cancelRequests := context.WithCancel(context.Background())
+	defer cancelRequests()
+
+	hub := services.NewEventHub(cfg.Events)
+	relay := services.NewOutboxRelay(repositories.NewOutboxRepository(db), hub, cfg.Outbox)
+	if err := relay.Start(context.Background()); err != nil {
+		log.Fatalf("failed to start the event relay: %v", err)
+	}
 	srv := &http.Server{
-		Addr:    fmt.Sprintf(":%s", cfg.Port),
-		Handler: controllers.InitRoutes(db),
+		Addr:        fmt.Sprintf(":%s", cfg.Port),
+		Handler:     controllers.InitRoutes(db, cfg, hub),
+		BaseContext: func(net.Listener) context.Context { return requestCtx },
 	}
+	// Shutdown waits for connections to go idle, which open event streams never do on their own.
+	srv.RegisterOnShutdown(hub.Close)
+
+	scheduler := services.NewReminderScheduler(
+		repositories.NewReminderRepository(db),
+		map[string]interfaces.Notifier{
+			entities.ReminderChannelLog:     notifiers.NewLogNotifier(),
+			entities.ReminderChannelWebhook: notifiers.NewWebhookNotifier(cfg.Reminder.WebhookURL),
+		},
+		cfg.Reminder,
+	)
+	purger := services.NewTrashPurger(repositories.NewTrashRepository(db), cfg.Trash)
+	idempotencyPurger := services.NewIdempotencyPurger(repositories.NewIdempotencyRepository(db), cfg.Idempotency)
+	dispatcher := services.NewWebhookDispatcher(repositories.NewWebhookRepository(db), notifiers.NewWebhookSender(cfg.Webhook.Timeout), cfg.Webhook)
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(5)
+	go func() {
+		defer wg.Done()
+		scheduler.Run(schedulerCtx)
+	}()
+	go func() {
+		defer wg.Done()
+		purger.Run(schedulerCtx)
+	}()
+	go func() {
+		defer wg.Done()
+		idempotencyPurger.Run(schedulerCtx)
+	}()
+	go func() {
+		defer wg.Done()
+		relay.Run(schedulerCtx)
+	}()
+	go func() {
+		defer wg.Done()
+		dispatcher.Run(schedulerCtx)
+	}()
 
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -41,9 +94,15 @@ func Run(cfg *config.Config) {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	if err := srv.Shutdown(shutdownCtx); err != nil {
+	err = srv.Shutdown(shutdownCtx)
+	cancelRequests()
+	if err != nil {
 		log.Fatalf("failed to shutdown: %v", err)
 	} else {
 		log.Println("Server gracefully stopped")
 	}
+
+	// Let an in-flight dispatch, purge or relay finish before the deferred db.Close.
+	stopScheduler()
+	wg.Wait()
 }
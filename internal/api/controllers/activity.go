@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type ActivityController struct {
+	service interfaces.ActivityServicer
+}
+
+func NewActivityController(service interfaces.ActivityServicer) *ActivityController {
+	return &ActivityController{
+		service: service,
+	}
+}
+
+// GetAll lists the room's activity newest first, paged with the before_id and limit query
+// parameters.
+func (ac *ActivityController) GetAll(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseActivityFilter(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	_, activities, err := ac.service.GetAll(r.Context(), filter)
+	if err != nil {
+		activityError(w, err)
+		return
+	}
+
+	res := response.ConvertActivitiesResponse(activities)
+	response.Basic(w, http.StatusOK, res)
+}
+
+// Feed serves the same page as GetAll as an Atom feed. Feed readers cannot send headers,
+// so the feed token comes in the token query parameter.
+func (ac *ActivityController) Feed(w http.ResponseWriter, r *http.Request) {
+	if err := ac.service.Authenticate(r.Context(), r.URL.Query().Get("token")); err != nil {
+		if errors.Is(err, entities.ErrInvalidFeedToken) {
+			response.Error(w, http.StatusUnauthorized, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	filter, err := parseActivityFilter(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	room, activities, err := ac.service.GetAll(r.Context(), filter)
+	if err != nil {
+		activityError(w, err)
+		return
+	}
+
+	response.Atom(w, http.StatusOK, response.ConvertActivityFeed(room, activities))
+}
+
+// CreateFeedToken issues a feed token for the X-Actor of the request. Issuing a new token
+// revokes the previous one.
+func (ac *ActivityController) CreateFeedToken(w http.ResponseWriter, r *http.Request) {
+	token, err := ac.service.CreateFeedToken(r.Context())
+	if err != nil {
+		if errors.Is(err, entities.ErrActorRequired) {
+			response.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.FeedToken{Token: token})
+}
+
+func activityError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		response.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, entities.ErrInvalidActivityFilter):
+		response.Error(w, http.StatusBadRequest, err)
+	default:
+		response.Error(w, http.StatusInternalServerError, err)
+	}
+}
+
+func parseActivityFilter(r *http.Request) (*entities.ActivityFilter, error) {
+	roomId, err := strconv.Atoi(r.PathValue("roomId"))
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &entities.ActivityFilter{RoomId: roomId}
+	for name, dst := range map[string]*int{
+		"before_id": &filter.BeforeId,
+		"limit":     &filter.Limit,
+	} {
+		if value := r.URL.Query().Get(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, err
+			}
+			*dst = n
+		}
+	}
+
+	return filter, nil
+}
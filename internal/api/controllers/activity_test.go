@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAllActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockActivityServicer(ctrl)
+	controller := NewActivityController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/activity", controller.GetAll)
+
+	createdAt := time.Date(2025, 8, 23, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		path           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success to Get activities with paging",
+			path: "/v1/rooms/1/activity?before_id=10&limit=1",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), &entities.ActivityFilter{RoomId: 1, BeforeId: 10, Limit: 1}).
+					Return(&entities.Room{Id: 1}, []*entities.Activity{
+						{Id: 9, RoomId: 1, BoardId: 2, TodoId: 3, Kind: entities.ActivityTodoCompleted, Actor: "alice", Title: "Buy milk", CreatedAt: createdAt},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{"activities":[{"id":9,"kind":"todo_completed","actor":"alice","room_id":1,"board_id":2,"todo_id":3,
+				"title":"Buy milk","created_at":"2025-08-23T09:00:00Z"}]}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to invalid limit",
+			path:           "/v1/rooms/1/activity?limit=abc",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name: "Failed with not found - Due to not exist room",
+			path: "/v1/rooms/999/activity",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), &entities.ActivityFilter{RoomId: 999}).Return(nil, nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestFeedActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockActivityServicer(ctrl)
+	controller := NewActivityController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/activity.atom", controller.Feed)
+
+	createdAt := time.Date(2025, 8, 23, 9, 0, 0, 0, time.UTC)
+	fromBoardId := 1
+
+	testCases := []struct {
+		name           string
+		path           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success to Get the activity feed",
+			path: "/v1/rooms/1/activity.atom?token=secret",
+			setupMock: func() {
+				mockService.EXPECT().Authenticate(gomock.Any(), "secret").Return(nil)
+				mockService.EXPECT().GetAll(gomock.Any(), &entities.ActivityFilter{RoomId: 1}).
+					Return(&entities.Room{Id: 1, Name: "Team"}, []*entities.Activity{
+						{Id: 2, RoomId: 1, BoardId: 2, TodoId: 3, FromBoardId: &fromBoardId, Kind: entities.ActivityTodoMoved, Title: "Buy milk", CreatedAt: createdAt},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<feed xmlns="http://www.w3.org/2005/Atom"><id>urn:sample-todo-app:rooms:1:activity</id><title>Team activity</title>` +
+				`<updated>2025-08-23T09:00:00Z</updated><entry><id>urn:sample-todo-app:activities:2</id>` +
+				`<title>Someone moved &#34;Buy milk&#34; from board 1 to board 2</title><updated>2025-08-23T09:00:00Z</updated>` +
+				`<author><name>Someone</name></author></entry></feed>`,
+		},
+		{
+			name: "Failed with unauthorized - Due to invalid feed token",
+			path: "/v1/rooms/1/activity.atom?token=wrong",
+			setupMock: func() {
+				mockService.EXPECT().Authenticate(gomock.Any(), "wrong").Return(entities.ErrInvalidFeedToken)
+			},
+			expectedStatus: 401,
+			expectedBody:   `{"message":"Unauthorized"}` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.Equal(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestCreateFeedTokenActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockActivityServicer(ctrl)
+	controller := NewActivityController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/feed-tokens", controller.CreateFeedToken)
+
+	testCases := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success to Create a feed token",
+			setupMock: func() {
+				mockService.EXPECT().CreateFeedToken(gomock.Any()).Return("abc123", nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"token":"abc123"}`,
+		},
+		{
+			name: "Failed with invalid request - Due to missing actor",
+			setupMock: func() {
+				mockService.EXPECT().CreateFeedToken(gomock.Any()).Return("", entities.ErrActorRequired)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/feed-tokens", nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+var errAdminOnly = errors.New("Audit log is for administrators only")
+
+type AuditController struct {
+	service    interfaces.AuditServicer
+	adminToken string
+}
+
+// NewAuditController serves the audit log to requests bearing adminToken. An empty token
+// turns every request away.
+func NewAuditController(service interfaces.AuditServicer, adminToken string) *AuditController {
+	return &AuditController{
+		service:    service,
+		adminToken: adminToken,
+	}
+}
+
+// GetAll lists audit events newest first, filtered by the actor, entity_type, entity_id,
+// action, since, until and before_id query parameters. since and until are RFC 3339 times.
+func (ac *AuditController) GetAll(w http.ResponseWriter, r *http.Request) {
+	if !ac.isAdmin(r) {
+		response.Error(w, http.StatusForbidden, errAdminOnly)
+		return
+	}
+
+	filter, err := parseAuditFilter(r.URL.Query())
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	events, err := ac.service.GetAll(r.Context(), filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidAuditFilter):
+			response.Error(w, http.StatusBadRequest, err)
+		default:
+			response.Error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	res := response.ConvertAuditEventsResponse(events)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (ac *AuditController) isAdmin(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || ac.adminToken == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(ac.adminToken)) == 1
+}
+
+func parseAuditFilter(query url.Values) (*entities.AuditFilter, error) {
+	filter := &entities.AuditFilter{
+		Actor:      query.Get("actor"),
+		EntityType: query.Get("entity_type"),
+		Action:     query.Get("action"),
+	}
+
+	for name, dst := range map[string]*int{
+		"entity_id": &filter.EntityId,
+		"before_id": &filter.BeforeId,
+		"limit":     &filter.Limit,
+	} {
+		if value := query.Get(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, err
+			}
+			*dst = n
+		}
+	}
+
+	for name, dst := range map[string]**time.Time{
+		"since": &filter.Since,
+		"until": &filter.Until,
+	} {
+		if value := query.Get(name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, err
+			}
+			*dst = &t
+		}
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,131 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAllAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockAuditServicer(ctrl)
+	controller := NewAuditController(mockService, "secret")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/audit", controller.GetAll)
+
+	since := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		query          string
+		authorization  string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:          "Success to Get audit events with filters",
+			query:         "?entity_type=board&entity_id=1&action=delete&since=2025-08-01T00:00:00Z",
+			authorization: "Bearer secret",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), &entities.AuditFilter{EntityType: "board", EntityId: 1, Action: "delete", Since: &since}).
+					Return([]*entities.AuditEvent{
+						{
+							Id:         3,
+							Actor:      "alice",
+							EntityType: "board",
+							EntityId:   1,
+							Action:     "delete",
+							Before:     json.RawMessage(`{"Id":1,"Name":"backlog"}`),
+							RequestId:  "req-1",
+							IP:         "192.0.2.1",
+							CreatedAt:  time.Date(2025, 8, 2, 10, 0, 0, 0, time.UTC),
+						},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{"events":[{
+				"id":3,
+				"actor":"alice",
+				"entity_type":"board",
+				"entity_id":1,
+				"action":"delete",
+				"before":{"Id":1,"Name":"backlog"},
+				"after":null,
+				"request_id":"req-1",
+				"ip":"192.0.2.1",
+				"created_at":"2025-08-02T10:00:00Z"
+			}]}`,
+		},
+		{
+			name:           "Failed with forbidden - Due to missing token",
+			setupMock:      func() {},
+			expectedStatus: 403,
+			expectedBody:   `{"message":"Forbidden"}`,
+		},
+		{
+			name:           "Failed with forbidden - Due to wrong token",
+			authorization:  "Bearer guess",
+			setupMock:      func() {},
+			expectedStatus: 403,
+			expectedBody:   `{"message":"Forbidden"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to invalid since",
+			query:          "?since=yesterday",
+			authorization:  "Bearer secret",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:          "Failed with bad request - Due to invalid filter",
+			query:         "?action=archive",
+			authorization: "Bearer secret",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), &entities.AuditFilter{Action: "archive"}).
+					Return(nil, entities.ErrInvalidAuditFilter)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:          "Failed with internal server error - Due to unexpected errors",
+			authorization: "Bearer secret",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), &entities.AuditFilter{}).
+					Return(nil, errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/audit"+tc.query, nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
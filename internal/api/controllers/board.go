@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type BoardController struct {
+	service interfaces.BoardServicer
+}
+
+func NewBoardController(service interfaces.BoardServicer) *BoardController {
+	return &BoardController{
+		service: service,
+	}
+}
+
+func (bc *BoardController) GetAll(w http.ResponseWriter, r *http.Request) {
+	roomIdStr := r.PathValue("roomId")
+	roomId, err := strconv.Atoi(roomIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	includeArchived, err := parseArchivedQuery(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	boards, err := bc.service.GetAllByRoomId(r.Context(), roomId, includeArchived)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertBoardsResponse(boards)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (bc *BoardController) GetById(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	board, err := bc.service.GetById(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertBoardResponse(board)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (bc *BoardController) Create(w http.ResponseWriter, r *http.Request) {
+	roomIdStr := r.PathValue("roomId")
+	roomId, err := strconv.Atoi(roomIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req request.Board
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	board, err := bc.service.Create(r.Context(), req.Name, req.Priority, roomId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertBoardResponse(board)
+	response.Created(w, fmt.Sprintf("/v1/rooms/%d/boards/%d", roomId, board.Id), res)
+}
+
+func (bc *BoardController) Archive(w http.ResponseWriter, r *http.Request) {
+	setArchived(w, r, bc.service.Archive)
+}
+
+func (bc *BoardController) Unarchive(w http.ResponseWriter, r *http.Request) {
+	setArchived(w, r, bc.service.Unarchive)
+}
+
+func (bc *BoardController) Reorder(w http.ResponseWriter, r *http.Request) {
+	roomIdStr := r.PathValue("roomId")
+	roomId, err := strconv.Atoi(roomIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req request.BoardOrder
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = bc.service.Reorder(r.Context(), roomId, req.Ids)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidBoardOrder) {
+			response.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
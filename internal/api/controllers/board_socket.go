@@ -0,0 +1,249 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/go-playground/validator"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	"github.com/rm-ryou/sample_todo_app/internal/services"
+)
+
+var errInvalidBoardMessage = errors.New("Message lacks the fields its type needs")
+
+type BoardSocketController struct {
+	todos        interfaces.TodoServicer
+	events       interfaces.EventServicer
+	presence     interfaces.PresenceServicer
+	cfg          config.Events
+	cors         config.CORS
+	queryTimeout time.Duration
+}
+
+func NewBoardSocketController(todos interfaces.TodoServicer, events interfaces.EventServicer, presence interfaces.PresenceServicer, cfg config.Events, cors config.CORS, queryTimeout time.Duration) *BoardSocketController {
+	return &BoardSocketController{
+		todos:        todos,
+		events:       events,
+		presence:     presence,
+		cfg:          cfg,
+		cors:         cors,
+		queryTimeout: queryTimeout,
+	}
+}
+
+// Serve upgrades to a WebSocket that sends the board's changes and viewers, and applies
+// the changes the client sends. Browsers cannot set headers on a WebSocket, so the actor
+// and the last event id seen before reconnecting may be given as query parameters.
+func (bc *BoardSocketController) Serve(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// A malformed last_event_id cannot come from this server, so it starts afresh.
+	lastEventId, _ := strconv.ParseInt(r.URL.Query().Get("last_event_id"), 10, 64)
+
+	actor := services.ActorFrom(r.Context())
+	if actor.Name == "" {
+		actor.Name = r.URL.Query().Get("actor")
+		if len(actor.Name) > actorMaxLength {
+			response.Error(w, http.StatusBadRequest, fmt.Errorf("actor is longer than %d bytes", actorMaxLength))
+			return
+		}
+	}
+
+	sub, err := bc.events.SubscribeBoard(r.Context(), boardId, lastEventId)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			response.Error(w, http.StatusNotFound, err)
+		case errors.Is(err, entities.ErrEventsClosed):
+			response.Error(w, http.StatusServiceUnavailable, err)
+		default:
+			response.Error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	defer bc.events.Unsubscribe(sub)
+
+	// Browsers do not apply CORS to WebSockets, so Accept refuses origins other than the
+	// request's own and the ones CORS allows.
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{OriginPatterns: bc.cors.AllowedOrigins})
+	if err != nil {
+		// Accept has already answered the request.
+		return
+	}
+	defer conn.CloseNow()
+
+	session := bc.presence.Join(boardId, actor.Name)
+	defer bc.presence.Leave(session)
+
+	ctx, cancel := context.WithCancel(services.WithActor(r.Context(), actor))
+	defer cancel()
+
+	replies := make(chan *response.BoardMessage)
+	go func() {
+		defer cancel()
+		for {
+			_, data, err := conn.Read(ctx)
+			if err != nil {
+				return
+			}
+
+			reply := bc.handle(ctx, boardId, session, data)
+			select {
+			case replies <- reply:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// send gives up on clients that stop reading, so they cannot hold the connection forever.
+	send := func(msg *response.BoardMessage) bool {
+		writeCtx := ctx
+		if bc.cfg.WriteTimeout > 0 {
+			var cancel context.CancelFunc
+			writeCtx, cancel = context.WithTimeout(ctx, bc.cfg.WriteTimeout)
+			defer cancel()
+		}
+		return wsjson.Write(writeCtx, conn, msg) == nil
+	}
+
+	if sub.Reset && !send(response.BoardResetMessage()) {
+		return
+	}
+	for _, event := range sub.Backlog {
+		if event.ConcernsBoard(boardId) && !send(response.ConvertBoardEventMessage(event)) {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(bc.cfg.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-sub.Events:
+			if !ok {
+				conn.Close(websocket.StatusTryAgainLater, "server is shutting down")
+				return
+			}
+			if event.ConcernsBoard(boardId) && !send(response.ConvertBoardEventMessage(event)) {
+				return
+			}
+		case viewers := <-session.Updates:
+			if !send(response.ConvertPresenceMessage(viewers)) {
+				return
+			}
+		case reply := <-replies:
+			if !send(reply) {
+				return
+			}
+		case <-heartbeat.C:
+			pingCtx, cancel := context.WithTimeout(ctx, bc.cfg.HeartbeatInterval)
+			err := conn.Ping(pingCtx)
+			cancel()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// handle applies a message from the client and returns the reply to it. The changes
+// themselves reach every viewer, the sender included, as events.
+func (bc *BoardSocketController) handle(ctx context.Context, boardId int, session *entities.PresenceSession, data []byte) *response.BoardMessage {
+	var msg request.BoardMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return response.BoardErrorMessage("", http.StatusBadRequest)
+	}
+	if msg.Todo != nil {
+		msg.Todo.BoardId = boardId
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(msg); err != nil {
+		return response.BoardErrorMessage(msg.RequestId, http.StatusBadRequest)
+	}
+
+	if bc.queryTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, bc.queryTimeout)
+		defer cancel()
+	}
+
+	todo, err := bc.apply(ctx, boardId, session, &msg)
+	if err != nil {
+		return response.BoardErrorMessage(msg.RequestId, boardMessageStatus(err))
+	}
+
+	return response.BoardAckMessage(msg.RequestId, todo)
+}
+
+func (bc *BoardSocketController) apply(ctx context.Context, boardId int, session *entities.PresenceSession, msg *request.BoardMessage) (*entities.Todo, error) {
+	switch msg.Type {
+	case request.BoardMessageEditing:
+		bc.presence.SetEditing(session, msg.TodoId)
+		return nil, nil
+	case request.BoardMessageCreate:
+		if msg.Todo == nil {
+			return nil, errInvalidBoardMessage
+		}
+		req := msg.Todo
+		return bc.todos.Create(ctx, boardId, req.Title, req.Done, req.StatusId, req.Priority, req.DueDate, convertRecurrence(req.Recurrence))
+	case request.BoardMessageUpdate:
+		if msg.Todo == nil {
+			return nil, errInvalidBoardMessage
+		}
+		if err := bc.onBoard(ctx, boardId, msg.Id); err != nil {
+			return nil, err
+		}
+		req := msg.Todo
+		return nil, bc.todos.Update(ctx, msg.Id, req.Title, req.Done, req.StatusId, req.Priority, req.DueDate, convertRecurrence(req.Recurrence))
+	case request.BoardMessageMove:
+		return nil, bc.todos.Move(ctx, boardId, msg.Id, msg.BeforeId, msg.AfterId)
+	default:
+		if err := bc.onBoard(ctx, boardId, msg.Id); err != nil {
+			return nil, err
+		}
+		return nil, bc.todos.Delete(ctx, msg.Id)
+	}
+}
+
+// onBoard keeps a board's socket from changing todos of other boards.
+func (bc *BoardSocketController) onBoard(ctx context.Context, boardId, id int) error {
+	todo, err := bc.todos.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if todo.BoardId != boardId {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func boardMessageStatus(err error) int {
+	if errors.Is(err, errInvalidBoardMessage) || errors.Is(err, entities.ErrInvalidRank) {
+		return http.StatusBadRequest
+	}
+
+	return batchOperationStatus(err)
+}
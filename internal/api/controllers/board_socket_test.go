@@ -0,0 +1,329 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/rm-ryou/sample_todo_app/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newBoardSocketServer serves the controller and waits on close for the handlers to return,
+// as the test server does not track hijacked connections.
+func newBoardSocketServer(t *testing.T, controller *BoardSocketController) string {
+	var wg sync.WaitGroup
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/socket", func(w http.ResponseWriter, r *http.Request) {
+		wg.Add(1)
+		defer wg.Done()
+		controller.Serve(w, r)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(func() {
+		srv.Close()
+		wg.Wait()
+	})
+
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func dialBoardSocket(t *testing.T, url string) *websocket.Conn {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	conn, _, err := websocket.Dial(ctx, url, nil)
+	require.NoError(t, err)
+
+	return conn
+}
+
+func readBoardMessage(t *testing.T, conn *websocket.Conn) *response.BoardMessage {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var msg response.BoardMessage
+	require.NoError(t, wsjson.Read(ctx, conn, &msg))
+
+	return &msg
+}
+
+func writeBoardMessage(t *testing.T, conn *websocket.Conn, msg string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	require.NoError(t, conn.Write(ctx, websocket.MessageText, []byte(msg)))
+}
+
+func TestBoardSocket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoService := mock_service.NewMockTodoServicer(ctrl)
+	mockEventService := mock_service.NewMockEventServicer(ctrl)
+	controller := NewBoardSocketController(mockTodoService, mockEventService, services.NewPresenceHub(), config.Events{HeartbeatInterval: time.Hour, WriteTimeout: time.Second}, config.CORS{}, time.Second)
+	url := newBoardSocketServer(t, controller)
+
+	todo := &entities.Todo{Id: 3, BoardId: 2, Title: "Buy milk", StatusId: 1, Rank: "V"}
+	otherTodo := &entities.Todo{Id: 4, BoardId: 9, Title: "Buy eggs", StatusId: 1, Rank: "V"}
+
+	events := make(chan *entities.Event)
+	sub := &entities.EventSubscription{
+		RoomId: 1,
+		Reset:  true,
+		Backlog: []*entities.Event{
+			{Id: 5, RoomId: 1, Type: entities.EventTodoUpdated, Data: otherTodo},
+			{Id: 6, RoomId: 1, Type: entities.EventTodoCreated, Data: todo},
+		},
+		Events: events,
+	}
+	unsubscribed := make(chan struct{})
+	mockEventService.EXPECT().SubscribeBoard(gomock.Any(), 2, int64(4)).Return(sub, nil)
+	mockEventService.EXPECT().Unsubscribe(sub).Do(func(*entities.EventSubscription) { close(unsubscribed) })
+
+	conn := dialBoardSocket(t, url+"/v1/boards/2/socket?actor=alice&last_event_id=4")
+
+	// The reset comes first, then the missed events of the board only.
+	assert.Equal(t, response.BoardMessageReset, readBoardMessage(t, conn).Type)
+	msg := readBoardMessage(t, conn)
+	assert.Equal(t, response.BoardMessageEvent, msg.Type)
+	assert.Equal(t, int64(6), msg.EventId)
+	assert.Equal(t, 3, msg.Todo.Id)
+
+	msg = readBoardMessage(t, conn)
+	assert.Equal(t, response.BoardMessagePresence, msg.Type)
+	assert.Equal(t, []*response.Viewer{{Actor: "alice"}}, msg.Viewers)
+
+	mockTodoService.EXPECT().Create(gomock.Any(), 2, "Buy milk", false, nil, 0, nil, nil).Return(todo, nil)
+	writeBoardMessage(t, conn, `{"type":"create","request_id":"r1","todo":{"title":"Buy milk"}}`)
+	msg = readBoardMessage(t, conn)
+	assert.Equal(t, response.BoardMessageAck, msg.Type)
+	assert.Equal(t, "r1", msg.RequestId)
+	assert.Equal(t, 3, msg.Todo.Id)
+
+	events <- &entities.Event{Id: 7, RoomId: 1, Type: entities.EventTodoUpdated, Data: otherTodo}
+	events <- &entities.Event{Id: 8, RoomId: 1, Type: entities.EventTodoDeleted, Data: todo}
+	msg = readBoardMessage(t, conn)
+	assert.Equal(t, response.BoardMessageEvent, msg.Type)
+	assert.Equal(t, int64(8), msg.EventId)
+	assert.Equal(t, entities.EventTodoDeleted, msg.Event)
+
+	// The presence update and the ack may come in either order.
+	writeBoardMessage(t, conn, `{"type":"editing","request_id":"r2","todo_id":3}`)
+	replies := map[string]*response.BoardMessage{}
+	for range 2 {
+		msg := readBoardMessage(t, conn)
+		replies[msg.Type] = msg
+	}
+	require.Contains(t, replies, response.BoardMessagePresence)
+	require.Contains(t, replies, response.BoardMessageAck)
+	editing := 3
+	assert.Equal(t, []*response.Viewer{{Actor: "alice", EditingTodoId: &editing}}, replies[response.BoardMessagePresence].Viewers)
+	assert.Equal(t, "r2", replies[response.BoardMessageAck].RequestId)
+
+	// Shutting down ends the subscription, and the client is told to come back later.
+	close(events)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, _, err := conn.Read(ctx)
+	assert.Equal(t, websocket.StatusTryAgainLater, websocket.CloseStatus(err))
+	<-unsubscribed
+}
+
+func TestBoardSocketMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoService := mock_service.NewMockTodoServicer(ctrl)
+	mockEventService := mock_service.NewMockEventServicer(ctrl)
+	controller := NewBoardSocketController(mockTodoService, mockEventService, services.NewPresenceHub(), config.Events{HeartbeatInterval: time.Hour, WriteTimeout: time.Second}, config.CORS{}, time.Second)
+	url := newBoardSocketServer(t, controller)
+
+	sub := &entities.EventSubscription{RoomId: 1, Events: make(chan *entities.Event)}
+	unsubscribed := make(chan struct{})
+	mockEventService.EXPECT().SubscribeBoard(gomock.Any(), 2, int64(0)).Return(sub, nil)
+	mockEventService.EXPECT().Unsubscribe(sub).Do(func(*entities.EventSubscription) { close(unsubscribed) })
+
+	conn := dialBoardSocket(t, url+"/v1/boards/2/socket?actor=alice")
+	defer func() {
+		conn.Close(websocket.StatusNormalClosure, "")
+		<-unsubscribed
+	}()
+	assert.Equal(t, response.BoardMessagePresence, readBoardMessage(t, conn).Type)
+
+	testCases := []struct {
+		name           string
+		message        string
+		setupMock      func()
+		expectedType   string
+		expectedStatus int
+	}{
+		{
+			name:    "Success to update todo",
+			message: `{"type":"update","request_id":"r1","id":3,"todo":{"title":"Buy oat milk","done":true}}`,
+			setupMock: func() {
+				mockTodoService.EXPECT().GetById(gomock.Any(), 3).Return(&entities.Todo{Id: 3, BoardId: 2}, nil)
+				mockTodoService.EXPECT().Update(gomock.Any(), 3, "Buy oat milk", true, nil, 0, nil, nil).Return(nil)
+			},
+			expectedType: response.BoardMessageAck,
+		},
+		{
+			name:    "Success to move todo",
+			message: `{"type":"move","request_id":"r1","id":3,"after_id":4}`,
+			setupMock: func() {
+				afterId := 4
+				mockTodoService.EXPECT().Move(gomock.Any(), 2, 3, nil, &afterId).Return(nil)
+			},
+			expectedType: response.BoardMessageAck,
+		},
+		{
+			name:    "Success to delete todo",
+			message: `{"type":"delete","request_id":"r1","id":3}`,
+			setupMock: func() {
+				mockTodoService.EXPECT().GetById(gomock.Any(), 3).Return(&entities.Todo{Id: 3, BoardId: 2}, nil)
+				mockTodoService.EXPECT().Delete(gomock.Any(), 3).Return(nil)
+			},
+			expectedType: response.BoardMessageAck,
+		},
+		{
+			name:           "Failed with bad request - Due to malformed message",
+			message:        `{"type":`,
+			setupMock:      func() {},
+			expectedType:   response.BoardMessageError,
+			expectedStatus: 400,
+		},
+		{
+			name:           "Failed with bad request - Due to unknown type",
+			message:        `{"type":"rename","request_id":"r1","id":3}`,
+			setupMock:      func() {},
+			expectedType:   response.BoardMessageError,
+			expectedStatus: 400,
+		},
+		{
+			name:           "Failed with bad request - Due to create without todo",
+			message:        `{"type":"create","request_id":"r1"}`,
+			setupMock:      func() {},
+			expectedType:   response.BoardMessageError,
+			expectedStatus: 400,
+		},
+		{
+			name:    "Failed with bad request - Due to invalid rank",
+			message: `{"type":"move","request_id":"r1","id":3,"before_id":4,"after_id":5}`,
+			setupMock: func() {
+				mockTodoService.EXPECT().Move(gomock.Any(), 2, 3, gomock.Any(), gomock.Any()).Return(entities.ErrInvalidRank)
+			},
+			expectedType:   response.BoardMessageError,
+			expectedStatus: 400,
+		},
+		{
+			name:    "Failed with not found - Due to todo of another board",
+			message: `{"type":"delete","request_id":"r1","id":4}`,
+			setupMock: func() {
+				mockTodoService.EXPECT().GetById(gomock.Any(), 4).Return(&entities.Todo{Id: 4, BoardId: 9}, nil)
+			},
+			expectedType:   response.BoardMessageError,
+			expectedStatus: 404,
+		},
+		{
+			name:    "Failed with conflict - Due to archived board",
+			message: `{"type":"create","request_id":"r1","todo":{"title":"Buy milk"}}`,
+			setupMock: func() {
+				mockTodoService.EXPECT().Create(gomock.Any(), 2, "Buy milk", false, nil, 0, nil, nil).Return(nil, entities.ErrArchived)
+			},
+			expectedType:   response.BoardMessageError,
+			expectedStatus: 409,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			writeBoardMessage(t, conn, tc.message)
+			msg := readBoardMessage(t, conn)
+
+			assert.Equal(t, tc.expectedType, msg.Type)
+			assert.Equal(t, tc.expectedStatus, msg.Status)
+		})
+	}
+}
+
+func TestBoardSocketRefused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoService := mock_service.NewMockTodoServicer(ctrl)
+	mockEventService := mock_service.NewMockEventServicer(ctrl)
+	controller := NewBoardSocketController(mockTodoService, mockEventService, services.NewPresenceHub(), config.Events{HeartbeatInterval: time.Hour}, config.CORS{AllowedOrigins: []string{"https://app.example.com"}}, time.Second)
+	url := newBoardSocketServer(t, controller)
+
+	testCases := []struct {
+		name           string
+		query          string
+		origin         string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:           "Failed with bad request - Due to too long actor",
+			query:          "?actor=" + strings.Repeat("a", actorMaxLength+1),
+			setupMock:      func() {},
+			expectedStatus: 400,
+		},
+		{
+			name: "Failed with not found - Due to not exist board",
+			setupMock: func() {
+				mockEventService.EXPECT().SubscribeBoard(gomock.Any(), 2, int64(0)).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+		},
+		{
+			name: "Failed with service unavailable - Due to the server shutting down",
+			setupMock: func() {
+				mockEventService.EXPECT().SubscribeBoard(gomock.Any(), 2, int64(0)).Return(nil, entities.ErrEventsClosed)
+			},
+			expectedStatus: 503,
+		},
+		{
+			name:   "Failed with forbidden - Due to not allowed origin",
+			origin: "https://evil.example.com",
+			setupMock: func() {
+				sub := &entities.EventSubscription{RoomId: 1, Events: make(chan *entities.Event)}
+				mockEventService.EXPECT().SubscribeBoard(gomock.Any(), 2, int64(0)).Return(sub, nil)
+				mockEventService.EXPECT().Unsubscribe(sub)
+			},
+			expectedStatus: 403,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			opts := &websocket.DialOptions{HTTPHeader: http.Header{}}
+			if tc.origin != "" {
+				opts.HTTPHeader.Set("Origin", tc.origin)
+			}
+			_, res, err := websocket.Dial(ctx, url+"/v1/boards/2/socket"+tc.query, opts)
+			require.Error(t, err)
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+		})
+	}
+}
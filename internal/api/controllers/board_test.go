@@ -0,0 +1,434 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAllBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockBoardServicer(ctrl)
+	controller := NewBoardController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/boards/", controller.GetAll)
+
+	testCases := []struct {
+		name           string
+		roomIdParam    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Get boards in position order",
+			roomIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAllByRoomId(gomock.Any(), 1, false).
+					Return([]*entities.Board{
+						{
+							Id:        2,
+							Name:      "Doing",
+							Priority:  0,
+							Position:  0,
+							RoomId:    1,
+							CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+							UpdatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						},
+						{
+							Id:        1,
+							Name:      "Backlog",
+							Priority:  1,
+							Position:  1,
+							RoomId:    1,
+							CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+							UpdatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{"boards":[
+				{"id":2,"name":"Doing","priority":0,"position":0,"room_id":1,"created_at":"2025-05-01T10:00:00Z","updated_at":"2025-05-01T10:00:00Z"},
+				{"id":1,"name":"Backlog","priority":1,"position":1,"room_id":1,"created_at":"2025-05-01T10:00:00Z","updated_at":"2025-05-01T10:00:00Z"}
+			]}`,
+		},
+		{
+			name:        "Success to Get empty boards",
+			roomIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAllByRoomId(gomock.Any(), 1, false).Return(nil, nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"boards":[]}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric room id",
+			roomIdParam:    "invalid",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with internal server error - Due to unexpected errors",
+			roomIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAllByRoomId(gomock.Any(), 1, false).
+					Return(nil, errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/rooms/" + tc.roomIdParam + "/boards/"
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestGetByIdBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockBoardServicer(ctrl)
+	controller := NewBoardController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/boards/{id}", controller.GetById)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "Success to Get board",
+			idParam: "2",
+			setupMock: func() {
+				mockService.EXPECT().GetById(gomock.Any(), 2).
+					Return(&entities.Board{
+						Id:        2,
+						Name:      "backlog",
+						Priority:  1,
+						Position:  0,
+						RoomId:    1,
+						CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"id":2,
+				"name":"backlog",
+				"priority":1,
+				"position":0,
+				"room_id":1,
+				"created_at":"2025-01-01T10:00:00Z",
+				"updated_at":"2025-01-01T10:00:00Z"
+			}`,
+		},
+		{
+			name:           "Failed with bad request - Due to invalid id",
+			idParam:        "abc",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:    "Failed with not found - Due to the board does not exist",
+			idParam: "999",
+			setupMock: func() {
+				mockService.EXPECT().GetById(gomock.Any(), 999).
+					Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/rooms/1/boards/"+tc.idParam, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestCreateBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockBoardServicer(ctrl)
+	controller := NewBoardController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/boards/", controller.Create)
+
+	testCases := []struct {
+		name             string
+		roomIdParam      string
+		requestBody      string
+		setupMock        func()
+		expectedStatus   int
+		expectedLocation string
+		expectedBody     string
+	}{
+		{
+			name:        "Success to Create new board",
+			roomIdParam: "1",
+			requestBody: `{"name":"backlog","priority":1}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), "backlog", 1, 1).
+					Return(&entities.Board{
+						Id:        4,
+						Name:      "backlog",
+						Priority:  1,
+						Position:  2,
+						RoomId:    1,
+						CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			expectedStatus:   201,
+			expectedLocation: "/v1/rooms/1/boards/4",
+			expectedBody: `{
+				"id":4,
+				"name":"backlog",
+				"priority":1,
+				"position":2,
+				"room_id":1,
+				"created_at":"2025-01-01T10:00:00Z",
+				"updated_at":"2025-01-01T10:00:00Z"
+			}`,
+		},
+		{
+			name:           "Failed with bad request - Due to the empty name",
+			roomIdParam:    "1",
+			requestBody:    `{"name":"","priority":1}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to the priority is negative number",
+			roomIdParam:    "1",
+			requestBody:    `{"name":"backlog","priority":-1}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with not found - Due to the room does not exist or is in the trash",
+			roomIdParam: "1",
+			requestBody: `{"name":"backlog","priority":1}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), "backlog", 1, 1).
+					Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:        "Failed with internal server error - Due to unexpected errors",
+			roomIdParam: "1",
+			requestBody: `{"name":"backlog","priority":1}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), "backlog", 1, 1).
+					Return(nil, errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/v1/rooms/"+tc.roomIdParam+"/boards/", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.Equal(t, tc.expectedLocation, res.Header().Get("Location"))
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestReorderBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockBoardServicer(ctrl)
+	controller := NewBoardController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/boards/order", controller.Reorder)
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Reorder boards",
+			requestBody: `{"ids":[3,1,2]}`,
+			setupMock: func() {
+				mockService.EXPECT().Reorder(gomock.Any(), 1, []int{3, 1, 2}).
+					Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to missing ids",
+			requestBody:    `{}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with bad request - Due to ids not matching the room",
+			requestBody: `{"ids":[2]}`,
+			setupMock: func() {
+				mockService.EXPECT().Reorder(gomock.Any(), 1, []int{2}).
+					Return(entities.ErrInvalidBoardOrder)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with conflict - Due to the room is archived",
+			requestBody: `{"ids":[3,1,2]}`,
+			setupMock: func() {
+				mockService.EXPECT().Reorder(gomock.Any(), 1, []int{3, 1, 2}).
+					Return(entities.ErrArchived)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+		{
+			name:        "Failed with internal server error - Due to unexpected errors",
+			requestBody: `{"ids":[3,1,2]}`,
+			setupMock: func() {
+				mockService.EXPECT().Reorder(gomock.Any(), 1, []int{3, 1, 2}).
+					Return(errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/v1/rooms/1/boards/order", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestUnarchiveBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockBoardServicer(ctrl)
+	controller := NewBoardController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/boards/{id}/unarchive", controller.Unarchive)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "Success to Unarchive board",
+			idParam: "2",
+			setupMock: func() {
+				mockService.EXPECT().Unarchive(gomock.Any(), 2).
+					Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:    "Failed with not found - Due to the board does not exist",
+			idParam: "999",
+			setupMock: func() {
+				mockService.EXPECT().Unarchive(gomock.Any(), 999).
+					Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:    "Failed with conflict - Due to the room is archived",
+			idParam: "2",
+			setupMock: func() {
+				mockService.EXPECT().Unarchive(gomock.Any(), 2).
+					Return(entities.ErrArchived)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/rooms/1/boards/"+tc.idParam+"/unarchive", nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type ChecklistController struct {
+	service interfaces.ChecklistServicer
+}
+
+func NewChecklistController(service interfaces.ChecklistServicer) *ChecklistController {
+	return &ChecklistController{
+		service: service,
+	}
+}
+
+func (cc *ChecklistController) GetAll(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	items, err := cc.service.GetAll(r.Context(), boardId, todoId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertChecklistResponse(items)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (cc *ChecklistController) Create(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req request.ChecklistItem
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := cc.service.Create(r.Context(), boardId, todoId, req.Text, req.Checked); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
+
+func (cc *ChecklistController) Update(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req request.ChecklistItem
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = cc.service.Update(r.Context(), boardId, todoId, id, req.Text, req.Checked)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
+
+func (cc *ChecklistController) Delete(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = cc.service.Delete(r.Context(), boardId, todoId, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
+
+func (cc *ChecklistController) Reorder(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req request.ChecklistOrder
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = cc.service.Reorder(r.Context(), boardId, todoId, req.Ids)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrInvalidChecklistOrder) {
+			response.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
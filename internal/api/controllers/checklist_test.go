@@ -0,0 +1,348 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAllChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockChecklistServicer(ctrl)
+	controller := NewChecklistController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/checklist/", controller.GetAll)
+
+	testCases := []struct {
+		name           string
+		todoIdParam    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Get checklist",
+			todoIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1, 1).
+					Return([]*entities.ChecklistItem{
+						{
+							Id:        1,
+							TodoId:    1,
+							Text:      "buy milk",
+							Checked:   true,
+							Position:  0,
+							CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+							UpdatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"items":[
+					{
+						"id":1,
+						"todo_id":1,
+						"text":"buy milk",
+						"checked":true,
+						"position":0,
+						"created_at":"2025-05-01T10:00:00Z",
+						"updated_at":"2025-05-01T10:00:00Z"
+					}
+				]
+			}`,
+		},
+		{
+			name:        "If there is no record, return empty json",
+			todoIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1, 1).
+					Return(nil, nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"items":[]}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric todo id",
+			todoIdParam:    "invalid",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with internal server error - Due to unexpected errors",
+			todoIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1, 1).
+					Return(nil, errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/" + tc.todoIdParam + "/checklist/"
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestCreateChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockChecklistServicer(ctrl)
+	controller := NewChecklistController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/checklist/", controller.Create)
+
+	testCases := []struct {
+		name           string
+		todoIdParam    string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Create new checklist item",
+			todoIdParam: "1",
+			requestBody: `{"text":"buy milk","checked":false}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 1, "buy milk", false).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to number of characters in the text is more than 100",
+			todoIdParam:    "1",
+			requestBody:    fmt.Sprintf(`{"text":"%s","checked":false}`, strings.Repeat("a", 101)),
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			path := "/v1/boards/1/todos/" + tc.todoIdParam + "/checklist/"
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, path, body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestUpdateChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockChecklistServicer(ctrl)
+	controller := NewChecklistController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/checklist/{id}", controller.Update)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Update checklist item",
+			idParam:     "1",
+			requestBody: `{"text":"buy milk","checked":true}`,
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), 1, 1, 1, "buy milk", true).
+					Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric id",
+			idParam:        "invalid",
+			requestBody:    `{"text":"buy milk","checked":true}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with not found - Due to no checklist item with id",
+			idParam:     "999",
+			requestBody: `{"text":"buy milk","checked":true}`,
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), 1, 1, 999, "buy milk", true).
+					Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			path := "/v1/boards/1/todos/1/checklist/" + tc.idParam
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPut, path, body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestDeleteChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockChecklistServicer(ctrl)
+	controller := NewChecklistController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/checklist/{id}", controller.Delete)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "Success to Delete checklist item",
+			idParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), 1, 1, 1).
+					Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:    "Failed with not found - Due to no checklist item with id",
+			idParam: "999",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), 1, 1, 999).
+					Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/1/checklist/" + tc.idParam
+			req := httptest.NewRequest(http.MethodDelete, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestReorderChecklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockChecklistServicer(ctrl)
+	controller := NewChecklistController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/checklist/order", controller.Reorder)
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Reorder checklist",
+			requestBody: `{"ids":[2,1]}`,
+			setupMock: func() {
+				mockService.EXPECT().Reorder(gomock.Any(), 1, 1, []int{2, 1}).
+					Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to missing ids",
+			requestBody:    `{}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with bad request - Due to ids not matching the checklist",
+			requestBody: `{"ids":[2]}`,
+			setupMock: func() {
+				mockService.EXPECT().Reorder(gomock.Any(), 1, 1, []int{2}).
+					Return(entities.ErrInvalidChecklistOrder)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/v1/boards/1/todos/1/checklist/order", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
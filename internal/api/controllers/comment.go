@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type CommentController struct {
+	service interfaces.CommentServicer
+}
+
+func NewCommentController(service interfaces.CommentServicer) *CommentController {
+	return &CommentController{
+		service: service,
+	}
+}
+
+func (cc *CommentController) GetAll(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	comments, err := cc.service.GetAll(r.Context(), boardId, todoId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertCommentsResponse(comments)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (cc *CommentController) Create(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req request.Comment
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	comment, err := cc.service.Create(r.Context(), boardId, todoId, req.Body)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertCommentResponse(comment)
+	response.Basic(w, http.StatusCreated, res)
+}
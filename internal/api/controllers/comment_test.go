@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockCommentServicer(ctrl)
+	controller := NewCommentController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/comments/", controller.Create)
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Create new comment",
+			requestBody: `{"body":"Looks good"}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 2, "Looks good").Return(&entities.Comment{
+					Id:        3,
+					TodoId:    2,
+					Author:    "alice",
+					Body:      "Looks good",
+					CreatedAt: time.Date(2025, 8, 23, 9, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+			expectedStatus: 201,
+			expectedBody:   `{"id":3,"todo_id":2,"author":"alice","body":"Looks good","created_at":"2025-08-23T09:00:00Z"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to the empty body",
+			requestBody:    `{"body":""}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with not found - Due to the todo on another board",
+			requestBody: `{"body":"Looks good"}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 2, "Looks good").Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:        "Failed with conflict - Due to the archived board",
+			requestBody: `{"body":"Looks good"}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 2, "Looks good").Return(nil, entities.ErrArchived)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/v1/boards/1/todos/2/comments/", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
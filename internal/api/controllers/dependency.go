@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type DependencyController struct {
+	service interfaces.DependencyServicer
+}
+
+func NewDependencyController(service interfaces.DependencyServicer) *DependencyController {
+	return &DependencyController{
+		service: service,
+	}
+}
+
+func (dc *DependencyController) GetBlockers(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	dependencies, err := dc.service.GetBlockers(r.Context(), boardId, todoId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertDependenciesResponse(dependencies)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (dc *DependencyController) AddBlocker(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req request.Dependency
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = dc.service.AddBlocker(r.Context(), boardId, todoId, req.BlockerId)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			response.Error(w, http.StatusNotFound, err)
+		case errors.Is(err, entities.ErrDependencyOutsideRoom):
+			response.Error(w, http.StatusBadRequest, err)
+		case errors.Is(err, entities.ErrDependencyCycle), errors.Is(err, entities.ErrArchived):
+			response.Error(w, http.StatusConflict, err)
+		default:
+			response.Error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
+
+func (dc *DependencyController) RemoveBlocker(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	blockerIdStr := r.PathValue("blockerId")
+	blockerId, err := strconv.Atoi(blockerIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = dc.service.RemoveBlocker(r.Context(), boardId, todoId, blockerId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
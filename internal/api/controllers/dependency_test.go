@@ -0,0 +1,253 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetBlockers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockDependencyServicer(ctrl)
+	controller := NewDependencyController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/blockers/", controller.GetBlockers)
+
+	testCases := []struct {
+		name           string
+		todoIdParam    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Get blockers",
+			todoIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetBlockers(gomock.Any(), 1, 1).
+					Return([]*entities.TodoDependency{
+						{
+							TodoId:    1,
+							BlockerId: 2,
+							CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"blockers":[
+					{"todo_id":1,"blocker_id":2,"created_at":"2025-05-01T10:00:00Z"}
+				]
+			}`,
+		},
+		{
+			name:        "If there is no record, return empty json",
+			todoIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetBlockers(gomock.Any(), 1, 1).
+					Return(nil, nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"blockers":[]}`,
+		},
+		{
+			name:        "Failed with not found - Due to todo not on board",
+			todoIdParam: "2",
+			setupMock: func() {
+				mockService.EXPECT().GetBlockers(gomock.Any(), 1, 2).
+					Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric todo id",
+			todoIdParam:    "invalid",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/" + tc.todoIdParam + "/blockers/"
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestAddBlocker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockDependencyServicer(ctrl)
+	controller := NewDependencyController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/blockers/", controller.AddBlocker)
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Add blocker",
+			requestBody: `{"blocker_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().AddBlocker(gomock.Any(), 1, 1, 2).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to missing blocker id",
+			requestBody:    `{}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with not found - Due to no todo with id",
+			requestBody: `{"blocker_id":999}`,
+			setupMock: func() {
+				mockService.EXPECT().AddBlocker(gomock.Any(), 1, 1, 999).Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:        "Failed with bad request - Due to the blocker is in another room",
+			requestBody: `{"blocker_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().AddBlocker(gomock.Any(), 1, 1, 2).Return(entities.ErrDependencyOutsideRoom)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with conflict - Due to a dependency cycle",
+			requestBody: `{"blocker_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().AddBlocker(gomock.Any(), 1, 1, 2).Return(entities.ErrDependencyCycle)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+		{
+			name:        "Failed with conflict - Due to the archived board",
+			requestBody: `{"blocker_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().AddBlocker(gomock.Any(), 1, 1, 2).Return(entities.ErrArchived)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+		{
+			name:        "Failed with internal server error - Due to unexpected errors",
+			requestBody: `{"blocker_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().AddBlocker(gomock.Any(), 1, 1, 2).Return(errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/v1/boards/1/todos/1/blockers/", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestRemoveBlocker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockDependencyServicer(ctrl)
+	controller := NewDependencyController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/blockers/{blockerId}", controller.RemoveBlocker)
+
+	testCases := []struct {
+		name           string
+		blockerIdParam string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Success to Remove blocker",
+			blockerIdParam: "2",
+			setupMock: func() {
+				mockService.EXPECT().RemoveBlocker(gomock.Any(), 1, 1, 2).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric blocker id",
+			blockerIdParam: "invalid",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:           "Failed with not found - Due to no dependency",
+			blockerIdParam: "999",
+			setupMock: func() {
+				mockService.EXPECT().RemoveBlocker(gomock.Any(), 1, 1, 999).Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/1/blockers/" + tc.blockerIdParam
+			req := httptest.NewRequest(http.MethodDelete, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
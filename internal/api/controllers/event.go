@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type EventController struct {
+	service interfaces.EventServicer
+	cfg     config.Events
+}
+
+func NewEventController(service interfaces.EventServicer, cfg config.Events) *EventController {
+	return &EventController{
+		service: service,
+		cfg:     cfg,
+	}
+}
+
+// Stream sends the room's events as Server-Sent Events until the client goes away or the
+// server shuts down. A client reconnecting with Last-Event-ID first gets the events it
+// missed, or a reset event when too many were missed.
+func (ec *EventController) Stream(w http.ResponseWriter, r *http.Request) {
+	roomIdStr := r.PathValue("roomId")
+	roomId, err := strconv.Atoi(roomIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// A malformed Last-Event-ID cannot come from this server, so it starts afresh.
+	lastEventId, _ := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64)
+
+	sub, err := ec.service.Subscribe(r.Context(), roomId, lastEventId)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			response.Error(w, http.StatusNotFound, err)
+		case errors.Is(err, entities.ErrEventsClosed):
+			response.Error(w, http.StatusServiceUnavailable, err)
+		default:
+			response.Error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	defer ec.service.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	// send gives up on clients that stop reading, so they cannot hold the connection forever.
+	send := func(write func() error) bool {
+		if ec.cfg.WriteTimeout > 0 {
+			rc.SetWriteDeadline(time.Now().Add(ec.cfg.WriteTimeout))
+		}
+		if err := write(); err != nil {
+			return false
+		}
+		return rc.Flush() == nil
+	}
+
+	if sub.Reset && !send(func() error { return response.WriteResetEvent(w) }) {
+		return
+	}
+	for _, event := range sub.Backlog {
+		if !send(func() error { return response.WriteEvent(w, event) }) {
+			return
+		}
+	}
+	if !send(func() error { return response.WriteHeartbeat(w) }) {
+		return
+	}
+
+	heartbeat := time.NewTicker(ec.cfg.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-sub.Events:
+			if !ok {
+				return
+			}
+			if !send(func() error { return response.WriteEvent(w, event) }) {
+				return
+			}
+		case <-heartbeat.C:
+			if !send(func() error { return response.WriteHeartbeat(w) }) {
+				return
+			}
+		}
+	}
+}
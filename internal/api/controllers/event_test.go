@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStreamEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockEventServicer(ctrl)
+	controller := NewEventController(mockService, config.Events{HeartbeatInterval: time.Hour, WriteTimeout: time.Second})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/events", controller.Stream)
+
+	createdAt := time.Date(2025, 8, 30, 9, 0, 0, 0, time.UTC)
+	todo := &entities.Todo{Id: 3, BoardId: 2, Title: "Buy milk", StatusId: 1, Rank: "V", CreatedAt: createdAt, UpdatedAt: createdAt}
+	todoJSON := `{"id":3,"title":"Buy milk","status_id":1,"done":false,"priority":0,"rank":"V","board_id":2,` +
+		`"created_at":"2025-08-30T09:00:00Z","updated_at":"2025-08-30T09:00:00Z",` +
+		`"checklist_progress":{"checked":0,"total":0},"blocked":false}`
+
+	// newSubscription delivers live events and then ends like a dropped subscription.
+	newSubscription := func(reset bool, backlog []*entities.Event, live ...*entities.Event) *entities.EventSubscription {
+		ch := make(chan *entities.Event, len(live))
+		for _, event := range live {
+			ch <- event
+		}
+		close(ch)
+		return &entities.EventSubscription{RoomId: 1, Reset: reset, Backlog: backlog, Events: ch}
+	}
+
+	testCases := []struct {
+		name           string
+		lastEventId    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success to stream events",
+			setupMock: func() {
+				sub := newSubscription(false, nil, &entities.Event{Id: 7, RoomId: 1, Type: entities.EventTodoUpdated, Data: todo})
+				mockService.EXPECT().Subscribe(gomock.Any(), 1, int64(0)).Return(sub, nil)
+				mockService.EXPECT().Unsubscribe(sub)
+			},
+			expectedStatus: 200,
+			expectedBody:   ": heartbeat\n\nid: 7\nevent: todo.updated\ndata: " + todoJSON + "\n\n",
+		},
+		{
+			name:        "Success to resume with the missed events after a reset",
+			lastEventId: "5",
+			setupMock: func() {
+				sub := newSubscription(true, []*entities.Event{{Id: 6, RoomId: 1, Type: entities.EventTodoCreated, Data: todo}})
+				mockService.EXPECT().Subscribe(gomock.Any(), 1, int64(5)).Return(sub, nil)
+				mockService.EXPECT().Unsubscribe(sub)
+			},
+			expectedStatus: 200,
+			expectedBody:   "event: reset\ndata: {}\n\nid: 6\nevent: todo.created\ndata: " + todoJSON + "\n\n: heartbeat\n\n",
+		},
+		{
+			name: "Failed with not found - Due to not exist room",
+			setupMock: func() {
+				mockService.EXPECT().Subscribe(gomock.Any(), 1, int64(0)).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}` + "\n",
+		},
+		{
+			name: "Failed with service unavailable - Due to the server shutting down",
+			setupMock: func() {
+				mockService.EXPECT().Subscribe(gomock.Any(), 1, int64(0)).Return(nil, entities.ErrEventsClosed)
+			},
+			expectedStatus: 503,
+			expectedBody:   `{"message":"Service Unavailable"}` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/rooms/1/events", nil)
+			if tc.lastEventId != "" {
+				req.Header.Set("Last-Event-ID", tc.lastEventId)
+			}
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.Equal(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// replayedHeaders are the response headers recorded for repeats; the rest are set anew.
+var replayedHeaders = []string{"Content-Type", "Location"}
+
+type IdempotencyMiddleware struct {
+	service interfaces.IdempotencyServicer
+}
+
+func NewIdempotencyMiddleware(service interfaces.IdempotencyServicer) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		service: service,
+	}
+}
+
+// Handler makes POST requests that carry an Idempotency-Key safe to retry: a repeat gets the
+// response recorded for the first request. Server errors are not recorded, so that the
+// request can be retried with the same key.
+func (im *IdempotencyMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		record, replay, err := im.service.Begin(r.Context(), key, requestHash(r, body))
+		if err != nil {
+			switch {
+			case errors.Is(err, entities.ErrInvalidIdempotencyKey):
+				response.Error(w, http.StatusBadRequest, err)
+			case errors.Is(err, entities.ErrIdempotencyKeyReused):
+				response.Error(w, http.StatusUnprocessableEntity, err)
+			case errors.Is(err, entities.ErrIdempotencyKeyInFlight):
+				response.Error(w, http.StatusConflict, err)
+			default:
+				response.Error(w, http.StatusInternalServerError, err)
+			}
+			return
+		}
+
+		if replay {
+			for name, values := range record.Header {
+				w.Header()[name] = values
+			}
+			w.Header().Set(idempotentReplayedHeader, "true")
+			w.WriteHeader(record.StatusCode)
+			w.Write(record.Body)
+			return
+		}
+
+		// The response is on its way to the client by now, so recording it must outlive the request.
+		ctx := context.WithoutCancel(r.Context())
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			if p := recover(); p != nil {
+				im.service.Release(ctx, record)
+				panic(p)
+			}
+		}()
+
+		next.ServeHTTP(rec, r)
+
+		if rec.status >= http.StatusInternalServerError {
+			err = im.service.Release(ctx, record)
+		} else {
+			err = im.service.Complete(ctx, record, rec.status, recordedHeader(rec.Header()), rec.body.Bytes())
+		}
+		if err != nil {
+			log.Printf("failed to record response for idempotency key %q: %v", key, err)
+		}
+	})
+}
+
+// requestHash tells repeats of a request from other requests reusing its key.
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, r.Method+" "+r.URL.Path+"\n")
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func recordedHeader(header http.Header) map[string][]string {
+	recorded := make(map[string][]string)
+	for _, name := range replayedHeaders {
+		if values := header.Values(name); len(values) > 0 {
+			recorded[name] = values
+		}
+	}
+
+	return recorded
+}
+
+// responseRecorder passes the response through while keeping a copy of its status and body.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(code int) {
+	rr.status = code
+	rr.ResponseWriter.WriteHeader(code)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
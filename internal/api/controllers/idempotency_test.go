@@ -0,0 +1,186 @@
+package controllers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockIdempotencyServicer(ctrl)
+	middleware := NewIdempotencyMiddleware(mockService)
+
+	var nextCalls int
+	var nextStatus int
+	handler := middleware.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCalls++
+		if nextStatus == http.StatusCreated {
+			w.Header().Set("Location", "/v1/rooms/1")
+			response.Basic(w, nextStatus, map[string]int{"id": 1})
+			return
+		}
+		response.Error(w, nextStatus, nil)
+	}))
+
+	const body = `{"name":"test room"}`
+	hash := requestHash(httptest.NewRequest(http.MethodPost, "/v1/rooms/", nil), []byte(body))
+	claimed := &entities.IdempotencyRecord{Id: 1, Key: "key", RequestHash: hash}
+
+	testCases := []struct {
+		name              string
+		method            string
+		key               string
+		nextStatus        int
+		setupMock         func()
+		expectedNextCalls int
+		expectedStatus    int
+		expectedLocation  string
+		expectedReplayed  string
+		expectedBody      string
+	}{
+		{
+			name:              "Success to pass through - Due to no key",
+			method:            http.MethodPost,
+			key:               "",
+			nextStatus:        http.StatusCreated,
+			setupMock:         func() {},
+			expectedNextCalls: 1,
+			expectedStatus:    201,
+			expectedLocation:  "/v1/rooms/1",
+			expectedBody:      `{"id":1}`,
+		},
+		{
+			name:              "Success to pass through - Due to a method other than POST",
+			method:            http.MethodPut,
+			key:               "key",
+			nextStatus:        http.StatusCreated,
+			setupMock:         func() {},
+			expectedNextCalls: 1,
+			expectedStatus:    201,
+			expectedLocation:  "/v1/rooms/1",
+			expectedBody:      `{"id":1}`,
+		},
+		{
+			name:       "Success to record the response to a new key",
+			method:     http.MethodPost,
+			key:        "key",
+			nextStatus: http.StatusCreated,
+			setupMock: func() {
+				mockService.EXPECT().Begin(gomock.Any(), "key", hash).Return(claimed, false, nil)
+				mockService.EXPECT().Complete(gomock.Any(), claimed, 201, map[string][]string{
+					"Content-Type": {"application/json; charset=utf-8"},
+					"Location":     {"/v1/rooms/1"},
+				}, []byte("{\"id\":1}\n")).Return(nil)
+			},
+			expectedNextCalls: 1,
+			expectedStatus:    201,
+			expectedLocation:  "/v1/rooms/1",
+			expectedBody:      `{"id":1}`,
+		},
+		{
+			name:   "Success to replay the recorded response",
+			method: http.MethodPost,
+			key:    "key",
+			setupMock: func() {
+				mockService.EXPECT().Begin(gomock.Any(), "key", hash).
+					Return(&entities.IdempotencyRecord{
+						Id:          1,
+						Key:         "key",
+						RequestHash: hash,
+						StatusCode:  201,
+						Header: map[string][]string{
+							"Content-Type": {"application/json; charset=utf-8"},
+							"Location":     {"/v1/rooms/1"},
+						},
+						Body: []byte("{\"id\":1}\n"),
+					}, true, nil)
+			},
+			expectedNextCalls: 0,
+			expectedStatus:    201,
+			expectedLocation:  "/v1/rooms/1",
+			expectedReplayed:  "true",
+			expectedBody:      `{"id":1}`,
+		},
+		{
+			name:       "Success to release the key - Due to a server error",
+			method:     http.MethodPost,
+			key:        "key",
+			nextStatus: http.StatusInternalServerError,
+			setupMock: func() {
+				mockService.EXPECT().Begin(gomock.Any(), "key", hash).Return(claimed, false, nil)
+				mockService.EXPECT().Release(gomock.Any(), claimed).Return(nil)
+			},
+			expectedNextCalls: 1,
+			expectedStatus:    500,
+			expectedBody:      `{"message":"Internal Server Error"}`,
+		},
+		{
+			name:   "Failed with bad request - Due to invalid key",
+			method: http.MethodPost,
+			key:    "key",
+			setupMock: func() {
+				mockService.EXPECT().Begin(gomock.Any(), "key", hash).
+					Return(nil, false, entities.ErrInvalidIdempotencyKey)
+			},
+			expectedNextCalls: 0,
+			expectedStatus:    400,
+			expectedBody:      `{"message":"Bad Request"}`,
+		},
+		{
+			name:   "Failed with unprocessable entity - Due to the key used for a different request",
+			method: http.MethodPost,
+			key:    "key",
+			setupMock: func() {
+				mockService.EXPECT().Begin(gomock.Any(), "key", hash).
+					Return(nil, false, entities.ErrIdempotencyKeyReused)
+			},
+			expectedNextCalls: 0,
+			expectedStatus:    422,
+			expectedBody:      `{"message":"Unprocessable Entity"}`,
+		},
+		{
+			name:   "Failed with conflict - Due to the request holding the key is in progress",
+			method: http.MethodPost,
+			key:    "key",
+			setupMock: func() {
+				mockService.EXPECT().Begin(gomock.Any(), "key", hash).
+					Return(nil, false, entities.ErrIdempotencyKeyInFlight)
+			},
+			expectedNextCalls: 0,
+			expectedStatus:    409,
+			expectedBody:      `{"message":"Conflict"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			nextCalls = 0
+			nextStatus = tc.nextStatus
+
+			req := httptest.NewRequest(tc.method, "/v1/rooms/", bytes.NewBufferString(body))
+			if tc.key != "" {
+				req.Header.Set(idempotencyKeyHeader, tc.key)
+			}
+			res := httptest.NewRecorder()
+
+			handler.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedNextCalls, nextCalls)
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.Equal(t, tc.expectedLocation, res.Header().Get("Location"))
+			assert.Equal(t, tc.expectedReplayed, res.Header().Get(idempotentReplayedHeader))
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type IncomingWebhookController struct {
+	service interfaces.IncomingWebhookServicer
+}
+
+func NewIncomingWebhookController(service interfaces.IncomingWebhookServicer) *IncomingWebhookController {
+	return &IncomingWebhookController{
+		service: service,
+	}
+}
+
+func (ic *IncomingWebhookController) GetAll(w http.ResponseWriter, r *http.Request) {
+	boardId, err := strconv.Atoi(r.PathValue("boardId"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	webhooks, err := ic.service.GetAll(r.Context(), boardId)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertIncomingWebhooksResponse(webhooks)
+	response.Basic(w, http.StatusOK, res)
+}
+
+// Create answers with the token, which cannot be read again later.
+func (ic *IncomingWebhookController) Create(w http.ResponseWriter, r *http.Request) {
+	boardId, err := strconv.Atoi(r.PathValue("boardId"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	token, webhook, err := ic.service.Create(r.Context(), boardId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertIncomingWebhookResponse(webhook)
+	res.Token = token
+	res.URL = fmt.Sprintf("/v1/incoming-webhooks/%s", token)
+	response.Created(w, fmt.Sprintf("/v1/boards/%d/incoming-webhooks/%d", boardId, webhook.Id), res)
+}
+
+func (ic *IncomingWebhookController) Delete(w http.ResponseWriter, r *http.Request) {
+	boardId, err := strconv.Atoi(r.PathValue("boardId"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := ic.service.Delete(r.Context(), boardId, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
+
+// Receive answers 201 with the todo it created, or 200 with the todo it updated because it
+// was sent with a known external key.
+func (ic *IncomingWebhookController) Receive(w http.ResponseWriter, r *http.Request) {
+	var req request.IncomingTodo
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	title := req.Title
+	if title == "" {
+		title = entities.TitleFromText(req.Text)
+	}
+	if title == "" {
+		response.Error(w, http.StatusBadRequest, entities.ErrInvalidTitle)
+		return
+	}
+
+	incoming := &entities.IncomingTodo{
+		ExternalKey: req.ExternalKey,
+		Title:       title,
+		Priority:    req.Priority,
+		DueDate:     req.DueDate,
+	}
+	todo, created, err := ic.service.Receive(r.Context(), r.PathValue("token"), incoming)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidIncomingWebhookToken) {
+			response.Error(w, http.StatusUnauthorized, err)
+			return
+		}
+		response.Error(w, batchOperationStatus(err), err)
+		return
+	}
+
+	res := response.ConvertTodoResponse(todo)
+	if !created {
+		response.Basic(w, http.StatusOK, res)
+		return
+	}
+	response.Created(w, fmt.Sprintf("/v1/boards/%d/todos/%d", todo.BoardId, todo.Id), res)
+}
@@ -0,0 +1,195 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateIncomingWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockIncomingWebhookServicer(ctrl)
+	controller := NewIncomingWebhookController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/incoming-webhooks/", controller.Create)
+
+	testCases := []struct {
+		name             string
+		setupMock        func()
+		expectedStatus   int
+		expectedLocation string
+		expectedBody     string
+	}{
+		{
+			name: "Success to create incoming webhook with its token",
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1).Return("abc", &entities.IncomingWebhook{
+					Id:        2,
+					BoardId:   1,
+					TokenHash: entities.HashIncomingWebhookToken("abc"),
+					CreatedAt: time.Date(2025, 9, 13, 9, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+			expectedStatus:   201,
+			expectedLocation: "/v1/boards/1/incoming-webhooks/2",
+			expectedBody: `{
+				"id":2,
+				"board_id":1,
+				"token":"abc",
+				"url":"/v1/incoming-webhooks/abc",
+				"created_at":"2025-09-13T09:00:00Z"
+			}`,
+		},
+		{
+			name: "Failed with not found - Due to the board does not exist",
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1).Return("", nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			req := httptest.NewRequest(http.MethodPost, "/v1/boards/1/incoming-webhooks/", nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.Equal(t, tc.expectedLocation, res.Header().Get("Location"))
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestReceiveIncomingWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockIncomingWebhookServicer(ctrl)
+	controller := NewIncomingWebhookController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/incoming-webhooks/{token}", controller.Receive)
+
+	createdAt := time.Date(2025, 9, 13, 9, 0, 0, 0, time.UTC)
+	todo := &entities.Todo{Id: 5, BoardId: 1, Title: "disk full", StatusId: 1, Rank: "A", CreatedAt: createdAt, UpdatedAt: createdAt}
+	todoBody := `{
+		"id":5,
+		"title":"disk full",
+		"status_id":1,
+		"done":false,
+		"priority":0,
+		"rank":"A",
+		"board_id":1,
+		"created_at":"2025-09-13T09:00:00Z",
+		"updated_at":"2025-09-13T09:00:00Z",
+		"checklist_progress":{"checked":0,"total":0},
+		"blocked":false
+	}`
+
+	testCases := []struct {
+		name             string
+		requestBody      string
+		setupMock        func()
+		expectedStatus   int
+		expectedLocation string
+		expectedBody     string
+	}{
+		{
+			name:        "Success to create todo from payload",
+			requestBody: `{"title":"disk full","external_key":"alert-1"}`,
+			setupMock: func() {
+				mockService.EXPECT().Receive(gomock.Any(), "abc", &entities.IncomingTodo{ExternalKey: "alert-1", Title: "disk full"}).
+					Return(todo, true, nil)
+			},
+			expectedStatus:   201,
+			expectedLocation: "/v1/boards/1/todos/5",
+			expectedBody:     todoBody,
+		},
+		{
+			name:        "Success to create todo from Slack message",
+			requestBody: `{"text":"\n  disk full  \non db-1"}`,
+			setupMock: func() {
+				mockService.EXPECT().Receive(gomock.Any(), "abc", &entities.IncomingTodo{Title: "disk full"}).
+					Return(todo, true, nil)
+			},
+			expectedStatus:   201,
+			expectedLocation: "/v1/boards/1/todos/5",
+			expectedBody:     todoBody,
+		},
+		{
+			name:        "Success to update todo of known external key",
+			requestBody: `{"title":"disk full","external_key":"alert-1"}`,
+			setupMock: func() {
+				mockService.EXPECT().Receive(gomock.Any(), "abc", &entities.IncomingTodo{ExternalKey: "alert-1", Title: "disk full"}).
+					Return(todo, false, nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   todoBody,
+		},
+		{
+			name:           "Failed with bad request - Due to no title nor text",
+			requestBody:    `{"text":"  \n"}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to negative priority",
+			requestBody:    `{"title":"disk full","priority":-1}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with unauthorized - Due to unknown token",
+			requestBody: `{"title":"disk full"}`,
+			setupMock: func() {
+				mockService.EXPECT().Receive(gomock.Any(), "abc", gomock.Any()).
+					Return(nil, false, entities.ErrInvalidIncomingWebhookToken)
+			},
+			expectedStatus: 401,
+			expectedBody:   `{"message":"Unauthorized"}`,
+		},
+		{
+			name:        "Failed with conflict - Due to archived board",
+			requestBody: `{"title":"disk full"}`,
+			setupMock: func() {
+				mockService.EXPECT().Receive(gomock.Any(), "abc", gomock.Any()).
+					Return(nil, false, entities.ErrArchived)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/v1/incoming-webhooks/abc", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.Equal(t, tc.expectedLocation, res.Header().Get("Location"))
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/services"
+)
+
+// withQueryTimeout puts a deadline on the request context, so queries of a slow request are
+// canceled instead of holding a connection. A non-positive timeout leaves requests unbounded.
+func withQueryTimeout(next http.Handler, timeout time.Duration) http.Handler {
+	if timeout <= 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+const (
+	actorHeader     = "X-Actor"
+	requestIdHeader = "X-Request-Id"
+)
+
+// Actors and request ids longer than this do not fit the audit log.
+const actorMaxLength = 64
+
+// withActor attaches who makes the request to its context for the audit log, and echoes the
+// request id, generated unless the client sent one. The API has no authentication yet, so the
+// actor is whatever the client declares in X-Actor.
+func withActor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.Header.Get(actorHeader)
+		if len(name) > actorMaxLength {
+			response.Error(w, http.StatusBadRequest, fmt.Errorf("%s is longer than %d bytes", actorHeader, actorMaxLength))
+			return
+		}
+
+		requestId := r.Header.Get(requestIdHeader)
+		if requestId == "" || len(requestId) > actorMaxLength {
+			requestId = newRequestId()
+		}
+		w.Header().Set(requestIdHeader, requestId)
+
+		actor := entities.Actor{
+			Name:      name,
+			RequestId: requestId,
+			IP:        remoteIP(r),
+		}
+		next.ServeHTTP(w, r.WithContext(services.WithActor(r.Context(), actor)))
+	})
+}
+
+func newRequestId() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
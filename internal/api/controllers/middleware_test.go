@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithQueryTimeout(t *testing.T) {
+	testCases := []struct {
+		name             string
+		timeout          time.Duration
+		expectedDeadline bool
+	}{
+		{
+			name:             "Success to set a deadline",
+			timeout:          time.Second,
+			expectedDeadline: true,
+		},
+		{
+			name:             "Success to leave the request unbounded - Due to a zero timeout",
+			timeout:          0,
+			expectedDeadline: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var hasDeadline bool
+			handler := withQueryTimeout(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, hasDeadline = r.Context().Deadline()
+			}), tc.timeout)
+
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, tc.expectedDeadline, hasDeadline)
+		})
+	}
+}
+
+func TestWithActor(t *testing.T) {
+	testCases := []struct {
+		name           string
+		header         map[string]string
+		expectedStatus int
+		expectedActor  entities.Actor
+	}{
+		{
+			name:           "Success to attach the declared actor and request id",
+			header:         map[string]string{"X-Actor": "alice", "X-Request-Id": "req-1"},
+			expectedStatus: 200,
+			expectedActor:  entities.Actor{Name: "alice", RequestId: "req-1", IP: "192.0.2.1"},
+		},
+		{
+			name:           "Success to attach an anonymous actor",
+			header:         map[string]string{"X-Request-Id": "req-2"},
+			expectedStatus: 200,
+			expectedActor:  entities.Actor{RequestId: "req-2", IP: "192.0.2.1"},
+		},
+		{
+			name:           "Failed with bad request - Due to the actor is too long",
+			header:         map[string]string{"X-Actor": strings.Repeat("a", 65)},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actor entities.Actor
+			handler := withActor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actor = services.ActorFrom(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			for name, value := range tc.header {
+				req.Header.Set(name, value)
+			}
+			res := httptest.NewRecorder()
+
+			handler.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.Equal(t, tc.expectedActor, actor)
+			if res.Code == http.StatusOK {
+				assert.Equal(t, tc.expectedActor.RequestId, res.Header().Get("X-Request-Id"))
+			}
+		})
+	}
+}
+
+func TestWithActorGeneratesRequestId(t *testing.T) {
+	var actor entities.Actor
+	handler := withActor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor = services.ActorFrom(r.Context())
+	}))
+	res := httptest.NewRecorder()
+
+	handler.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/", nil))
+
+	assert.Len(t, actor.RequestId, 32)
+	assert.Equal(t, actor.RequestId, res.Header().Get("X-Request-Id"))
+}
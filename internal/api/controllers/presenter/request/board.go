@@ -0,0 +1,10 @@
+package request
+
+type Board struct {
+	Name     string `json:"name" validate:"required,max=50"`
+	Priority int    `json:"priority" validate:"min=0"`
+}
+
+type BoardOrder struct {
+	Ids []int `json:"ids" validate:"required"`
+}
@@ -0,0 +1,22 @@
+package request
+
+const (
+	BoardMessageEditing = "editing"
+	BoardMessageCreate  = "create"
+	BoardMessageUpdate  = "update"
+	BoardMessageMove    = "move"
+	BoardMessageDelete  = "delete"
+)
+
+// BoardMessage is a message from a client of a board's WebSocket. Type decides which of the
+// other fields are read: TodoId for editing, Todo for create and update, Id for update, move
+// and delete, and BeforeId and AfterId for move. The board of Todo is the socket's board.
+type BoardMessage struct {
+	Type      string `json:"type" validate:"required,oneof=editing create update move delete"`
+	RequestId string `json:"request_id" validate:"max=64"`
+	Id        int    `json:"id"`
+	TodoId    *int   `json:"todo_id,omitempty"`
+	Todo      *Todo  `json:"todo,omitempty"`
+	BeforeId  *int   `json:"before_id,omitempty"`
+	AfterId   *int   `json:"after_id,omitempty"`
+}
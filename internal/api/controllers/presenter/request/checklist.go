@@ -0,0 +1,10 @@
+package request
+
+type ChecklistItem struct {
+	Text    string `json:"text" validate:"required,max=100"`
+	Checked bool   `json:"checked"`
+}
+
+type ChecklistOrder struct {
+	Ids []int `json:"ids" validate:"required"`
+}
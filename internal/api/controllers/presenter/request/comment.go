@@ -0,0 +1,5 @@
+package request
+
+type Comment struct {
+	Body string `json:"body" validate:"required,max=1000"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type Dependency struct {
+	BlockerId int `json:"blocker_id" validate:"required"`
+}
@@ -0,0 +1,13 @@
+package request
+
+import "time"
+
+// IncomingTodo is what external systems post to an incoming webhook. Chat tools that only
+// send Slack's {"text": ...} get a todo titled after the first line of Text.
+type IncomingTodo struct {
+	Title       string     `json:"title" validate:"max=50"`
+	Text        string     `json:"text"`
+	ExternalKey string     `json:"external_key" validate:"max=255"`
+	Priority    *int       `json:"priority,omitempty" validate:"omitempty,min=0"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+}
@@ -0,0 +1,9 @@
+package request
+
+import "time"
+
+type Reminder struct {
+	RemindAt      *time.Time `json:"remind_at,omitempty"`
+	OffsetMinutes *int       `json:"offset_minutes,omitempty" validate:"omitempty,min=0"`
+	Channel       string     `json:"channel" validate:"omitempty,oneof=log webhook"`
+}
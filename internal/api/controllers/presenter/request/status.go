@@ -0,0 +1,10 @@
+package request
+
+type Status struct {
+	Name     string `json:"name" validate:"required,max=30"`
+	Category string `json:"category" validate:"required,oneof=todo doing done"`
+}
+
+type StatusTransitions struct {
+	StatusIds []int `json:"status_ids" validate:"dive,min=1"`
+}
@@ -0,0 +1,25 @@
+package request
+
+import "time"
+
+type SyncPush struct {
+	Mutations []*SyncMutation `json:"mutations" validate:"required,min=1,dive,required"`
+}
+
+// SyncMutation creates, replaces or deletes a room, board or todo. ClientId is echoed back
+// so that clients can match the results to their queue.
+type SyncMutation struct {
+	ClientId    string     `json:"client_id" validate:"max=64"`
+	Entity      string     `json:"entity" validate:"required,oneof=room board todo"`
+	Op          string     `json:"op" validate:"required,oneof=create update delete"`
+	Id          int        `json:"id"`
+	BaseVersion int64      `json:"base_version"`
+	Name        string     `json:"name" validate:"max=50"`
+	Priority    int        `json:"priority"`
+	RoomId      int        `json:"room_id"`
+	BoardId     int        `json:"board_id"`
+	Title       string     `json:"title" validate:"max=50"`
+	Done        bool       `json:"done"`
+	StatusId    *int       `json:"status_id,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+}
@@ -6,6 +6,25 @@ type Todo struct {
 	BoardId  int        `json:"board_id" validate:"required"`
 	Title    string     `json:"title" validate:"required,max=50"`
 	Done     bool       `json:"done"`
+	StatusId *int       `json:"status_id,omitempty"`
 	Priority int        `json:"priority"`
 	DueDate  *time.Time `json:"due_date,omitempty"`
+
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+}
+
+// TodoMove places a todo right before BeforeId or right after AfterId, or between both.
+type TodoMove struct {
+	BeforeId *int `json:"before_id,omitempty"`
+	AfterId  *int `json:"after_id,omitempty"`
+}
+
+// TodoTransfer names the board a todo is moved or copied to.
+type TodoTransfer struct {
+	BoardId int `json:"board_id" validate:"required"`
+}
+
+type Recurrence struct {
+	Rule     string `json:"rule" validate:"required,max=255"`
+	Timezone string `json:"timezone" validate:"max=64"`
 }
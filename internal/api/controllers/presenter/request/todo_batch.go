@@ -0,0 +1,21 @@
+package request
+
+import "time"
+
+type TodoBatch struct {
+	Operations []*TodoBatchOperation `json:"operations" validate:"required,min=1,dive,required"`
+}
+
+// TodoBatchOperation updates the fields that are set, moves the todo to BoardId or deletes it.
+// A due date cannot be told apart from an absent one when null, so ClearDueDate removes it.
+type TodoBatchOperation struct {
+	Op           string     `json:"op" validate:"required,oneof=update move delete"`
+	Id           int        `json:"id" validate:"required"`
+	Title        *string    `json:"title,omitempty" validate:"omitempty,max=50"`
+	Done         *bool      `json:"done,omitempty"`
+	StatusId     *int       `json:"status_id,omitempty"`
+	Priority     *int       `json:"priority,omitempty"`
+	DueDate      *time.Time `json:"due_date,omitempty"`
+	ClearDueDate bool       `json:"clear_due_date,omitempty"`
+	BoardId      *int       `json:"board_id,omitempty"`
+}
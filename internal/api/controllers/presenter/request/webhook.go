@@ -0,0 +1,10 @@
+package request
+
+type Webhook struct {
+	URL string `json:"url" validate:"required,max=2048"`
+	// Secret may be left out when updating to keep the current one.
+	Secret     string   `json:"secret" validate:"max=255"`
+	EventTypes []string `json:"event_types" validate:"required,min=1"`
+	// Enabled is only read by updates, where it defaults to true. Webhooks start out enabled.
+	Enabled *bool `json:"enabled"`
+}
@@ -0,0 +1,138 @@
+package response
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListActivity struct {
+	Activities []*Activity `json:"activities"`
+}
+
+type Activity struct {
+	Id          int       `json:"id"`
+	Kind        string    `json:"kind"`
+	Actor       string    `json:"actor"`
+	RoomId      int       `json:"room_id"`
+	BoardId     int       `json:"board_id,omitempty"`
+	TodoId      int       `json:"todo_id,omitempty"`
+	FromBoardId *int      `json:"from_board_id,omitempty"`
+	Title       string    `json:"title,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type FeedToken struct {
+	Token string `json:"token"`
+}
+
+func ConvertActivityResponse(activity *entities.Activity) *Activity {
+	return &Activity{
+		Id:          activity.Id,
+		Kind:        activity.Kind,
+		Actor:       activity.Actor,
+		RoomId:      activity.RoomId,
+		BoardId:     activity.BoardId,
+		TodoId:      activity.TodoId,
+		FromBoardId: activity.FromBoardId,
+		Title:       activity.Title,
+		CreatedAt:   activity.CreatedAt,
+	}
+}
+
+func ConvertActivitiesResponse(activities []*entities.Activity) *ListActivity {
+	listActivity := []*Activity{}
+
+	for _, activity := range activities {
+		listActivity = append(listActivity, ConvertActivityResponse(activity))
+	}
+	return &ListActivity{Activities: listActivity}
+}
+
+type AtomFeed struct {
+	XMLName xml.Name     `xml:"http://www.w3.org/2005/Atom feed"`
+	Id      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Updated time.Time    `xml:"updated"`
+	Entries []*AtomEntry `xml:"entry"`
+}
+
+type AtomEntry struct {
+	Id      string     `xml:"id"`
+	Title   string     `xml:"title"`
+	Updated time.Time  `xml:"updated"`
+	Author  AtomAuthor `xml:"author"`
+}
+
+type AtomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// ConvertActivityFeed renders the room's activity, newest first, as an Atom feed.
+func ConvertActivityFeed(room *entities.Room, activities []*entities.Activity) *AtomFeed {
+	feed := &AtomFeed{
+		Id:      fmt.Sprintf("urn:sample-todo-app:rooms:%d:activity", room.Id),
+		Title:   room.Name + " activity",
+		Updated: room.UpdatedAt.UTC(),
+		Entries: []*AtomEntry{},
+	}
+	if len(activities) > 0 {
+		feed.Updated = activities[0].CreatedAt.UTC()
+	}
+
+	for _, activity := range activities {
+		feed.Entries = append(feed.Entries, &AtomEntry{
+			Id:      fmt.Sprintf("urn:sample-todo-app:activities:%d", activity.Id),
+			Title:   describeActivity(activity),
+			Updated: activity.CreatedAt.UTC(),
+			Author:  AtomAuthor{Name: actorName(activity.Actor)},
+		})
+	}
+
+	return feed
+}
+
+func describeActivity(activity *entities.Activity) string {
+	actor := actorName(activity.Actor)
+	switch activity.Kind {
+	case entities.ActivityTodoCreated:
+		return fmt.Sprintf("%s created %q", actor, activity.Title)
+	case entities.ActivityTodoCompleted:
+		return fmt.Sprintf("%s completed %q", actor, activity.Title)
+	case entities.ActivityTodoMoved:
+		if activity.FromBoardId != nil {
+			return fmt.Sprintf("%s moved %q from board %d to board %d", actor, activity.Title, *activity.FromBoardId, activity.BoardId)
+		}
+		return fmt.Sprintf("%s moved %q to board %d", actor, activity.Title, activity.BoardId)
+	case entities.ActivityTodoCommented:
+		return fmt.Sprintf("%s commented on %q", actor, activity.Title)
+	case entities.ActivityMemberJoined:
+		return fmt.Sprintf("%s joined the room", actor)
+	}
+	return fmt.Sprintf("%s changed %q", actor, activity.Title)
+}
+
+// actorName stands in for changes made without an X-Actor header.
+func actorName(actor string) string {
+	if actor == "" {
+		return "Someone"
+	}
+	return actor
+}
+
+func Atom(w http.ResponseWriter, code int, feed *AtomFeed) {
+	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	w.WriteHeader(code)
+
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		log.Printf("Error writing XML: %v", err)
+		return
+	}
+	if err := xml.NewEncoder(w).Encode(feed); err != nil {
+		log.Printf("Error encoding XML: %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListAuditEvent struct {
+	Events []*AuditEvent `json:"events"`
+}
+
+type AuditEvent struct {
+	Id         int             `json:"id"`
+	Actor      string          `json:"actor"`
+	EntityType string          `json:"entity_type"`
+	EntityId   int             `json:"entity_id"`
+	Action     string          `json:"action"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	RequestId  string          `json:"request_id"`
+	IP         string          `json:"ip"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+func ConvertAuditEventResponse(event *entities.AuditEvent) *AuditEvent {
+	return &AuditEvent{
+		Id:         event.Id,
+		Actor:      event.Actor,
+		EntityType: event.EntityType,
+		EntityId:   event.EntityId,
+		Action:     event.Action,
+		Before:     event.Before,
+		After:      event.After,
+		RequestId:  event.RequestId,
+		IP:         event.IP,
+		CreatedAt:  event.CreatedAt,
+	}
+}
+
+func ConvertAuditEventsResponse(events []*entities.AuditEvent) *ListAuditEvent {
+	listEvent := []*AuditEvent{}
+
+	for _, event := range events {
+		listEvent = append(listEvent, ConvertAuditEventResponse(event))
+	}
+	return &ListAuditEvent{Events: listEvent}
+}
@@ -0,0 +1,46 @@
+package response
+
+import (
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListBoard struct {
+	Boards []*Board `json:"boards"`
+}
+
+type Board struct {
+	Id         int        `json:"id"`
+	Name       string     `json:"name"`
+	Priority   int        `json:"priority"`
+	Position   int        `json:"position"`
+	RoomId     int        `json:"room_id"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+}
+
+func ConvertBoardResponse(board *entities.Board) *Board {
+	return &Board{
+		Id:         board.Id,
+		Name:       board.Name,
+		Priority:   board.Priority,
+		Position:   board.Position,
+		RoomId:     board.RoomId,
+		ArchivedAt: board.ArchivedAt,
+		CreatedAt:  board.CreatedAt,
+		UpdatedAt:  board.UpdatedAt,
+		DeletedAt:  board.DeletedAt,
+	}
+}
+
+func ConvertBoardsResponse(boards []*entities.Board) *ListBoard {
+	listBoard := []*Board{}
+
+	for _, board := range boards {
+		listBoard = append(listBoard, ConvertBoardResponse(board))
+	}
+	return &ListBoard{Boards: listBoard}
+}
@@ -0,0 +1,69 @@
+package response
+
+import (
+	"net/http"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+const (
+	BoardMessageEvent    = "event"
+	BoardMessageReset    = "reset"
+	BoardMessagePresence = "presence"
+	BoardMessageAck      = "ack"
+	BoardMessageError    = "error"
+)
+
+// BoardMessage is a message to a client of a board's WebSocket. Replies to the client's own
+// messages carry its request id.
+type BoardMessage struct {
+	Type      string    `json:"type"`
+	RequestId string    `json:"request_id,omitempty"`
+	EventId   int64     `json:"event_id,omitempty"`
+	Event     string    `json:"event,omitempty"`
+	Todo      *Todo     `json:"todo,omitempty"`
+	Viewers   []*Viewer `json:"viewers,omitempty"`
+	Status    int       `json:"status,omitempty"`
+	Message   string    `json:"message,omitempty"`
+}
+
+type Viewer struct {
+	Actor         string `json:"actor"`
+	EditingTodoId *int   `json:"editing_todo_id,omitempty"`
+}
+
+func ConvertBoardEventMessage(event *entities.Event) *BoardMessage {
+	msg := &BoardMessage{Type: BoardMessageEvent, EventId: event.Id, Event: event.Type}
+	if todo, ok := event.Data.(*entities.Todo); ok {
+		msg.Todo = ConvertTodoResponse(todo)
+	}
+
+	return msg
+}
+
+func BoardResetMessage() *BoardMessage {
+	return &BoardMessage{Type: BoardMessageReset}
+}
+
+func ConvertPresenceMessage(viewers []entities.Presence) *BoardMessage {
+	msg := &BoardMessage{Type: BoardMessagePresence}
+	for _, viewer := range viewers {
+		msg.Viewers = append(msg.Viewers, &Viewer{Actor: viewer.Actor, EditingTodoId: viewer.EditingTodoId})
+	}
+
+	return msg
+}
+
+// BoardAckMessage confirms a mutation, with the todo when it was created.
+func BoardAckMessage(requestId string, todo *entities.Todo) *BoardMessage {
+	msg := &BoardMessage{Type: BoardMessageAck, RequestId: requestId}
+	if todo != nil {
+		msg.Todo = ConvertTodoResponse(todo)
+	}
+
+	return msg
+}
+
+func BoardErrorMessage(requestId string, status int) *BoardMessage {
+	return &BoardMessage{Type: BoardMessageError, RequestId: requestId, Status: status, Message: http.StatusText(status)}
+}
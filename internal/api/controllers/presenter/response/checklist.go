@@ -0,0 +1,54 @@
+package response
+
+import (
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListChecklistItem struct {
+	Items []*ChecklistItem `json:"items"`
+}
+
+type ChecklistItem struct {
+	Id        int       `json:"id"`
+	TodoId    int       `json:"todo_id"`
+	Text      string    `json:"text"`
+	Checked   bool      `json:"checked"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ChecklistProgress struct {
+	Checked int `json:"checked"`
+	Total   int `json:"total"`
+}
+
+func convertChecklistItemResponse(item *entities.ChecklistItem) *ChecklistItem {
+	return &ChecklistItem{
+		Id:        item.Id,
+		TodoId:    item.TodoId,
+		Text:      item.Text,
+		Checked:   item.Checked,
+		Position:  item.Position,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
+
+func ConvertChecklistResponse(items []*entities.ChecklistItem) *ListChecklistItem {
+	listItem := []*ChecklistItem{}
+
+	for _, item := range items {
+		listItem = append(listItem, convertChecklistItemResponse(item))
+	}
+	return &ListChecklistItem{Items: listItem}
+}
+
+func convertChecklistProgressResponse(progress entities.ChecklistProgress) ChecklistProgress {
+	return ChecklistProgress{
+		Checked: progress.Checked,
+		Total:   progress.Total,
+	}
+}
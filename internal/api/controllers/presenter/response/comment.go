@@ -0,0 +1,38 @@
+package response
+
+import (
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListComment struct {
+	Comments []*Comment `json:"comments"`
+}
+
+type Comment struct {
+	Id        int       `json:"id"`
+	TodoId    int       `json:"todo_id"`
+	Author    string    `json:"author"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ConvertCommentResponse(comment *entities.Comment) *Comment {
+	return &Comment{
+		Id:        comment.Id,
+		TodoId:    comment.TodoId,
+		Author:    comment.Author,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+	}
+}
+
+func ConvertCommentsResponse(comments []*entities.Comment) *ListComment {
+	listComment := []*Comment{}
+
+	for _, comment := range comments {
+		listComment = append(listComment, ConvertCommentResponse(comment))
+	}
+	return &ListComment{Comments: listComment}
+}
@@ -0,0 +1,34 @@
+package response
+
+import (
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListDependency struct {
+	Blockers []*Dependency `json:"blockers"`
+}
+
+type Dependency struct {
+	TodoId    int       `json:"todo_id"`
+	BlockerId int       `json:"blocker_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func convertDependencyResponse(dependency *entities.TodoDependency) *Dependency {
+	return &Dependency{
+		TodoId:    dependency.TodoId,
+		BlockerId: dependency.BlockerId,
+		CreatedAt: dependency.CreatedAt,
+	}
+}
+
+func ConvertDependenciesResponse(dependencies []*entities.TodoDependency) *ListDependency {
+	listDependency := []*Dependency{}
+
+	for _, dependency := range dependencies {
+		listDependency = append(listDependency, convertDependencyResponse(dependency))
+	}
+	return &ListDependency{Blockers: listDependency}
+}
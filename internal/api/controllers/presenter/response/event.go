@@ -0,0 +1,43 @@
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+// ResetEvent tells a client that it missed events and should reload the room.
+const ResetEvent = "reset"
+
+// WriteEvent writes the event in the text/event-stream format, with its data converted
+// like the REST responses of the same entity.
+func WriteEvent(w io.Writer, event *entities.Event) error {
+	var data any
+	switch v := event.Data.(type) {
+	case *entities.Todo:
+		data = ConvertTodoResponse(v)
+	default:
+		data = v
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Type, b)
+	return err
+}
+
+func WriteResetEvent(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "event: %s\ndata: {}\n\n", ResetEvent)
+	return err
+}
+
+// WriteHeartbeat writes a comment, which clients ignore, to keep an idle stream open.
+func WriteHeartbeat(w io.Writer) error {
+	_, err := io.WriteString(w, ": heartbeat\n\n")
+	return err
+}
@@ -0,0 +1,37 @@
+package response
+
+import (
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListIncomingWebhook struct {
+	IncomingWebhooks []*IncomingWebhook `json:"incoming_webhooks"`
+}
+
+// IncomingWebhook carries the token and the URL to post to only when it was just created.
+type IncomingWebhook struct {
+	Id        int       `json:"id"`
+	BoardId   int       `json:"board_id"`
+	Token     string    `json:"token,omitempty"`
+	URL       string    `json:"url,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ConvertIncomingWebhookResponse(webhook *entities.IncomingWebhook) *IncomingWebhook {
+	return &IncomingWebhook{
+		Id:        webhook.Id,
+		BoardId:   webhook.BoardId,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+func ConvertIncomingWebhooksResponse(webhooks []*entities.IncomingWebhook) *ListIncomingWebhook {
+	listWebhook := []*IncomingWebhook{}
+
+	for _, webhook := range webhooks {
+		listWebhook = append(listWebhook, ConvertIncomingWebhookResponse(webhook))
+	}
+	return &ListIncomingWebhook{IncomingWebhooks: listWebhook}
+}
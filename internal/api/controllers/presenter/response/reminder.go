@@ -0,0 +1,44 @@
+package response
+
+import (
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListReminder struct {
+	Reminders []*Reminder `json:"reminders"`
+}
+
+type Reminder struct {
+	Id            int        `json:"id"`
+	TodoId        int        `json:"todo_id"`
+	RemindAt      *time.Time `json:"remind_at,omitempty"`
+	OffsetMinutes *int       `json:"offset_minutes,omitempty"`
+	Channel       string     `json:"channel"`
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func convertReminderResponse(reminder *entities.Reminder) *Reminder {
+	return &Reminder{
+		Id:            reminder.Id,
+		TodoId:        reminder.TodoId,
+		RemindAt:      reminder.RemindAt,
+		OffsetMinutes: reminder.OffsetMinutes,
+		Channel:       reminder.Channel,
+		SentAt:        reminder.SentAt,
+		CreatedAt:     reminder.CreatedAt,
+		UpdatedAt:     reminder.UpdatedAt,
+	}
+}
+
+func ConvertRemindersResponse(reminders []*entities.Reminder) *ListReminder {
+	listReminder := []*Reminder{}
+
+	for _, reminder := range reminders {
+		listReminder = append(listReminder, convertReminderResponse(reminder))
+	}
+	return &ListReminder{Reminders: listReminder}
+}
@@ -20,6 +20,12 @@ func Basic(w http.ResponseWriter, code int, resItem any) {
 	}
 }
 
+// Created writes resItem with 201 Created and points Location at the new resource.
+func Created(w http.ResponseWriter, location string, resItem any) {
+	w.Header().Set("Location", location)
+	Basic(w, http.StatusCreated, resItem)
+}
+
 func Error(w http.ResponseWriter, code int, err error) {
 	log.Printf("Error output by controller: %v", err)
 	res := BasicResponse{Message: http.StatusText(code)}
@@ -11,18 +11,22 @@ type ListRoom struct {
 }
 
 type Room struct {
-	Id        int       `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	Id         int        `json:"id"`
+	Name       string     `json:"name"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
 }
 
-func convertRoomResponse(room *entities.Room) *Room {
+func ConvertRoomResponse(room *entities.Room) *Room {
 	return &Room{
-		Id:        room.Id,
-		Name:      room.Name,
-		CreatedAt: room.CreatedAt,
-		UpdatedAt: room.UpdatedAt,
+		Id:         room.Id,
+		Name:       room.Name,
+		ArchivedAt: room.ArchivedAt,
+		CreatedAt:  room.CreatedAt,
+		UpdatedAt:  room.UpdatedAt,
+		DeletedAt:  room.DeletedAt,
 	}
 }
 
@@ -30,7 +34,7 @@ func ConvertRoomsResponse(rooms []*entities.Room) *ListRoom {
 	listRoom := []*Room{}
 
 	for _, room := range rooms {
-		listRoom = append(listRoom, convertRoomResponse(room))
+		listRoom = append(listRoom, ConvertRoomResponse(room))
 	}
 	return &ListRoom{Rooms: listRoom}
 }
@@ -0,0 +1,36 @@
+package response
+
+import (
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListRoomMember struct {
+	Members []*RoomMember `json:"members"`
+}
+
+type RoomMember struct {
+	Id       int       `json:"id"`
+	RoomId   int       `json:"room_id"`
+	Actor    string    `json:"actor"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+func ConvertRoomMemberResponse(member *entities.RoomMember) *RoomMember {
+	return &RoomMember{
+		Id:       member.Id,
+		RoomId:   member.RoomId,
+		Actor:    member.Actor,
+		JoinedAt: member.JoinedAt,
+	}
+}
+
+func ConvertRoomMembersResponse(members []*entities.RoomMember) *ListRoomMember {
+	listMember := []*RoomMember{}
+
+	for _, member := range members {
+		listMember = append(listMember, ConvertRoomMemberResponse(member))
+	}
+	return &ListRoomMember{Members: listMember}
+}
@@ -0,0 +1,45 @@
+package response
+
+import (
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListStatus struct {
+	Statuses []*Status `json:"statuses"`
+}
+
+type Status struct {
+	Id          int       `json:"id"`
+	RoomId      int       `json:"room_id"`
+	Name        string    `json:"name"`
+	Category    string    `json:"category"`
+	Position    int       `json:"position"`
+	Transitions []int     `json:"transitions"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func convertStatusResponse(status *entities.Status) *Status {
+	return &Status{
+		Id:       status.Id,
+		RoomId:   status.RoomId,
+		Name:     status.Name,
+		Category: status.Category,
+		Position: status.Position,
+		// An empty list means todos may move to any status of the room.
+		Transitions: append([]int{}, status.Transitions...),
+		CreatedAt:   status.CreatedAt,
+		UpdatedAt:   status.UpdatedAt,
+	}
+}
+
+func ConvertStatusesResponse(statuses []*entities.Status) *ListStatus {
+	listStatus := []*Status{}
+
+	for _, status := range statuses {
+		listStatus = append(listStatus, convertStatusResponse(status))
+	}
+	return &ListStatus{Statuses: listStatus}
+}
@@ -0,0 +1,119 @@
+package response
+
+import (
+	"net/http"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+// Sync lists the entities that changed with their versions, and the ids of those deleted.
+type Sync struct {
+	Rooms     []*SyncRoom  `json:"rooms"`
+	Boards    []*SyncBoard `json:"boards"`
+	Todos     []*SyncTodo  `json:"todos"`
+	Deleted   *SyncDeleted `json:"deleted"`
+	NextToken string       `json:"next_token"`
+	HasMore   bool         `json:"has_more"`
+}
+
+type SyncRoom struct {
+	*Room
+	Version int64 `json:"version"`
+}
+
+type SyncBoard struct {
+	*Board
+	Version int64 `json:"version"`
+}
+
+type SyncTodo struct {
+	*Todo
+	Version int64 `json:"version"`
+}
+
+type SyncDeleted struct {
+	Rooms  []int `json:"rooms"`
+	Boards []int `json:"boards"`
+	Todos  []int `json:"todos"`
+}
+
+type SyncPush struct {
+	Results []*SyncResult `json:"results"`
+}
+
+// SyncResult reports one mutation with the status code it would have had on its own. Data
+// is the entity as it is now, which on 409 is what the client has to reconcile with.
+type SyncResult struct {
+	ClientId string `json:"client_id,omitempty"`
+	Status   int    `json:"status"`
+	Message  string `json:"message"`
+	Entity   string `json:"entity"`
+	Id       int    `json:"id,omitempty"`
+	Version  int64  `json:"version,omitempty"`
+	Deleted  bool   `json:"deleted,omitempty"`
+	Data     any    `json:"data,omitempty"`
+}
+
+func ConvertSyncResponse(page *entities.SyncPage) *Sync {
+	res := &Sync{
+		Rooms:     []*SyncRoom{},
+		Boards:    []*SyncBoard{},
+		Todos:     []*SyncTodo{},
+		Deleted:   &SyncDeleted{Rooms: []int{}, Boards: []int{}, Todos: []int{}},
+		NextToken: page.Token,
+		HasMore:   page.HasMore,
+	}
+
+	for _, change := range page.Changes {
+		switch entity := change.Entity.(type) {
+		case *entities.Room:
+			res.Rooms = append(res.Rooms, &SyncRoom{Room: ConvertRoomResponse(entity), Version: change.Version})
+		case *entities.Board:
+			res.Boards = append(res.Boards, &SyncBoard{Board: ConvertBoardResponse(entity), Version: change.Version})
+		case *entities.Todo:
+			res.Todos = append(res.Todos, &SyncTodo{Todo: ConvertTodoResponse(entity), Version: change.Version})
+		case nil:
+			switch change.EntityType {
+			case entities.SyncEntityRoom:
+				res.Deleted.Rooms = append(res.Deleted.Rooms, change.EntityId)
+			case entities.SyncEntityBoard:
+				res.Deleted.Boards = append(res.Deleted.Boards, change.EntityId)
+			case entities.SyncEntityTodo:
+				res.Deleted.Todos = append(res.Deleted.Todos, change.EntityId)
+			}
+		}
+	}
+
+	return res
+}
+
+func ConvertSyncPushResponse(clientIds []string, mutations []*entities.SyncMutation, results []*entities.SyncResult, statuses []int) *SyncPush {
+	listResult := []*SyncResult{}
+
+	for i, result := range results {
+		res := &SyncResult{
+			ClientId: clientIds[i],
+			Status:   statuses[i],
+			Message:  http.StatusText(statuses[i]),
+			Entity:   mutations[i].EntityType,
+			Id:       mutations[i].Id,
+		}
+
+		if change := result.Change; change != nil {
+			res.Id = change.EntityId
+			res.Version = change.Version
+			res.Deleted = change.Entity == nil
+			switch entity := change.Entity.(type) {
+			case *entities.Room:
+				res.Data = ConvertRoomResponse(entity)
+			case *entities.Board:
+				res.Data = ConvertBoardResponse(entity)
+			case *entities.Todo:
+				res.Data = ConvertTodoResponse(entity)
+			}
+		}
+
+		listResult = append(listResult, res)
+	}
+	return &SyncPush{Results: listResult}
+}
@@ -11,26 +11,58 @@ type ListTodo struct {
 }
 
 type Todo struct {
-	Id        int        `json:"id"`
-	Title     string     `json:"title"`
-	Done      bool       `json:"done"`
-	Priority  int        `json:"priority"`
-	BoardId   int        `json:"board_id"`
-	DueDate   *time.Time `json:"due_date,omitempty"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
+	Id          int        `json:"id"`
+	Title       string     `json:"title"`
+	StatusId    int        `json:"status_id"`
+	Done        bool       `json:"done"`
+	Priority    int        `json:"priority"`
+	Rank        string     `json:"rank"`
+	BoardId     int        `json:"board_id"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+
+	Recurrence        *Recurrence       `json:"recurrence,omitempty"`
+	ChecklistProgress ChecklistProgress `json:"checklist_progress"`
+	Blocked           bool              `json:"blocked"`
+}
+
+type Recurrence struct {
+	Rule     string `json:"rule"`
+	Timezone string `json:"timezone,omitempty"`
 }
 
 func ConvertTodoResponse(todo *entities.Todo) *Todo {
 	return &Todo{
-		Id:        todo.Id,
-		Title:     todo.Title,
-		Done:      todo.Done,
-		Priority:  todo.Priority,
-		DueDate:   todo.DueDate,
-		BoardId:   todo.BoardId,
-		CreatedAt: todo.CreatedAt,
-		UpdatedAt: todo.UpdatedAt,
+		Id:          todo.Id,
+		Title:       todo.Title,
+		StatusId:    todo.StatusId,
+		Done:        todo.Done,
+		Priority:    todo.Priority,
+		Rank:        todo.Rank,
+		DueDate:     todo.DueDate,
+		CompletedAt: todo.CompletedAt,
+		BoardId:     todo.BoardId,
+		CreatedAt:   todo.CreatedAt,
+		UpdatedAt:   todo.UpdatedAt,
+		DeletedAt:   todo.DeletedAt,
+
+		Recurrence:        convertRecurrenceResponse(todo.Recurrence),
+		ChecklistProgress: convertChecklistProgressResponse(todo.ChecklistProgress),
+		Blocked:           todo.Blocked,
+	}
+}
+
+func convertRecurrenceResponse(recurrence *entities.Recurrence) *Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	return &Recurrence{
+		Rule:     recurrence.Rule,
+		Timezone: recurrence.Timezone,
 	}
 }
 
@@ -0,0 +1,30 @@
+package response
+
+import "net/http"
+
+type TodoBatch struct {
+	Results []*TodoBatchResult `json:"results"`
+}
+
+// TodoBatchResult reports one operation with the status code it would have had on its own.
+// Applied is false for every operation of a batch that was rolled back.
+type TodoBatchResult struct {
+	Id      int    `json:"id"`
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Applied bool   `json:"applied"`
+}
+
+func ConvertTodoBatchResponse(ids, statuses []int, applied bool) *TodoBatch {
+	results := []*TodoBatchResult{}
+
+	for i, id := range ids {
+		results = append(results, &TodoBatchResult{
+			Id:      id,
+			Status:  statuses[i],
+			Message: http.StatusText(statuses[i]),
+			Applied: applied,
+		})
+	}
+	return &TodoBatch{Results: results}
+}
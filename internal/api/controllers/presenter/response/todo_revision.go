@@ -0,0 +1,83 @@
+package response
+
+import (
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListTodoRevision struct {
+	Revisions []*TodoRevision `json:"revisions"`
+}
+
+type TodoRevision struct {
+	Version    int         `json:"version"`
+	Title      string      `json:"title"`
+	StatusId   int         `json:"status_id"`
+	Priority   int         `json:"priority"`
+	DueDate    *time.Time  `json:"due_date,omitempty"`
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+	Actor      string      `json:"actor"`
+	CreatedAt  time.Time   `json:"created_at"`
+
+	// Changes holds the fields that differ from the previous revision.
+	Changes map[string]*FieldChange `json:"changes"`
+}
+
+type FieldChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// ConvertTodoRevisionsResponse expects the revisions oldest first and diffs each one
+// against the one before it.
+func ConvertTodoRevisionsResponse(revisions []*entities.TodoRevision) *ListTodoRevision {
+	listRevision := []*TodoRevision{}
+
+	var prev *entities.TodoRevision
+	for _, revision := range revisions {
+		listRevision = append(listRevision, convertTodoRevisionResponse(revision, prev))
+		prev = revision
+	}
+	return &ListTodoRevision{Revisions: listRevision}
+}
+
+func convertTodoRevisionResponse(revision, prev *entities.TodoRevision) *TodoRevision {
+	changes := make(map[string]*FieldChange)
+	for _, field := range revision.ChangedFields(prev) {
+		var from any
+		if prev != nil {
+			from = revisionField(prev, field)
+		}
+		changes[field] = &FieldChange{From: from, To: revisionField(revision, field)}
+	}
+
+	return &TodoRevision{
+		Version:    revision.Version,
+		Title:      revision.Title,
+		StatusId:   revision.StatusId,
+		Priority:   revision.Priority,
+		DueDate:    revision.DueDate,
+		Recurrence: convertRecurrenceResponse(revision.Recurrence),
+		Actor:      revision.Actor,
+		CreatedAt:  revision.CreatedAt,
+
+		Changes: changes,
+	}
+}
+
+func revisionField(revision *entities.TodoRevision, field string) any {
+	switch field {
+	case entities.TodoFieldTitle:
+		return revision.Title
+	case entities.TodoFieldStatusId:
+		return revision.StatusId
+	case entities.TodoFieldPriority:
+		return revision.Priority
+	case entities.TodoFieldDueDate:
+		return revision.DueDate
+	case entities.TodoFieldRecurrence:
+		return convertRecurrenceResponse(revision.Recurrence)
+	}
+	return nil
+}
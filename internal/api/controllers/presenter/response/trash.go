@@ -0,0 +1,19 @@
+package response
+
+import (
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type Trash struct {
+	Rooms  []*Room  `json:"rooms"`
+	Boards []*Board `json:"boards"`
+	Todos  []*Todo  `json:"todos"`
+}
+
+func ConvertTrashResponse(trash *entities.Trash) *Trash {
+	return &Trash{
+		Rooms:  ConvertRoomsResponse(trash.Rooms).Rooms,
+		Boards: ConvertBoardsResponse(trash.Boards).Boards,
+		Todos:  ConvertoTodosResponse(trash.Todos).Todos,
+	}
+}
@@ -0,0 +1,92 @@
+package response
+
+import (
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ListWebhook struct {
+	Webhooks []*Webhook `json:"webhooks"`
+}
+
+// Webhook leaves out the secret, which is only ever sent to the API.
+type Webhook struct {
+	Id           int        `json:"id"`
+	RoomId       int        `json:"room_id"`
+	URL          string     `json:"url"`
+	EventTypes   []string   `json:"event_types"`
+	Enabled      bool       `json:"enabled"`
+	FailureCount int        `json:"failure_count"`
+	DisabledAt   *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type ListWebhookDelivery struct {
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+}
+
+type WebhookDelivery struct {
+	Id            int        `json:"id"`
+	WebhookId     int        `json:"webhook_id"`
+	EventId       int64      `json:"event_id,omitempty"`
+	EventType     string     `json:"event_type"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	StatusCode    *int       `json:"status_code,omitempty"`
+	LastError     string     `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+	FailedAt      *time.Time `json:"failed_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+func ConvertWebhookResponse(webhook *entities.Webhook) *Webhook {
+	return &Webhook{
+		Id:           webhook.Id,
+		RoomId:       webhook.RoomId,
+		URL:          webhook.URL,
+		EventTypes:   webhook.EventTypes,
+		Enabled:      webhook.DisabledAt == nil,
+		FailureCount: webhook.FailureCount,
+		DisabledAt:   webhook.DisabledAt,
+		CreatedAt:    webhook.CreatedAt,
+		UpdatedAt:    webhook.UpdatedAt,
+	}
+}
+
+func ConvertWebhooksResponse(webhooks []*entities.Webhook) *ListWebhook {
+	listWebhook := []*Webhook{}
+
+	for _, webhook := range webhooks {
+		listWebhook = append(listWebhook, ConvertWebhookResponse(webhook))
+	}
+	return &ListWebhook{Webhooks: listWebhook}
+}
+
+func ConvertWebhookDeliveryResponse(delivery *entities.WebhookDelivery) *WebhookDelivery {
+	return &WebhookDelivery{
+		Id:            delivery.Id,
+		WebhookId:     delivery.WebhookId,
+		EventId:       delivery.EventId,
+		EventType:     delivery.EventType,
+		Status:        delivery.Status(),
+		Attempts:      delivery.Attempts,
+		StatusCode:    delivery.StatusCode,
+		LastError:     delivery.LastError,
+		NextAttemptAt: delivery.NextAttemptAt,
+		DeliveredAt:   delivery.DeliveredAt,
+		FailedAt:      delivery.FailedAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+}
+
+func ConvertWebhookDeliveriesResponse(deliveries []*entities.WebhookDelivery) *ListWebhookDelivery {
+	listDelivery := []*WebhookDelivery{}
+
+	for _, delivery := range deliveries {
+		listDelivery = append(listDelivery, ConvertWebhookDeliveryResponse(delivery))
+	}
+	return &ListWebhookDelivery{Deliveries: listDelivery}
+}
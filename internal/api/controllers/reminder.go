@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type ReminderController struct {
+	service interfaces.ReminderServicer
+}
+
+func NewReminderController(service interfaces.ReminderServicer) *ReminderController {
+	return &ReminderController{
+		service: service,
+	}
+}
+
+func (rc *ReminderController) GetAll(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	reminders, err := rc.service.GetAll(r.Context(), boardId, todoId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertRemindersResponse(reminders)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (rc *ReminderController) Create(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req request.Reminder
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := rc.service.Create(r.Context(), boardId, todoId, req.RemindAt, req.OffsetMinutes, req.Channel); err != nil {
+		if errors.Is(err, entities.ErrInvalidReminderTime) || errors.Is(err, entities.ErrInvalidReminderChannel) ||
+			errors.Is(err, entities.ErrReminderChannelUnavailable) {
+			response.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
+
+func (rc *ReminderController) Delete(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todoIdStr := r.PathValue("todoId")
+	todoId, err := strconv.Atoi(todoIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := rc.service.Delete(r.Context(), boardId, todoId, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
@@ -0,0 +1,283 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAllReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockReminderServicer(ctrl)
+	controller := NewReminderController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/reminders/", controller.GetAll)
+
+	offset := 30
+
+	testCases := []struct {
+		name           string
+		todoIdParam    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Get reminders",
+			todoIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1, 1).
+					Return([]*entities.Reminder{
+						{
+							Id:            1,
+							TodoId:        1,
+							OffsetMinutes: &offset,
+							Channel:       entities.ReminderChannelLog,
+							CreatedAt:     time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+							UpdatedAt:     time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"reminders":[
+					{
+						"id":1,
+						"todo_id":1,
+						"offset_minutes":30,
+						"channel":"log",
+						"created_at":"2025-05-01T10:00:00Z",
+						"updated_at":"2025-05-01T10:00:00Z"
+					}
+				]
+			}`,
+		},
+		{
+			name:        "If there is no record, return empty json",
+			todoIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1, 1).
+					Return(nil, nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"reminders":[]}`,
+		},
+		{
+			name:        "Failed with not found - Due to todo not on board",
+			todoIdParam: "2",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1, 2).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric todo id",
+			todoIdParam:    "invalid",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/" + tc.todoIdParam + "/reminders/"
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestCreateReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockReminderServicer(ctrl)
+	controller := NewReminderController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/reminders/", controller.Create)
+
+	remindAt := time.Date(2025, 6, 20, 9, 0, 0, 0, time.UTC)
+	offset := 30
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Create reminder at absolute time",
+			requestBody: `{"remind_at":"2025-06-20T09:00:00Z"}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 1, &remindAt, nil, "").Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:        "Success to Create reminder before due date",
+			requestBody: `{"offset_minutes":30,"channel":"webhook"}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 1, nil, &offset, entities.ReminderChannelWebhook).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to unknown channel",
+			requestBody:    `{"offset_minutes":30,"channel":"pigeon"}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to negative offset",
+			requestBody:    `{"offset_minutes":-5}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with bad request - Due to missing reminder time",
+			requestBody: `{}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 1, nil, nil, "").Return(entities.ErrInvalidReminderTime)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with bad request - Due to webhook channel not configured",
+			requestBody: `{"offset_minutes":30,"channel":"webhook"}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 1, nil, &offset, entities.ReminderChannelWebhook).Return(entities.ErrReminderChannelUnavailable)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with not found - Due to no todo on board",
+			requestBody: `{"offset_minutes":30}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 1, nil, &offset, "").Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:        "Failed with conflict - Due to archived board",
+			requestBody: `{"offset_minutes":30}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 1, nil, &offset, "").Return(entities.ErrArchived)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+		{
+			name:        "Failed with internal server error - Due to unexpected errors",
+			requestBody: `{"offset_minutes":30}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, 1, nil, &offset, "").Return(errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/v1/boards/1/todos/1/reminders/", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestDeleteReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockReminderServicer(ctrl)
+	controller := NewReminderController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{todoId}/reminders/{id}", controller.Delete)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "Success to Delete reminder",
+			idParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), 1, 1, 1).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric id",
+			idParam:        "invalid",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:    "Failed with not found - Due to no reminder with id",
+			idParam: "999",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), 1, 1, 999).Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/1/reminders/" + tc.idParam
+			req := httptest.NewRequest(http.MethodDelete, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
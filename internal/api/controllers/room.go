@@ -1,15 +1,18 @@
 package controllers
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/go-playground/validator"
 	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
 	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
 	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
 )
 
@@ -24,7 +27,13 @@ func NewRoomController(service interfaces.RoomServicer) *RoomController {
 }
 
 func (rc *RoomController) GetAll(w http.ResponseWriter, r *http.Request) {
-	rooms, err := rc.service.GetAll()
+	includeArchived, err := parseArchivedQuery(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	rooms, err := rc.service.GetAll(r.Context(), includeArchived)
 	if err != nil {
 		response.Error(w, http.StatusInternalServerError, err)
 		return
@@ -34,6 +43,28 @@ func (rc *RoomController) GetAll(w http.ResponseWriter, r *http.Request) {
 	response.Basic(w, http.StatusOK, res)
 }
 
+func (rc *RoomController) GetById(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	room, err := rc.service.GetById(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertRoomResponse(room)
+	response.Basic(w, http.StatusOK, res)
+}
+
 func (rc *RoomController) Create(w http.ResponseWriter, r *http.Request) {
 	req := request.Room{}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -47,13 +78,14 @@ func (rc *RoomController) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := rc.service.Create(req.Name)
+	room, err := rc.service.Create(r.Context(), req.Name)
 	if err != nil {
 		response.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+	res := response.ConvertRoomResponse(room)
+	response.Created(w, fmt.Sprintf("/v1/rooms/%d", room.Id), res)
 }
 
 func (rc *RoomController) Update(w http.ResponseWriter, r *http.Request) {
@@ -76,12 +108,16 @@ func (rc *RoomController) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = rc.service.Update(id, req.Name)
+	err = rc.service.Update(r.Context(), id, req.Name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			response.Error(w, http.StatusNotFound, err)
 			return
 		}
+		if errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
 		response.Error(w, http.StatusInternalServerError, err)
 		return
 	}
@@ -89,6 +125,14 @@ func (rc *RoomController) Update(w http.ResponseWriter, r *http.Request) {
 	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
 }
 
+func (rc *RoomController) Archive(w http.ResponseWriter, r *http.Request) {
+	setArchived(w, r, rc.service.Archive)
+}
+
+func (rc *RoomController) Unarchive(w http.ResponseWriter, r *http.Request) {
+	setArchived(w, r, rc.service.Unarchive)
+}
+
 func (rc *RoomController) Delete(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
 	id, err := strconv.Atoi(idStr)
@@ -97,7 +141,7 @@ func (rc *RoomController) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = rc.service.Delete(id)
+	err = rc.service.Delete(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			response.Error(w, http.StatusNotFound, err)
@@ -109,3 +153,37 @@ func (rc *RoomController) Delete(w http.ResponseWriter, r *http.Request) {
 
 	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
 }
+
+// parseArchivedQuery reads ?archived=true, which adds archived items to a listing.
+func parseArchivedQuery(r *http.Request) (bool, error) {
+	archived := r.URL.Query().Get("archived")
+	if archived == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(archived)
+}
+
+func setArchived(w http.ResponseWriter, r *http.Request, fn func(ctx context.Context, id int) error) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = fn(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			response.Error(w, http.StatusNotFound, err)
+		case errors.Is(err, entities.ErrArchived):
+			response.Error(w, http.StatusConflict, err)
+		default:
+			response.Error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type RoomMemberController struct {
+	service interfaces.RoomMemberServicer
+}
+
+func NewRoomMemberController(service interfaces.RoomMemberServicer) *RoomMemberController {
+	return &RoomMemberController{
+		service: service,
+	}
+}
+
+func (mc *RoomMemberController) GetAll(w http.ResponseWriter, r *http.Request) {
+	roomIdStr := r.PathValue("roomId")
+	roomId, err := strconv.Atoi(roomIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	members, err := mc.service.GetAll(r.Context(), roomId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertRoomMembersResponse(members)
+	response.Basic(w, http.StatusOK, res)
+}
+
+// Join adds the X-Actor of the request to the room.
+func (mc *RoomMemberController) Join(w http.ResponseWriter, r *http.Request) {
+	roomIdStr := r.PathValue("roomId")
+	roomId, err := strconv.Atoi(roomIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	member, err := mc.service.Join(r.Context(), roomId)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrActorRequired):
+			response.Error(w, http.StatusBadRequest, err)
+		case errors.Is(err, sql.ErrNoRows):
+			response.Error(w, http.StatusNotFound, err)
+		case errors.Is(err, entities.ErrArchived):
+			response.Error(w, http.StatusConflict, err)
+		default:
+			response.Error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	res := response.ConvertRoomMemberResponse(member)
+	response.Basic(w, http.StatusOK, res)
+}
@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestJoinRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockRoomMemberServicer(ctrl)
+	controller := NewRoomMemberController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/members/", controller.Join)
+
+	testCases := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success to Join the room",
+			setupMock: func() {
+				mockService.EXPECT().Join(gomock.Any(), 1).Return(&entities.RoomMember{
+					Id:       2,
+					RoomId:   1,
+					Actor:    "alice",
+					JoinedAt: time.Date(2025, 8, 23, 9, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"id":2,"room_id":1,"actor":"alice","joined_at":"2025-08-23T09:00:00Z"}`,
+		},
+		{
+			name: "Failed with bad request - Due to missing actor",
+			setupMock: func() {
+				mockService.EXPECT().Join(gomock.Any(), 1).Return(nil, entities.ErrActorRequired)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name: "Failed with conflict - Due to the archived room",
+			setupMock: func() {
+				mockService.EXPECT().Join(gomock.Any(), 1).Return(nil, entities.ErrArchived)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/rooms/1/members/", nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
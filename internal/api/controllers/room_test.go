@@ -29,6 +29,7 @@ func TestGetAllRoom(t *testing.T) {
 
 	testCases := []struct {
 		name           string
+		query          string
 		setupMock      func()
 		expectedStatus int
 		expectedBody   string
@@ -36,7 +37,7 @@ func TestGetAllRoom(t *testing.T) {
 		{
 			name: "Success to Get all room",
 			setupMock: func() {
-				mockService.EXPECT().GetAll().
+				mockService.EXPECT().GetAll(gomock.Any(), false).
 					Return([]*entities.Room{
 						{
 							Id:        1,
@@ -70,10 +71,46 @@ func TestGetAllRoom(t *testing.T) {
 				]
 			}`,
 		},
+		{
+			name:  "Success to Get all room including archived ones",
+			query: "?archived=true",
+			setupMock: func() {
+				archivedAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+				mockService.EXPECT().GetAll(gomock.Any(), true).
+					Return([]*entities.Room{
+						{
+							Id:         3,
+							Name:       "old room",
+							ArchivedAt: &archivedAt,
+							CreatedAt:  time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+							UpdatedAt:  time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+						},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"rooms":[
+					{
+						"id":3,
+						"name":"old room",
+						"archived_at":"2025-06-01T10:00:00Z",
+						"created_at":"2025-01-01T10:00:00Z",
+						"updated_at":"2025-01-01T10:00:00Z"
+					}
+				]
+			}`,
+		},
+		{
+			name:           "Failed with bad request - Due to invalid archived flag",
+			query:          "?archived=maybe",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
 		{
 			name: "If there is no record, return empty json",
 			setupMock: func() {
-				mockService.EXPECT().GetAll().
+				mockService.EXPECT().GetAll(gomock.Any(), false).
 					Return(nil, nil)
 			},
 			expectedStatus: 200,
@@ -82,7 +119,7 @@ func TestGetAllRoom(t *testing.T) {
 		{
 			name: "Failed with internal server error - Due to unexpected errors",
 			setupMock: func() {
-				mockService.EXPECT().GetAll().
+				mockService.EXPECT().GetAll(gomock.Any(), false).
 					Return(nil, errors.New("unexpected error"))
 			},
 			expectedStatus: 500,
@@ -94,7 +131,7 @@ func TestGetAllRoom(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setupMock()
 
-			req := httptest.NewRequest(http.MethodGet, "/v1/rooms/", nil)
+			req := httptest.NewRequest(http.MethodGet, "/v1/rooms/"+tc.query, nil)
 			res := httptest.NewRecorder()
 
 			mux.ServeHTTP(res, req)
@@ -116,20 +153,33 @@ func TestCreateRoom(t *testing.T) {
 	mux.HandleFunc("POST /v1/rooms", controller.Create)
 
 	testCases := []struct {
-		name           string
-		requestBody    string
-		setupMock      func()
-		expectedStatus int
-		expectedBody   string
+		name             string
+		requestBody      string
+		setupMock        func()
+		expectedStatus   int
+		expectedLocation string
+		expectedBody     string
 	}{
 		{
 			name:        "Success to Create new room",
 			requestBody: `{"name":"test room"}`,
 			setupMock: func() {
-				mockService.EXPECT().Create("test room").Return(nil)
+				mockService.EXPECT().Create(gomock.Any(), "test room").
+					Return(&entities.Room{
+						Id:        3,
+						Name:      "test room",
+						CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+					}, nil)
 			},
-			expectedStatus: 200,
-			expectedBody:   `{"message":"OK"}`,
+			expectedStatus:   201,
+			expectedLocation: "/v1/rooms/3",
+			expectedBody: `{
+				"id":3,
+				"name":"test room",
+				"created_at":"2025-01-01T10:00:00Z",
+				"updated_at":"2025-01-01T10:00:00Z"
+			}`,
 		},
 		{
 			name:           "Failed with bad request - Due to number of characters in the name is more than 50",
@@ -138,6 +188,16 @@ func TestCreateRoom(t *testing.T) {
 			expectedStatus: 400,
 			expectedBody:   `{"message":"Bad Request"}`,
 		},
+		{
+			name:        "Failed with internal server error - Due to unexpected errors",
+			requestBody: `{"name":"test room"}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), "test room").
+					Return(nil, errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -149,6 +209,78 @@ func TestCreateRoom(t *testing.T) {
 
 			mux.ServeHTTP(res, req)
 
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.Equal(t, tc.expectedLocation, res.Header().Get("Location"))
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestGetByIdRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockRoomServicer(ctrl)
+	controller := NewRoomController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/rooms/{id}", controller.GetById)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "Success to Get room",
+			idParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Room{
+						Id:        1,
+						Name:      "test room",
+						CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"id":1,
+				"name":"test room",
+				"created_at":"2025-01-01T10:00:00Z",
+				"updated_at":"2025-01-01T10:00:00Z"
+			}`,
+		},
+		{
+			name:           "Failed with bad request - Due to invalid id",
+			idParam:        "abc",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:    "Failed with not found - Due to the room does not exist",
+			idParam: "999",
+			setupMock: func() {
+				mockService.EXPECT().GetById(gomock.Any(), 999).
+					Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/rooms/"+tc.idParam, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
 			assert.Equal(t, tc.expectedStatus, res.Code)
 			assert.JSONEq(t, tc.expectedBody, res.Body.String())
 		})
@@ -178,7 +310,7 @@ func TestUpdateRoom(t *testing.T) {
 			idParam:     "1",
 			requestBody: `{"name":"update name"}`,
 			setupMock: func() {
-				mockService.EXPECT().Update(1, "update name").
+				mockService.EXPECT().Update(gomock.Any(), 1, "update name").
 					Return(nil)
 			},
 			expectedStatus: 200,
@@ -205,7 +337,7 @@ func TestUpdateRoom(t *testing.T) {
 			idParam:     "999",
 			requestBody: `{"name":"update name"}`,
 			setupMock: func() {
-				mockService.EXPECT().Update(999, "update name").
+				mockService.EXPECT().Update(gomock.Any(), 999, "update name").
 					Return(sql.ErrNoRows)
 			},
 			expectedStatus: 404,
@@ -216,7 +348,7 @@ func TestUpdateRoom(t *testing.T) {
 			idParam:     "1",
 			requestBody: `{"name":"update name"}`,
 			setupMock: func() {
-				mockService.EXPECT().Update(1, "update name").
+				mockService.EXPECT().Update(gomock.Any(), 1, "update name").
 					Return(errors.New("unexpected error"))
 			},
 			expectedStatus: 500,
@@ -260,7 +392,7 @@ func TestDeleteRoom(t *testing.T) {
 			name:    "Success to Delete room",
 			idParam: "1",
 			setupMock: func() {
-				mockService.EXPECT().Delete(1).
+				mockService.EXPECT().Delete(gomock.Any(), 1).
 					Return(nil)
 			},
 			expectedStatus: 200,
@@ -277,7 +409,7 @@ func TestDeleteRoom(t *testing.T) {
 			name:    "Failed with not found - Due to no room with id",
 			idParam: "999",
 			setupMock: func() {
-				mockService.EXPECT().Delete(999).
+				mockService.EXPECT().Delete(gomock.Any(), 999).
 					Return(sql.ErrNoRows)
 			},
 			expectedStatus: 404,
@@ -287,7 +419,7 @@ func TestDeleteRoom(t *testing.T) {
 			name:    "Failed with internal server error - Due to unexpected errors",
 			idParam: "1",
 			setupMock: func() {
-				mockService.EXPECT().Delete(1).
+				mockService.EXPECT().Delete(gomock.Any(), 1).
 					Return(errors.New("unexpected error"))
 			},
 			expectedStatus: 500,
@@ -309,3 +441,64 @@ func TestDeleteRoom(t *testing.T) {
 		})
 	}
 }
+
+func TestArchiveRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockRoomServicer(ctrl)
+	controller := NewRoomController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/rooms/{id}/archive", controller.Archive)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "Success to Archive room",
+			idParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().Archive(gomock.Any(), 1).
+					Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric id",
+			idParam:        "invalid",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:    "Failed with not found - Due to no room with id",
+			idParam: "999",
+			setupMock: func() {
+				mockService.EXPECT().Archive(gomock.Any(), 999).
+					Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/rooms/"+tc.idParam+"/archive", nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
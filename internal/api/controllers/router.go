@@ -4,8 +4,12 @@ import (
 	"database/sql"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	"github.com/rm-ryou/sample_todo_app/internal/notifiers"
 	"github.com/rm-ryou/sample_todo_app/internal/repositories"
 	"github.com/rm-ryou/sample_todo_app/internal/services"
 	"github.com/rs/cors"
@@ -13,23 +17,60 @@ import (
 
 // FIXME: Avoid initializing service, repository, controller in InitRouter
 // TODO: Use middleware and frameworks such as gin and echo for easy routing configuration
-func InitRoutes(db *sql.DB) http.Handler {
+func InitRoutes(db *sql.DB, cfg *config.Config, hub interfaces.EventHub) http.Handler {
 	mux := http.NewServeMux()
 
 	mux.Handle("/health", healthCheckMux())
 	mux.Handle("/v1/rooms/", roomMux(db))
-	mux.Handle("/v1/boards/{boardId}/todos/", todoMux(db))
+	mux.Handle("/v1/rooms/{roomId}/statuses/", statusMux(db))
+	mux.Handle("/v1/rooms/{roomId}/boards/", boardMux(db))
+	mux.Handle("/v1/rooms/{roomId}/webhooks/", webhookMux(db, cfg.Webhook))
+	mux.Handle("/v1/rooms/{roomId}/members/", roomMemberMux(db))
+	mux.Handle("/v1/boards/{boardId}/todos/", todoMux(db, cfg.Todo))
+	mux.Handle("/v1/todos:batch", todoBatchMux(db, cfg.Todo))
+	incomingWebhook := incomingWebhookMux(db, cfg.Todo)
+	mux.Handle("/v1/boards/{boardId}/incoming-webhooks/", incomingWebhook)
+	mux.Handle("/v1/incoming-webhooks/{token}", incomingWebhook)
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/checklist/", checklistMux(db))
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/blockers/", dependencyMux(db))
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/reminders/", reminderMux(db, cfg.Reminder))
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/comments/", commentMux(db))
+	mux.Handle("/v1/trash/", trashMux(db))
+	mux.Handle("/v1/audit", auditMux(db, cfg.Audit))
+	mux.Handle("/v1/sync", syncMux(db, cfg))
+	activity := activityMux(db)
+	mux.Handle("/v1/rooms/{roomId}/activity", activity)
+	mux.Handle("/v1/rooms/{roomId}/activity.atom", activity)
+	mux.Handle("/v1/feed-tokens", activity)
+
+	handler := withActor(idempotencyMiddleware(db, cfg.Idempotency).Handler(mux))
+
+	// Event streams and board sockets stay open for as long as the client listens, so they skip
+	// the query timeout.
+	root := http.NewServeMux()
+	root.Handle("/", withQueryTimeout(handler, cfg.DB.QueryTimeout))
+	root.Handle("/v1/rooms/{roomId}/events", eventMux(db, cfg.Events, hub))
+	root.Handle("/v1/boards/{boardId}/socket", withActor(boardSocketMux(db, cfg, hub)))
 
 	c := cors.New(cors.Options{
-		// TODO: fix allow origin
-		AllowedOrigins:   []string{"*"},
+		// An empty AllowedOrigins would allow any origin, so the configured ones are checked here.
+		AllowOriginFunc: func(origin string) bool {
+			return slices.Contains(cfg.CORS.AllowedOrigins, origin)
+		},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-Requested-With", "Last-Event-ID", idempotencyKeyHeader, actorHeader, requestIdHeader},
+		ExposedHeaders:   []string{"Location", idempotentReplayedHeader, requestIdHeader},
 		AllowCredentials: true,
-		// Enable Debugging for testing, consider disabling in production
-		Debug: true,
 	})
 
-	return c.Handler(mux)
+	return c.Handler(root)
+}
+
+func idempotencyMiddleware(db *sql.DB, cfg config.Idempotency) *IdempotencyMiddleware {
+	repository := repositories.NewIdempotencyRepository(db)
+	service := services.NewIdempotencyService(repository, cfg)
+
+	return NewIdempotencyMiddleware(service)
 }
 
 func healthCheckMux() *http.ServeMux {
@@ -41,7 +82,7 @@ func healthCheckMux() *http.ServeMux {
 
 func roomMux(db *sql.DB) *http.ServeMux {
 	repo := repositories.NewRoomRepository(db)
-	service := services.NewRoomService(repo)
+	service := services.NewRoomService(repo, repositories.NewUnitOfWork(db))
 	controller := NewRoomController(service)
 
 	mux := http.NewServeMux()
@@ -57,6 +98,94 @@ func roomMux(db *sql.DB) *http.ServeMux {
 	}))
 	mux.Handle("/v1/rooms/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
+		case http.MethodGet:
+			controller.GetById(w, r)
+		case http.MethodPut:
+			controller.Update(w, r)
+		case http.MethodDelete:
+			controller.Delete(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{id}/archive", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.Archive(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{id}/unarchive", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.Unarchive(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func statusMux(db *sql.DB) *http.ServeMux {
+	repository := repositories.NewStatusRepository(db)
+	service := services.NewStatusService(repository, repositories.NewRoomRepository(db), repositories.NewUnitOfWork(db))
+	controller := NewStatusController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/rooms/{roomId}/statuses/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		case http.MethodPost:
+			controller.Create(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{roomId}/statuses/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			controller.Update(w, r)
+		case http.MethodDelete:
+			controller.Delete(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{roomId}/statuses/{id}/transitions", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			controller.SetTransitions(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func webhookMux(db *sql.DB, cfg config.Webhook) *http.ServeMux {
+	repository := repositories.NewWebhookRepository(db)
+	service := services.NewWebhookService(repository, repositories.NewRoomRepository(db), notifiers.NewWebhookSender(cfg.Timeout), repositories.NewUnitOfWork(db))
+	controller := NewWebhookController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/rooms/{roomId}/webhooks/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		case http.MethodPost:
+			controller.Create(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{roomId}/webhooks/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetById(w, r)
 		case http.MethodPut:
 			controller.Update(w, r)
 		case http.MethodDelete:
@@ -65,18 +194,88 @@ func roomMux(db *sql.DB) *http.ServeMux {
 			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
 		}
 	}))
+	mux.Handle("/v1/rooms/{roomId}/webhooks/{id}/deliveries", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetDeliveries(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{roomId}/webhooks/{id}/test", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.SendTest(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func boardMux(db *sql.DB) *http.ServeMux {
+	repository := repositories.NewBoardRepository(db)
+	service := services.NewBoardService(repository, repositories.NewRoomRepository(db), repositories.NewUnitOfWork(db))
+	controller := NewBoardController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/rooms/{roomId}/boards/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		case http.MethodPost:
+			controller.Create(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{roomId}/boards/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetById(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{roomId}/boards/{id}/archive", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.Archive(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{roomId}/boards/{id}/unarchive", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.Unarchive(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{roomId}/boards/order", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			controller.Reorder(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
 
 	return mux
 }
 
-func todoMux(db *sql.DB) *http.ServeMux {
+func todoMux(db *sql.DB, cfg config.Todo) *http.ServeMux {
 	repository := repositories.NewTodoRepository(db)
-	service := services.NewTodoService(repository)
+	service := services.NewTodoService(repository, repositories.NewTodoRevisionRepository(db), repositories.NewUnitOfWork(db), cfg)
 	controller := NewTodoController(service)
 
 	mux := http.NewServeMux()
 	mux.Handle("/v1/boards/{boardId}/todos/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
 		case http.MethodPost:
 			controller.Create(w, r)
 		default:
@@ -95,6 +294,394 @@ func todoMux(db *sql.DB) *http.ServeMux {
 			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
 		}
 	}))
+	mux.Handle("/v1/boards/{boardId}/todos/{id}/move", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.Move(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/boards/{boardId}/todos/{id}/transfer", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.MoveToBoard(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/boards/{boardId}/todos/{id}/copy", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.Copy(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/boards/{boardId}/todos/{id}/revisions", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetRevisions(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/boards/{boardId}/todos/{id}/revisions/{version}/revert", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.Revert(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func todoBatchMux(db *sql.DB, cfg config.Todo) *http.ServeMux {
+	repository := repositories.NewTodoRepository(db)
+	service := services.NewTodoService(repository, repositories.NewTodoRevisionRepository(db), repositories.NewUnitOfWork(db), cfg)
+	controller := NewTodoController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/todos:batch", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.Batch(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func incomingWebhookMux(db *sql.DB, cfg config.Todo) *http.ServeMux {
+	repository := repositories.NewIncomingWebhookRepository(db)
+	boardRepository := repositories.NewBoardRepository(db)
+	uow := repositories.NewUnitOfWork(db)
+	todoService := services.NewTodoService(repositories.NewTodoRepository(db), repositories.NewTodoRevisionRepository(db), uow, cfg)
+	service := services.NewIncomingWebhookService(repository, boardRepository, todoService, uow)
+	controller := NewIncomingWebhookController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/boards/{boardId}/incoming-webhooks/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		case http.MethodPost:
+			controller.Create(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/boards/{boardId}/incoming-webhooks/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			controller.Delete(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/incoming-webhooks/{token}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.Receive(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func roomMemberMux(db *sql.DB) *http.ServeMux {
+	repository := repositories.NewRoomMemberRepository(db)
+	service := services.NewRoomMemberService(repository, repositories.NewRoomRepository(db), repositories.NewUnitOfWork(db))
+	controller := NewRoomMemberController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/rooms/{roomId}/members/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		case http.MethodPost:
+			controller.Join(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func commentMux(db *sql.DB) *http.ServeMux {
+	repository := repositories.NewCommentRepository(db)
+	todoRepository := repositories.NewTodoRepository(db)
+	service := services.NewCommentService(repository, todoRepository, repositories.NewUnitOfWork(db))
+	controller := NewCommentController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/comments/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		case http.MethodPost:
+			controller.Create(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func checklistMux(db *sql.DB) *http.ServeMux {
+	repository := repositories.NewChecklistRepository(db)
+	todoRepository := repositories.NewTodoRepository(db)
+	service := services.NewChecklistService(repository, todoRepository, repositories.NewUnitOfWork(db))
+	controller := NewChecklistController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/checklist/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		case http.MethodPost:
+			controller.Create(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/checklist/order", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			controller.Reorder(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/checklist/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			controller.Update(w, r)
+		case http.MethodDelete:
+			controller.Delete(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func dependencyMux(db *sql.DB) *http.ServeMux {
+	repository := repositories.NewDependencyRepository(db)
+	service := services.NewDependencyService(repository, repositories.NewTodoRepository(db), repositories.NewUnitOfWork(db))
+	controller := NewDependencyController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/blockers/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetBlockers(w, r)
+		case http.MethodPost:
+			controller.AddBlocker(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/blockers/{blockerId}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			controller.RemoveBlocker(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func reminderMux(db *sql.DB, cfg config.Reminder) *http.ServeMux {
+	repository := repositories.NewReminderRepository(db)
+	service := services.NewReminderService(repository, repositories.NewTodoRepository(db), repositories.NewUnitOfWork(db), cfg)
+	controller := NewReminderController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/reminders/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		case http.MethodPost:
+			controller.Create(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/boards/{boardId}/todos/{todoId}/reminders/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			controller.Delete(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func trashMux(db *sql.DB) *http.ServeMux {
+	repository := repositories.NewTrashRepository(db)
+	service := services.NewTrashService(repository, repositories.NewUnitOfWork(db))
+	controller := NewTrashController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/trash/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/trash/rooms/{id}/restore", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.RestoreRoom(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/trash/boards/{id}/restore", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.RestoreBoard(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/trash/todos/{id}/restore", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.RestoreTodo(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func activityMux(db *sql.DB) *http.ServeMux {
+	repository := repositories.NewActivityRepository(db)
+	service := services.NewActivityService(repository, repositories.NewRoomRepository(db), repositories.NewFeedTokenRepository(db))
+	controller := NewActivityController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/rooms/{roomId}/activity", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/rooms/{roomId}/activity.atom", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.Feed(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+	mux.Handle("/v1/feed-tokens", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			controller.CreateFeedToken(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func eventMux(db *sql.DB, cfg config.Events, hub interfaces.EventHub) *http.ServeMux {
+	service := services.NewEventService(hub, repositories.NewRoomRepository(db), repositories.NewBoardRepository(db))
+	controller := NewEventController(service, cfg)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/rooms/{roomId}/events", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.Stream(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func boardSocketMux(db *sql.DB, cfg *config.Config, hub interfaces.EventHub) *http.ServeMux {
+	boardRepository := repositories.NewBoardRepository(db)
+	todoService := services.NewTodoService(repositories.NewTodoRepository(db), repositories.NewTodoRevisionRepository(db), repositories.NewUnitOfWork(db), cfg.Todo)
+	eventService := services.NewEventService(hub, repositories.NewRoomRepository(db), boardRepository)
+	controller := NewBoardSocketController(todoService, eventService, services.NewPresenceHub(), cfg.Events, cfg.CORS, cfg.DB.QueryTimeout)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/boards/{boardId}/socket", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.Serve(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func syncMux(db *sql.DB, cfg *config.Config) *http.ServeMux {
+	roomRepository := repositories.NewRoomRepository(db)
+	boardRepository := repositories.NewBoardRepository(db)
+	uow := repositories.NewUnitOfWork(db)
+	roomService := services.NewRoomService(roomRepository, uow)
+	boardService := services.NewBoardService(boardRepository, roomRepository, uow)
+	todoService := services.NewTodoService(repositories.NewTodoRepository(db), repositories.NewTodoRevisionRepository(db), uow, cfg.Todo)
+	service := services.NewSyncService(repositories.NewSyncRepository(db), roomService, boardService, todoService, uow, cfg.Sync)
+	controller := NewSyncController(service)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/sync", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.Pull(w, r)
+		case http.MethodPost:
+			controller.Push(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
+
+	return mux
+}
+
+func auditMux(db *sql.DB, cfg config.Audit) *http.ServeMux {
+	repository := repositories.NewAuditRepository(db)
+	service := services.NewAuditService(repository)
+	controller := NewAuditController(service, cfg.AdminToken)
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/audit", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			controller.GetAll(w, r)
+		default:
+			response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed", r.Method))
+		}
+	}))
 
 	return mux
 }
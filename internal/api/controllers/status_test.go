@@ -0,0 +1,329 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAllStatuses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockStatusServicer(ctrl)
+	controller := NewStatusController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/statuses/", controller.GetAll)
+
+	testCases := []struct {
+		name           string
+		roomIdParam    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Get statuses",
+			roomIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1).
+					Return([]*entities.Status{
+						{
+							Id:        1,
+							RoomId:    1,
+							Name:      "In Review",
+							Category:  entities.StatusCategoryDoing,
+							Position:  3,
+							CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+							UpdatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"statuses":[
+					{
+						"id":1,
+						"room_id":1,
+						"name":"In Review",
+						"category":"doing",
+						"position":3,
+						"transitions":[],
+						"created_at":"2025-05-01T10:00:00Z",
+						"updated_at":"2025-05-01T10:00:00Z"
+					}
+				]
+			}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric room id",
+			roomIdParam:    "invalid",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/rooms/" + tc.roomIdParam + "/statuses/"
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestCreateStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockStatusServicer(ctrl)
+	controller := NewStatusController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/statuses/", controller.Create)
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Create status",
+			requestBody: `{"name":"In Review","category":"doing"}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, "In Review", entities.StatusCategoryDoing).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to unknown category",
+			requestBody:    `{"name":"Blocked","category":"blocked"}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/v1/rooms/1/statuses/", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestUpdateStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockStatusServicer(ctrl)
+	controller := NewStatusController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/statuses/{id}", controller.Update)
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Update status",
+			requestBody: `{"name":"Doing","category":"doing"}`,
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), 2, "Doing", entities.StatusCategoryDoing).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:        "Failed with not found - Due to no status with id",
+			requestBody: `{"name":"Doing","category":"doing"}`,
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), 2, "Doing", entities.StatusCategoryDoing).Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:        "Failed with conflict - Due to recategorizing status in use",
+			requestBody: `{"name":"Doing","category":"done"}`,
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), 2, "Doing", entities.StatusCategoryDone).Return(entities.ErrStatusInUse)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/v1/rooms/1/statuses/2", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestDeleteStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockStatusServicer(ctrl)
+	controller := NewStatusController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/statuses/{id}", controller.Delete)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "Success to Delete status",
+			idParam: "2",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), 2).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric id",
+			idParam:        "invalid",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:    "Failed with conflict - Due to the last done status",
+			idParam: "3",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), 3).Return(entities.ErrStatusRequired)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/rooms/1/statuses/" + tc.idParam
+			req := httptest.NewRequest(http.MethodDelete, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestSetTransitionsStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockStatusServicer(ctrl)
+	controller := NewStatusController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/statuses/{id}/transitions", controller.SetTransitions)
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Set transitions",
+			requestBody: `{"status_ids":[2,3]}`,
+			setupMock: func() {
+				mockService.EXPECT().SetTransitions(gomock.Any(), 1, []int{2, 3}).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-positive status id",
+			requestBody:    `{"status_ids":[0]}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with invalid request - Due to status of another room",
+			requestBody: `{"status_ids":[9]}`,
+			setupMock: func() {
+				mockService.EXPECT().SetTransitions(gomock.Any(), 1, []int{9}).Return(entities.ErrInvalidStatus)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with not found - Due to no status with id",
+			requestBody: `{"status_ids":[2]}`,
+			setupMock: func() {
+				mockService.EXPECT().SetTransitions(gomock.Any(), 1, []int{2}).Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/v1/rooms/1/statuses/1/transitions", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
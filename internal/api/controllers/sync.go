@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type SyncController struct {
+	service interfaces.SyncServicer
+}
+
+func NewSyncController(service interfaces.SyncServicer) *SyncController {
+	return &SyncController{
+		service: service,
+	}
+}
+
+// Pull answers with everything when the since query parameter is left out, and otherwise
+// with what changed after the next_token of an earlier pull.
+func (sc *SyncController) Pull(w http.ResponseWriter, r *http.Request) {
+	page, err := sc.service.Pull(r.Context(), r.URL.Query().Get("since"))
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidSyncToken) {
+			response.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertSyncResponse(page)
+	response.Basic(w, http.StatusOK, res)
+}
+
+// Push answers 200 with a result for each mutation, also when some of them failed.
+func (sc *SyncController) Push(w http.ResponseWriter, r *http.Request) {
+	var req request.SyncPush
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	mutations := make([]*entities.SyncMutation, 0, len(req.Mutations))
+	clientIds := make([]string, 0, len(req.Mutations))
+	for _, m := range req.Mutations {
+		mutations = append(mutations, &entities.SyncMutation{
+			EntityType:  m.Entity,
+			Op:          m.Op,
+			Id:          m.Id,
+			BaseVersion: m.BaseVersion,
+			Name:        m.Name,
+			Priority:    m.Priority,
+			RoomId:      m.RoomId,
+			BoardId:     m.BoardId,
+			Title:       m.Title,
+			Done:        m.Done,
+			StatusId:    m.StatusId,
+			DueDate:     m.DueDate,
+		})
+		clientIds = append(clientIds, m.ClientId)
+	}
+
+	results, err := sc.service.Push(r.Context(), mutations)
+	if err != nil {
+		if errors.Is(err, entities.ErrBatchTooLarge) {
+			response.Error(w, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	statuses := make([]int, len(results))
+	for i, result := range results {
+		statuses[i] = syncMutationStatus(mutations[i], result.Err)
+	}
+
+	res := response.ConvertSyncPushResponse(clientIds, mutations, results, statuses)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func syncMutationStatus(mutation *entities.SyncMutation, err error) int {
+	switch {
+	case err == nil && mutation.Op == entities.SyncOpCreate:
+		return http.StatusCreated
+	case errors.Is(err, entities.ErrSyncConflict):
+		return http.StatusConflict
+	case errors.Is(err, entities.ErrInvalidSyncMutation):
+		return http.StatusBadRequest
+	default:
+		return batchOperationStatus(err)
+	}
+}
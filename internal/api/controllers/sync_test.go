@@ -0,0 +1,205 @@
+package controllers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPullSync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockSyncServicer(ctrl)
+	controller := NewSyncController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/sync", controller.Pull)
+
+	createdAt := time.Date(2025, 9, 20, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		url            string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success to pull changed and deleted entities",
+			url:  "/v1/sync?since=40",
+			setupMock: func() {
+				mockService.EXPECT().Pull(gomock.Any(), "40").Return(&entities.SyncPage{
+					Changes: []*entities.SyncChange{
+						{EntityType: entities.SyncEntityRoom, EntityId: 1, Version: 41, Entity: &entities.Room{Id: 1, Name: "room", CreatedAt: createdAt, UpdatedAt: createdAt}},
+						{EntityType: entities.SyncEntityBoard, EntityId: 2, Version: 42},
+						{EntityType: entities.SyncEntityTodo, EntityId: 3, Version: 43},
+					},
+					Token:   "43",
+					HasMore: true,
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"rooms":[{
+					"id":1,
+					"name":"room",
+					"created_at":"2025-09-20T09:00:00Z",
+					"updated_at":"2025-09-20T09:00:00Z",
+					"version":41
+				}],
+				"boards":[],
+				"todos":[],
+				"deleted":{"rooms":[],"boards":[2],"todos":[3]},
+				"next_token":"43",
+				"has_more":true
+			}`,
+		},
+		{
+			name: "Success to pull the first page without since",
+			url:  "/v1/sync",
+			setupMock: func() {
+				mockService.EXPECT().Pull(gomock.Any(), "").Return(&entities.SyncPage{Token: "0"}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"rooms":[],
+				"boards":[],
+				"todos":[],
+				"deleted":{"rooms":[],"boards":[],"todos":[]},
+				"next_token":"0",
+				"has_more":false
+			}`,
+		},
+		{
+			name: "Failed with bad request - Due to invalid token",
+			url:  "/v1/sync?since=abc",
+			setupMock: func() {
+				mockService.EXPECT().Pull(gomock.Any(), "abc").Return(nil, entities.ErrInvalidSyncToken)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestPushSync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockSyncServicer(ctrl)
+	controller := NewSyncController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/sync", controller.Push)
+
+	createdAt := time.Date(2025, 9, 20, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success to push mutations with a result for each",
+			requestBody: `{"mutations":[
+				{"client_id":"a","entity":"room","op":"create","name":"room"},
+				{"client_id":"b","entity":"todo","op":"delete","id":3,"base_version":20},
+				{"client_id":"c","entity":"board","op":"delete","id":2,"base_version":20}
+			]}`,
+			setupMock: func() {
+				mockService.EXPECT().Push(gomock.Any(), []*entities.SyncMutation{
+					{EntityType: entities.SyncEntityRoom, Op: entities.SyncOpCreate, Name: "room"},
+					{EntityType: entities.SyncEntityTodo, Op: entities.SyncOpDelete, Id: 3, BaseVersion: 20},
+					{EntityType: entities.SyncEntityBoard, Op: entities.SyncOpDelete, Id: 2, BaseVersion: 20},
+				}).Return([]*entities.SyncResult{
+					{Change: &entities.SyncChange{EntityType: entities.SyncEntityRoom, EntityId: 1, Version: 41, Entity: &entities.Room{Id: 1, Name: "room", CreatedAt: createdAt, UpdatedAt: createdAt}}},
+					{Change: &entities.SyncChange{EntityType: entities.SyncEntityTodo, EntityId: 3, Version: 42}},
+					{Err: entities.ErrSyncConflict, Change: &entities.SyncChange{EntityType: entities.SyncEntityBoard, EntityId: 2, Version: 30, Entity: &entities.Board{Id: 2, Name: "board", RoomId: 1, CreatedAt: createdAt, UpdatedAt: createdAt}}},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{"results":[
+				{
+					"client_id":"a",
+					"status":201,
+					"message":"Created",
+					"entity":"room",
+					"id":1,
+					"version":41,
+					"data":{"id":1,"name":"room","created_at":"2025-09-20T09:00:00Z","updated_at":"2025-09-20T09:00:00Z"}
+				},
+				{
+					"client_id":"b",
+					"status":200,
+					"message":"OK",
+					"entity":"todo",
+					"id":3,
+					"version":42,
+					"deleted":true
+				},
+				{
+					"client_id":"c",
+					"status":409,
+					"message":"Conflict",
+					"entity":"board",
+					"id":2,
+					"version":30,
+					"data":{"id":2,"name":"board","priority":0,"position":0,"room_id":1,"created_at":"2025-09-20T09:00:00Z","updated_at":"2025-09-20T09:00:00Z"}
+				}
+			]}`,
+		},
+		{
+			name:           "Failed with bad request - Due to unknown entity",
+			requestBody:    `{"mutations":[{"entity":"status","op":"delete","id":1}]}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with request entity too large - Due to too many mutations",
+			requestBody: `{"mutations":[{"entity":"room","op":"create","name":"room"}]}`,
+			setupMock: func() {
+				mockService.EXPECT().Push(gomock.Any(), gomock.Any()).Return(nil, entities.ErrBatchTooLarge)
+			},
+			expectedStatus: 413,
+			expectedBody:   `{"message":"Request Entity Too Large"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/v1/sync", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/go-playground/validator"
 	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
 	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
 	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
 )
 
@@ -23,6 +25,24 @@ func NewTodoController(service interfaces.TodoServicer) *TodoController {
 	}
 }
 
+func (tc *TodoController) GetAll(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	todos, err := tc.service.GetAll(r.Context(), boardId)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertoTodosResponse(todos)
+	response.Basic(w, http.StatusOK, res)
+}
+
 func (tc *TodoController) GetById(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
 	id, err := strconv.Atoi(idStr)
@@ -31,7 +51,7 @@ func (tc *TodoController) GetById(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	todo, err := tc.service.GetById(id)
+	todo, err := tc.service.GetById(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			response.Error(w, http.StatusNotFound, err)
@@ -65,12 +85,26 @@ func (tc *TodoController) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := tc.service.Create(boardId, req.Title, req.Done, req.Priority, req.DueDate); err != nil {
+	todo, err := tc.service.Create(r.Context(), boardId, req.Title, req.Done, req.StatusId, req.Priority, req.DueDate, convertRecurrence(req.Recurrence))
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidRecurrence) || errors.Is(err, entities.ErrInvalidStatus) {
+			response.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
 		response.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+	res := response.ConvertTodoResponse(todo)
+	response.Created(w, fmt.Sprintf("/v1/boards/%d/todos/%d", boardId, todo.Id), res)
 }
 
 func (tc *TodoController) Update(w http.ResponseWriter, r *http.Request) {
@@ -93,12 +127,176 @@ func (tc *TodoController) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = tc.service.Update(id, req.Title, req.Done, req.Priority, req.DueDate)
+	err = tc.service.Update(r.Context(), id, req.Title, req.Done, req.StatusId, req.Priority, req.DueDate, convertRecurrence(req.Recurrence))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			response.Error(w, http.StatusNotFound, err)
 			return
 		}
+		if errors.Is(err, entities.ErrInvalidRecurrence) || errors.Is(err, entities.ErrInvalidStatus) {
+			response.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, entities.ErrTodoBlocked) || errors.Is(err, entities.ErrTransitionNotAllowed) || errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
+
+func (tc *TodoController) Move(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req request.TodoMove
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := tc.service.Move(r.Context(), boardId, id, req.BeforeId, req.AfterId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrInvalidRank) {
+			response.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
+
+func (tc *TodoController) MoveToBoard(w http.ResponseWriter, r *http.Request) {
+	boardId, id, req, ok := parseTodoTransfer(w, r)
+	if !ok {
+		return
+	}
+
+	if err := tc.service.MoveToBoard(r.Context(), boardId, id, req.BoardId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrStatusRequired) || errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
+
+func (tc *TodoController) Copy(w http.ResponseWriter, r *http.Request) {
+	boardId, id, req, ok := parseTodoTransfer(w, r)
+	if !ok {
+		return
+	}
+
+	todo, err := tc.service.CopyToBoard(r.Context(), boardId, id, req.BoardId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, entities.ErrStatusRequired) || errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertTodoResponse(todo)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (tc *TodoController) GetRevisions(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	revisions, err := tc.service.GetRevisions(r.Context(), boardId, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertTodoRevisionsResponse(revisions)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (tc *TodoController) Revert(w http.ResponseWriter, r *http.Request) {
+	boardIdStr := r.PathValue("boardId")
+	boardId, err := strconv.Atoi(boardIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	versionStr := r.PathValue("version")
+	version, err := strconv.Atoi(versionStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = tc.service.Revert(r.Context(), boardId, id, version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			response.Error(w, http.StatusNotFound, err)
+			return
+		}
+		// The status of an old revision may have been deleted since.
+		if errors.Is(err, entities.ErrInvalidStatus) || errors.Is(err, entities.ErrTodoBlocked) || errors.Is(err, entities.ErrTransitionNotAllowed) || errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
 		response.Error(w, http.StatusInternalServerError, err)
 		return
 	}
@@ -115,15 +313,142 @@ func (tc *TodoController) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = tc.service.Delete(id)
+	err = tc.service.Delete(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			response.Error(w, http.StatusNotFound, err)
 			return
 		}
+		if errors.Is(err, entities.ErrArchived) {
+			response.Error(w, http.StatusConflict, err)
+			return
+		}
 		response.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
 }
+
+// Batch answers 200 when every operation was applied. When any failed nothing is applied and
+// it answers 422. Each result carries the status the operation would have had on its own and
+// whether it was applied.
+func (tc *TodoController) Batch(w http.ResponseWriter, r *http.Request) {
+	var req request.TodoBatch
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ops := make([]*entities.TodoBatchOp, 0, len(req.Operations))
+	ids := make([]int, 0, len(req.Operations))
+	for _, o := range req.Operations {
+		ops = append(ops, &entities.TodoBatchOp{
+			Op:           o.Op,
+			Id:           o.Id,
+			Title:        o.Title,
+			Done:         o.Done,
+			StatusId:     o.StatusId,
+			Priority:     o.Priority,
+			DueDate:      o.DueDate,
+			ClearDueDate: o.ClearDueDate,
+			BoardId:      o.BoardId,
+		})
+		ids = append(ids, o.Id)
+	}
+
+	errs, err := tc.service.Batch(r.Context(), ops)
+	if errors.Is(err, entities.ErrBatchTooLarge) {
+		response.Error(w, http.StatusRequestEntityTooLarge, err)
+		return
+	}
+	if err != nil && !errors.Is(err, entities.ErrBatchAborted) {
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	statuses := make([]int, len(errs))
+	for i, opErr := range errs {
+		statuses[i] = batchOperationStatus(opErr)
+		if statuses[i] == http.StatusInternalServerError {
+			response.Error(w, http.StatusInternalServerError, opErr)
+			return
+		}
+	}
+
+	applied := err == nil
+	code := http.StatusOK
+	if !applied {
+		code = http.StatusUnprocessableEntity
+	}
+
+	res := response.ConvertTodoBatchResponse(ids, statuses, applied)
+	response.Basic(w, code, res)
+}
+
+func batchOperationStatus(err error) int {
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, entities.ErrInvalidBatchOperation),
+		errors.Is(err, entities.ErrInvalidTitle),
+		errors.Is(err, entities.ErrInvalidPriority),
+		errors.Is(err, entities.ErrInvalidRecurrence),
+		errors.Is(err, entities.ErrInvalidStatus):
+		return http.StatusBadRequest
+	case errors.Is(err, entities.ErrTodoBlocked),
+		errors.Is(err, entities.ErrTransitionNotAllowed),
+		errors.Is(err, entities.ErrStatusRequired),
+		errors.Is(err, entities.ErrArchived):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseTodoTransfer writes a 400 response and returns false when the request is malformed.
+func parseTodoTransfer(w http.ResponseWriter, r *http.Request) (boardId, id int, req request.TodoTransfer, ok bool) {
+	boardId, err := strconv.Atoi(r.PathValue("boardId"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return 0, 0, req, false
+	}
+
+	id, err = strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return 0, 0, req, false
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return 0, 0, req, false
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return 0, 0, req, false
+	}
+
+	return boardId, id, req, true
+}
+
+func convertRecurrence(req *request.Recurrence) *entities.Recurrence {
+	if req == nil {
+		return nil
+	}
+
+	return &entities.Recurrence{
+		Rule:     req.Rule,
+		Timezone: req.Timezone,
+	}
+}
@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBatchTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockTodoServicer(ctrl)
+	controller := NewTodoController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/todos:batch", controller.Batch)
+
+	done := true
+	boardId := 2
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to apply batch",
+			requestBody: `{"operations":[{"op":"update","id":1,"done":true},{"op":"move","id":2,"board_id":2},{"op":"delete","id":3}]}`,
+			setupMock: func() {
+				mockService.EXPECT().Batch(gomock.Any(), []*entities.TodoBatchOp{
+					{Op: "update", Id: 1, Done: &done},
+					{Op: "move", Id: 2, BoardId: &boardId},
+					{Op: "delete", Id: 3},
+				}).Return([]error{nil, nil, nil}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{"results":[
+				{"id":1,"status":200,"message":"OK","applied":true},
+				{"id":2,"status":200,"message":"OK","applied":true},
+				{"id":3,"status":200,"message":"OK","applied":true}
+			]}`,
+		},
+		{
+			name:        "Success to apply batch clearing due date",
+			requestBody: `{"operations":[{"op":"update","id":1,"clear_due_date":true}]}`,
+			setupMock: func() {
+				mockService.EXPECT().Batch(gomock.Any(), []*entities.TodoBatchOp{
+					{Op: "update", Id: 1, ClearDueDate: true},
+				}).Return([]error{nil}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"results":[{"id":1,"status":200,"message":"OK","applied":true}]}`,
+		},
+		{
+			name:        "Failed with unprocessable entity - Due to an operation that fails",
+			requestBody: `{"operations":[{"op":"update","id":1,"done":true},{"op":"delete","id":999},{"op":"update","id":3,"done":true}]}`,
+			setupMock: func() {
+				mockService.EXPECT().Batch(gomock.Any(), gomock.Any()).
+					Return([]error{nil, sql.ErrNoRows, entities.ErrTodoBlocked}, entities.ErrBatchAborted)
+			},
+			expectedStatus: 422,
+			expectedBody: `{"results":[
+				{"id":1,"status":200,"message":"OK","applied":false},
+				{"id":999,"status":404,"message":"Not Found","applied":false},
+				{"id":3,"status":409,"message":"Conflict","applied":false}
+			]}`,
+		},
+		{
+			name:        "Failed with request entity too large - Due to too many operations",
+			requestBody: `{"operations":[{"op":"delete","id":1},{"op":"delete","id":2}]}`,
+			setupMock: func() {
+				mockService.EXPECT().Batch(gomock.Any(), gomock.Any()).Return(nil, entities.ErrBatchTooLarge)
+			},
+			expectedStatus: 413,
+			expectedBody:   `{"message":"Request Entity Too Large"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to empty operations",
+			requestBody:    `{"operations":[]}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to unknown operation",
+			requestBody:    `{"operations":[{"op":"add_label","id":1}]}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with internal server error - Due to unexpected error in an operation",
+			requestBody: `{"operations":[{"op":"delete","id":1}]}`,
+			setupMock: func() {
+				mockService.EXPECT().Batch(gomock.Any(), gomock.Any()).
+					Return([]error{errors.New("unexpected error")}, entities.ErrBatchAborted)
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+		{
+			name:        "Failed with internal server error - Due to unexpected error on commit",
+			requestBody: `{"operations":[{"op":"delete","id":1}]}`,
+			setupMock: func() {
+				mockService.EXPECT().Batch(gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/v1/todos:batch", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
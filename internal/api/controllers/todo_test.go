@@ -17,6 +17,123 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+func TestGetAllTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockTodoServicer(ctrl)
+	controller := NewTodoController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos", controller.GetAll)
+
+	testCases := []struct {
+		name           string
+		boardIdParam   string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:         "Success to Get todos in rank order",
+			boardIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1).
+					Return([]*entities.Todo{
+						{
+							Id:        2,
+							Title:     "first",
+							StatusId:  1,
+							Rank:      "A",
+							BoardId:   1,
+							CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+							UpdatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						},
+						{
+							Id:        1,
+							Title:     "second",
+							StatusId:  1,
+							Rank:      "B",
+							BoardId:   1,
+							CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+							UpdatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{"todos":[
+				{
+					"id":2,
+					"title":"first",
+					"status_id":1,
+					"done":false,
+					"priority":0,
+					"rank":"A",
+					"board_id":1,
+					"created_at":"2025-05-01T10:00:00Z",
+					"updated_at":"2025-05-01T10:00:00Z",
+					"checklist_progress":{"checked":0,"total":0},
+					"blocked":false
+				},
+				{
+					"id":1,
+					"title":"second",
+					"status_id":1,
+					"done":false,
+					"priority":0,
+					"rank":"B",
+					"board_id":1,
+					"created_at":"2025-05-01T10:00:00Z",
+					"updated_at":"2025-05-01T10:00:00Z",
+					"checklist_progress":{"checked":0,"total":0},
+					"blocked":false
+				}
+			]}`,
+		},
+		{
+			name:         "Success to Get empty todos",
+			boardIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1).Return(nil, nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"todos":[]}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric board id",
+			boardIdParam:   "invalid",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:         "Failed with internal server error - Due to unexpected errors",
+			boardIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1).
+					Return(nil, errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/" + tc.boardIdParam + "/todos"
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
 func TestGetByIdTodo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -40,27 +157,38 @@ func TestGetByIdTodo(t *testing.T) {
 			idParam:      "1",
 			boardIdParam: "1",
 			setupMock: func() {
-				mockService.EXPECT().GetById(1).
+				mockService.EXPECT().GetById(gomock.Any(), 1).
 					Return(&entities.Todo{
 						Id:        1,
 						Title:     "test",
+						StatusId:  2,
 						Done:      false,
 						Priority:  1,
+						Rank:      "V",
 						DueDate:   nil,
 						BoardId:   1,
 						CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
 						UpdatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						ChecklistProgress: entities.ChecklistProgress{
+							Checked: 1,
+							Total:   3,
+						},
+						Blocked: true,
 					}, nil)
 			},
 			expectedStatus: 200,
 			expectedBody: `{
 				"id":1,
 				"title":"test",
+				"status_id":2,
 				"done":false,
 				"priority":1,
+				"rank":"V",
 				"board_id":1,
 				"created_at":"2025-05-01T10:00:00Z",
-				"updated_at":"2025-05-01T10:00:00Z"
+				"updated_at":"2025-05-01T10:00:00Z",
+				"checklist_progress":{"checked":1,"total":3},
+				"blocked":true
 			}`,
 		},
 		{
@@ -76,7 +204,7 @@ func TestGetByIdTodo(t *testing.T) {
 			idParam:      "999",
 			boardIdParam: "1",
 			setupMock: func() {
-				mockService.EXPECT().GetById(999).
+				mockService.EXPECT().GetById(gomock.Any(), 999).
 					Return(nil, sql.ErrNoRows)
 			},
 			expectedStatus: 404,
@@ -87,7 +215,7 @@ func TestGetByIdTodo(t *testing.T) {
 			idParam:      "1",
 			boardIdParam: "1",
 			setupMock: func() {
-				mockService.EXPECT().GetById(1).
+				mockService.EXPECT().GetById(gomock.Any(), 1).
 					Return(nil, errors.New("unexpected error"))
 			},
 			expectedStatus: 500,
@@ -122,22 +250,139 @@ func TestCreateTodo(t *testing.T) {
 	mux.HandleFunc("/v1/boards/{boardId}/todos", controller.Create)
 
 	testCases := []struct {
-		name           string
-		boardIdParam   string
-		requestBody    string
-		setupMock      func()
-		expectedStatus int
-		expectedBody   string
+		name             string
+		boardIdParam     string
+		requestBody      string
+		setupMock        func()
+		expectedStatus   int
+		expectedLocation string
+		expectedBody     string
 	}{
 		{
 			name:         "Success to Create new todo",
 			boardIdParam: "1",
 			requestBody:  `{"title":"TestTodo","done":false,"priority":0,"board_id":1}`,
 			setupMock: func() {
-				mockService.EXPECT().Create(1, "TestTodo", false, 0, nil).Return(nil)
+				mockService.EXPECT().Create(gomock.Any(), 1, "TestTodo", false, nil, 0, nil, nil).
+					Return(&entities.Todo{
+						Id:        5,
+						Title:     "TestTodo",
+						StatusId:  1,
+						Rank:      "V",
+						BoardId:   1,
+						CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+					}, nil)
 			},
-			expectedStatus: 200,
-			expectedBody:   `{"message":"OK"}`,
+			expectedStatus:   201,
+			expectedLocation: "/v1/boards/1/todos/5",
+			expectedBody: `{
+				"id":5,
+				"title":"TestTodo",
+				"status_id":1,
+				"done":false,
+				"priority":0,
+				"rank":"V",
+				"board_id":1,
+				"created_at":"2025-01-01T10:00:00Z",
+				"updated_at":"2025-01-01T10:00:00Z",
+				"checklist_progress":{"checked":0,"total":0},
+				"blocked":false
+			}`,
+		},
+		{
+			name:         "Success to Create new recurring todo",
+			boardIdParam: "1",
+			requestBody:  `{"title":"Chore","board_id":1,"due_date":"2025-06-14T00:00:00Z","recurrence":{"rule":"FREQ=WEEKLY","timezone":"Asia/Tokyo"}}`,
+			setupMock: func() {
+				dueDate := time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC)
+				recurrence := &entities.Recurrence{Rule: "FREQ=WEEKLY", Timezone: "Asia/Tokyo"}
+				mockService.EXPECT().Create(gomock.Any(), 1, "Chore", false, nil, 0, &dueDate, recurrence).
+					Return(&entities.Todo{
+						Id:         6,
+						Title:      "Chore",
+						StatusId:   1,
+						Rank:       "W",
+						BoardId:    1,
+						DueDate:    &dueDate,
+						Recurrence: recurrence,
+						CreatedAt:  time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt:  time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			expectedStatus:   201,
+			expectedLocation: "/v1/boards/1/todos/6",
+			expectedBody: `{
+				"id":6,
+				"title":"Chore",
+				"status_id":1,
+				"done":false,
+				"priority":0,
+				"rank":"W",
+				"board_id":1,
+				"due_date":"2025-06-14T00:00:00Z",
+				"created_at":"2025-01-01T10:00:00Z",
+				"updated_at":"2025-01-01T10:00:00Z",
+				"recurrence":{"rule":"FREQ=WEEKLY","timezone":"Asia/Tokyo"},
+				"checklist_progress":{"checked":0,"total":0},
+				"blocked":false
+			}`,
+		},
+		{
+			name:         "Failed with bad request - Due to invalid recurrence",
+			boardIdParam: "1",
+			requestBody:  `{"title":"Chore","board_id":1,"recurrence":{"rule":"FREQ=HOURLY"}}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, "Chore", false, nil, 0, nil, &entities.Recurrence{Rule: "FREQ=HOURLY"}).
+					Return(nil, entities.ErrInvalidRecurrence)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:         "Success to Create new todo in a status",
+			boardIdParam: "1",
+			requestBody:  `{"title":"TestTodo","status_id":2,"board_id":1}`,
+			setupMock: func() {
+				statusId := 2
+				mockService.EXPECT().Create(gomock.Any(), 1, "TestTodo", false, &statusId, 0, nil, nil).
+					Return(&entities.Todo{
+						Id:        7,
+						Title:     "TestTodo",
+						StatusId:  2,
+						Rank:      "X",
+						BoardId:   1,
+						CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			expectedStatus:   201,
+			expectedLocation: "/v1/boards/1/todos/7",
+			expectedBody: `{
+				"id":7,
+				"title":"TestTodo",
+				"status_id":2,
+				"done":false,
+				"priority":0,
+				"rank":"X",
+				"board_id":1,
+				"created_at":"2025-01-01T10:00:00Z",
+				"updated_at":"2025-01-01T10:00:00Z",
+				"checklist_progress":{"checked":0,"total":0},
+				"blocked":false
+			}`,
+		},
+		{
+			name:         "Failed with bad request - Due to status of another room",
+			boardIdParam: "1",
+			requestBody:  `{"title":"TestTodo","status_id":99,"board_id":1}`,
+			setupMock: func() {
+				statusId := 99
+				mockService.EXPECT().Create(gomock.Any(), 1, "TestTodo", false, &statusId, 0, nil, nil).
+					Return(nil, entities.ErrInvalidStatus)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
 		},
 		{
 			name:           "Failed with bad request - Due to number of characters in the title is more than 50",
@@ -160,6 +405,7 @@ func TestCreateTodo(t *testing.T) {
 			mux.ServeHTTP(res, req)
 
 			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.Equal(t, tc.expectedLocation, res.Header().Get("Location"))
 			assert.JSONEq(t, tc.expectedBody, res.Body.String())
 		})
 	}
@@ -190,12 +436,38 @@ func TestUpdateTodo(t *testing.T) {
 			boardIdParam: "1",
 			requestBody:  `{"title":"UpdateTitle!","done":true,"priority":0,"board_id":1}`,
 			setupMock: func() {
-				mockService.EXPECT().Update(1, "UpdateTitle!", true, 0, nil).
+				mockService.EXPECT().Update(gomock.Any(), 1, "UpdateTitle!", true, nil, 0, nil, nil).
 					Return(nil)
 			},
 			expectedStatus: 200,
 			expectedBody:   `{"message":"OK"}`,
 		},
+		{
+			name:         "Success to Update todo status",
+			idParam:      "1",
+			boardIdParam: "1",
+			requestBody:  `{"title":"UpdateTitle!","status_id":3,"priority":0,"board_id":1}`,
+			setupMock: func() {
+				statusId := 3
+				mockService.EXPECT().Update(gomock.Any(), 1, "UpdateTitle!", false, &statusId, 0, nil, nil).
+					Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:         "Failed with bad request - Due to status of another room",
+			idParam:      "1",
+			boardIdParam: "1",
+			requestBody:  `{"title":"UpdateTitle!","status_id":99,"priority":0,"board_id":1}`,
+			setupMock: func() {
+				statusId := 99
+				mockService.EXPECT().Update(gomock.Any(), 1, "UpdateTitle!", false, &statusId, 0, nil, nil).
+					Return(entities.ErrInvalidStatus)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
 		{
 			name:           "Failed with invalid request - Due to non-numeric id",
 			idParam:        "invalid",
@@ -220,19 +492,43 @@ func TestUpdateTodo(t *testing.T) {
 			boardIdParam: "1",
 			requestBody:  `{"title":"UpdateTitle!","done":false,"priority":1,"board_id":1}`,
 			setupMock: func() {
-				mockService.EXPECT().Update(999, "UpdateTitle!", false, 1, nil).
+				mockService.EXPECT().Update(gomock.Any(), 999, "UpdateTitle!", false, nil, 1, nil, nil).
 					Return(sql.ErrNoRows)
 			},
 			expectedStatus: 404,
 			expectedBody:   `{"message":"Not Found"}`,
 		},
+		{
+			name:         "Failed with conflict - Due to open blockers",
+			idParam:      "1",
+			boardIdParam: "1",
+			requestBody:  `{"title":"UpdateTitle!","done":true,"priority":1,"board_id":1}`,
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), 1, "UpdateTitle!", true, nil, 1, nil, nil).
+					Return(entities.ErrTodoBlocked)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+		{
+			name:         "Failed with conflict - Due to transition not allowed by the workflow",
+			idParam:      "1",
+			boardIdParam: "1",
+			requestBody:  `{"title":"UpdateTitle!","done":true,"priority":1,"board_id":1}`,
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), 1, "UpdateTitle!", true, nil, 1, nil, nil).
+					Return(entities.ErrTransitionNotAllowed)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
 		{
 			name:         "Failed with internal server error - Due to unexpected errors",
 			idParam:      "1",
 			boardIdParam: "1",
 			requestBody:  `{"title":"UpdateTitle!","done":false,"priority":1,"board_id":1}`,
 			setupMock: func() {
-				mockService.EXPECT().Update(1, "UpdateTitle!", false, 1, nil).
+				mockService.EXPECT().Update(gomock.Any(), 1, "UpdateTitle!", false, nil, 1, nil, nil).
 					Return(errors.New("unexpected error"))
 			},
 			expectedStatus: 500,
@@ -256,6 +552,278 @@ func TestUpdateTodo(t *testing.T) {
 	}
 }
 
+func TestMoveTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockTodoServicer(ctrl)
+	controller := NewTodoController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{id}/move", controller.Move)
+
+	beforeId, afterId := 2, 3
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Move todo between two todos",
+			idParam:     "1",
+			requestBody: `{"before_id":2,"after_id":3}`,
+			setupMock: func() {
+				mockService.EXPECT().Move(gomock.Any(), 1, 1, &beforeId, &afterId).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:        "Success to Move todo before another",
+			idParam:     "1",
+			requestBody: `{"before_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().Move(gomock.Any(), 1, 1, &beforeId, nil).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric id",
+			idParam:        "invalid",
+			requestBody:    `{"before_id":2}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with invalid request - Due to no neighbour",
+			idParam:     "1",
+			requestBody: `{}`,
+			setupMock: func() {
+				mockService.EXPECT().Move(gomock.Any(), 1, 1, nil, nil).Return(entities.ErrInvalidRank)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with not found - Due to neighbour in another board",
+			idParam:     "1",
+			requestBody: `{"after_id":3}`,
+			setupMock: func() {
+				mockService.EXPECT().Move(gomock.Any(), 1, 1, nil, &afterId).Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:        "Failed with internal server error - Due to unexpected errors",
+			idParam:     "1",
+			requestBody: `{"after_id":3}`,
+			setupMock: func() {
+				mockService.EXPECT().Move(gomock.Any(), 1, 1, nil, &afterId).Return(errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/" + tc.idParam + "/move"
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, path, body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestMoveToBoardTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockTodoServicer(ctrl)
+	controller := NewTodoController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{id}/transfer", controller.MoveToBoard)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Move todo to another board",
+			idParam:     "1",
+			requestBody: `{"board_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().MoveToBoard(gomock.Any(), 1, 1, 2).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to missing board id",
+			idParam:        "1",
+			requestBody:    `{}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with not found - Due to not exist board",
+			idParam:     "1",
+			requestBody: `{"board_id":999}`,
+			setupMock: func() {
+				mockService.EXPECT().MoveToBoard(gomock.Any(), 1, 1, 999).Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:        "Failed with conflict - Due to no matching status in the target room",
+			idParam:     "1",
+			requestBody: `{"board_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().MoveToBoard(gomock.Any(), 1, 1, 2).Return(entities.ErrStatusRequired)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/" + tc.idParam + "/transfer"
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, path, body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestCopyTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockTodoServicer(ctrl)
+	controller := NewTodoController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{id}/copy", controller.Copy)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to Copy todo to another board",
+			idParam:     "1",
+			requestBody: `{"board_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().CopyToBoard(gomock.Any(), 1, 1, 2).
+					Return(&entities.Todo{
+						Id:        7,
+						Title:     "copied",
+						StatusId:  4,
+						Rank:      "V",
+						BoardId:   2,
+						CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+						ChecklistProgress: entities.ChecklistProgress{
+							Checked: 1,
+							Total:   2,
+						},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"id":7,
+				"title":"copied",
+				"status_id":4,
+				"done":false,
+				"priority":0,
+				"rank":"V",
+				"board_id":2,
+				"created_at":"2025-05-01T10:00:00Z",
+				"updated_at":"2025-05-01T10:00:00Z",
+				"checklist_progress":{"checked":1,"total":2},
+				"blocked":false
+			}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to non-numeric id",
+			idParam:        "invalid",
+			requestBody:    `{"board_id":2}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with not found - Due to not exist todo",
+			idParam:     "999",
+			requestBody: `{"board_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().CopyToBoard(gomock.Any(), 1, 999, 2).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:        "Failed with internal server error - Due to unexpected errors",
+			idParam:     "1",
+			requestBody: `{"board_id":2}`,
+			setupMock: func() {
+				mockService.EXPECT().CopyToBoard(gomock.Any(), 1, 1, 2).Return(nil, errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/" + tc.idParam + "/copy"
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, path, body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
 func TestDeleteTodo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -279,7 +847,7 @@ func TestDeleteTodo(t *testing.T) {
 			idParam:      "1",
 			boardIdParam: "1",
 			setupMock: func() {
-				mockService.EXPECT().Delete(1).
+				mockService.EXPECT().Delete(gomock.Any(), 1).
 					Return(nil)
 			},
 			expectedStatus: 200,
@@ -298,18 +866,29 @@ func TestDeleteTodo(t *testing.T) {
 			idParam:      "999",
 			boardIdParam: "1",
 			setupMock: func() {
-				mockService.EXPECT().Delete(999).
+				mockService.EXPECT().Delete(gomock.Any(), 999).
 					Return(sql.ErrNoRows)
 			},
 			expectedStatus: 404,
 			expectedBody:   `{"message":"Not Found"}`,
 		},
+		{
+			name:         "Failed with conflict - Due to the board is archived",
+			idParam:      "1",
+			boardIdParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().Delete(gomock.Any(), 1).
+					Return(entities.ErrArchived)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
 		{
 			name:         "Failed with internal server error - Due to unexpected errors",
 			idParam:      "1",
 			boardIdParam: "1",
 			setupMock: func() {
-				mockService.EXPECT().Delete(1).
+				mockService.EXPECT().Delete(gomock.Any(), 1).
 					Return(errors.New("unexpected error"))
 			},
 			expectedStatus: 500,
@@ -332,3 +911,151 @@ func TestDeleteTodo(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRevisionsTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockTodoServicer(ctrl)
+	controller := NewTodoController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{id}/revisions", controller.GetRevisions)
+
+	createdAt := time.Date(2025, 8, 16, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		idParam        string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "Success to get todo revisions with changes",
+			idParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().GetRevisions(gomock.Any(), 1, 1).Return([]*entities.TodoRevision{
+					{Id: 1, TodoId: 1, Version: 1, Title: "first", StatusId: 1, Actor: "alice", CreatedAt: createdAt},
+					{Id: 2, TodoId: 1, Version: 2, Title: "second", StatusId: 1, Priority: 2, Actor: "bob", CreatedAt: createdAt},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{"revisions":[
+				{"version":1,"title":"first","status_id":1,"priority":0,"actor":"alice","created_at":"2025-08-16T09:00:00Z",
+				 "changes":{"title":{"from":null,"to":"first"},"status_id":{"from":null,"to":1}}},
+				{"version":2,"title":"second","status_id":1,"priority":2,"actor":"bob","created_at":"2025-08-16T09:00:00Z",
+				 "changes":{"title":{"from":"first","to":"second"},"priority":{"from":0,"to":2}}}
+			]}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to invalid id",
+			idParam:        "abc",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:    "Failed with not found - Due to not exist todo",
+			idParam: "999",
+			setupMock: func() {
+				mockService.EXPECT().GetRevisions(gomock.Any(), 1, 999).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/" + tc.idParam + "/revisions"
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestRevertTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockTodoServicer(ctrl)
+	controller := NewTodoController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/boards/{boardId}/todos/{id}/revisions/{version}/revert", controller.Revert)
+
+	testCases := []struct {
+		name           string
+		versionParam   string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:         "Success to revert todo",
+			versionParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().Revert(gomock.Any(), 1, 1, 1).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with invalid request - Due to invalid version",
+			versionParam:   "abc",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:         "Failed with not found - Due to not exist version",
+			versionParam: "99",
+			setupMock: func() {
+				mockService.EXPECT().Revert(gomock.Any(), 1, 1, 99).Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name:         "Failed with conflict - Due to the status of the revision was deleted",
+			versionParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().Revert(gomock.Any(), 1, 1, 1).Return(entities.ErrInvalidStatus)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+		{
+			name:         "Failed with conflict - Due to the board is archived",
+			versionParam: "1",
+			setupMock: func() {
+				mockService.EXPECT().Revert(gomock.Any(), 1, 1, 1).Return(entities.ErrArchived)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			path := "/v1/boards/1/todos/1/revisions/" + tc.versionParam + "/revert"
+			req := httptest.NewRequest(http.MethodPost, path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
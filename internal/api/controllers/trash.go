@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type TrashController struct {
+	service interfaces.TrashServicer
+}
+
+func NewTrashController(service interfaces.TrashServicer) *TrashController {
+	return &TrashController{
+		service: service,
+	}
+}
+
+func (tc *TrashController) GetAll(w http.ResponseWriter, r *http.Request) {
+	trash, err := tc.service.GetAll(r.Context())
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertTrashResponse(trash)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (tc *TrashController) RestoreRoom(w http.ResponseWriter, r *http.Request) {
+	restore(w, r, tc.service.RestoreRoom)
+}
+
+func (tc *TrashController) RestoreBoard(w http.ResponseWriter, r *http.Request) {
+	restore(w, r, tc.service.RestoreBoard)
+}
+
+func (tc *TrashController) RestoreTodo(w http.ResponseWriter, r *http.Request) {
+	restore(w, r, tc.service.RestoreTodo)
+}
+
+func restore(w http.ResponseWriter, r *http.Request, fn func(ctx context.Context, id int) error) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = fn(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			response.Error(w, http.StatusNotFound, err)
+		case errors.Is(err, entities.ErrTrashParentDeleted):
+			response.Error(w, http.StatusConflict, err)
+		default:
+			response.Error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
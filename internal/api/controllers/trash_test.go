@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAllTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockTrashServicer(ctrl)
+	controller := NewTrashController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/trash/", controller.GetAll)
+
+	deletedAt := time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success to Get all trashed rooms, boards and todos",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any()).
+					Return(&entities.Trash{
+						Rooms: []*entities.Room{
+							{
+								Id:        1,
+								Name:      "test room",
+								CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+								UpdatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+								DeletedAt: &deletedAt,
+							},
+						},
+						Todos: []*entities.Todo{
+							{
+								Id:        3,
+								Title:     "test todo",
+								StatusId:  1,
+								Rank:      "a",
+								BoardId:   2,
+								CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+								UpdatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+								DeletedAt: &deletedAt,
+							},
+						},
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"rooms":[
+					{
+						"id":1,
+						"name":"test room",
+						"created_at":"2025-01-01T10:00:00Z",
+						"updated_at":"2025-01-01T10:00:00Z",
+						"deleted_at":"2025-07-01T10:00:00Z"
+					}
+				],
+				"boards":[],
+				"todos":[
+					{
+						"id":3,
+						"title":"test todo",
+						"status_id":1,
+						"done":false,
+						"priority":0,
+						"rank":"a",
+						"board_id":2,
+						"created_at":"2025-01-01T10:00:00Z",
+						"updated_at":"2025-01-01T10:00:00Z",
+						"deleted_at":"2025-07-01T10:00:00Z",
+						"checklist_progress":{"checked":0,"total":0},
+						"blocked":false
+					}
+				]
+			}`,
+		},
+		{
+			name: "Failed with internal server error - Due to unexpected errors",
+			setupMock: func() {
+				mockService.EXPECT().GetAll(gomock.Any()).
+					Return(nil, errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			req := httptest.NewRequest(http.MethodGet, "/v1/trash/", nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestRestoreTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockTrashServicer(ctrl)
+	controller := NewTrashController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/trash/rooms/{id}/restore", controller.RestoreRoom)
+	mux.HandleFunc("POST /v1/trash/boards/{id}/restore", controller.RestoreBoard)
+	mux.HandleFunc("POST /v1/trash/todos/{id}/restore", controller.RestoreTodo)
+
+	testCases := []struct {
+		name           string
+		path           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success to Restore room",
+			path: "/v1/trash/rooms/1/restore",
+			setupMock: func() {
+				mockService.EXPECT().RestoreRoom(gomock.Any(), 1).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name: "Success to Restore board",
+			path: "/v1/trash/boards/2/restore",
+			setupMock: func() {
+				mockService.EXPECT().RestoreBoard(gomock.Any(), 2).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name: "Success to Restore todo",
+			path: "/v1/trash/todos/3/restore",
+			setupMock: func() {
+				mockService.EXPECT().RestoreTodo(gomock.Any(), 3).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"message":"OK"}`,
+		},
+		{
+			name:           "Failed with bad request - Due to invalid id",
+			path:           "/v1/trash/rooms/abc/restore",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name: "Failed with not found - Due to the board is not in the trash",
+			path: "/v1/trash/boards/2/restore",
+			setupMock: func() {
+				mockService.EXPECT().RestoreBoard(gomock.Any(), 2).Return(sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+		{
+			name: "Failed with conflict - Due to the board of the todo is in the trash",
+			path: "/v1/trash/todos/3/restore",
+			setupMock: func() {
+				mockService.EXPECT().RestoreTodo(gomock.Any(), 3).Return(entities.ErrTrashParentDeleted)
+			},
+			expectedStatus: 409,
+			expectedBody:   `{"message":"Conflict"}`,
+		},
+		{
+			name: "Failed with internal server error - Due to unexpected errors",
+			path: "/v1/trash/rooms/1/restore",
+			setupMock: func() {
+				mockService.EXPECT().RestoreRoom(gomock.Any(), 1).Return(errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			req := httptest.NewRequest(http.MethodPost, tc.path, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
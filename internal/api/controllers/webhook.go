@@ -0,0 +1,219 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/request"
+	"github.com/rm-ryou/sample_todo_app/internal/api/controllers/presenter/response"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type WebhookController struct {
+	service interfaces.WebhookServicer
+}
+
+func NewWebhookController(service interfaces.WebhookServicer) *WebhookController {
+	return &WebhookController{
+		service: service,
+	}
+}
+
+func (wc *WebhookController) GetAll(w http.ResponseWriter, r *http.Request) {
+	roomIdStr := r.PathValue("roomId")
+	roomId, err := strconv.Atoi(roomIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	webhooks, err := wc.service.GetAll(r.Context(), roomId)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := response.ConvertWebhooksResponse(webhooks)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (wc *WebhookController) GetById(w http.ResponseWriter, r *http.Request) {
+	roomId, id, err := parseWebhookPath(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	webhook, err := wc.service.GetById(r.Context(), roomId, id)
+	if err != nil {
+		webhookError(w, err)
+		return
+	}
+
+	res := response.ConvertWebhookResponse(webhook)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (wc *WebhookController) Create(w http.ResponseWriter, r *http.Request) {
+	roomIdStr := r.PathValue("roomId")
+	roomId, err := strconv.Atoi(roomIdStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	req, err := decodeWebhook(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	webhook, err := wc.service.Create(r.Context(), roomId, req.URL, req.Secret, req.EventTypes)
+	if err != nil {
+		webhookError(w, err)
+		return
+	}
+
+	res := response.ConvertWebhookResponse(webhook)
+	response.Created(w, fmt.Sprintf("/v1/rooms/%d/webhooks/%d", roomId, webhook.Id), res)
+}
+
+func (wc *WebhookController) Update(w http.ResponseWriter, r *http.Request) {
+	roomId, id, err := parseWebhookPath(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	req, err := decodeWebhook(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	enabled := req.Enabled == nil || *req.Enabled
+	webhook, err := wc.service.Update(r.Context(), roomId, id, req.URL, req.Secret, req.EventTypes, enabled)
+	if err != nil {
+		webhookError(w, err)
+		return
+	}
+
+	res := response.ConvertWebhookResponse(webhook)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func (wc *WebhookController) Delete(w http.ResponseWriter, r *http.Request) {
+	roomId, id, err := parseWebhookPath(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := wc.service.Delete(r.Context(), roomId, id); err != nil {
+		webhookError(w, err)
+		return
+	}
+
+	response.Basic(w, http.StatusOK, response.BasicResponse{Message: "OK"})
+}
+
+// GetDeliveries lists the webhook's deliveries newest first, paged with the before_id and
+// limit query parameters.
+func (wc *WebhookController) GetDeliveries(w http.ResponseWriter, r *http.Request) {
+	roomId, id, err := parseWebhookPath(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	filter := &entities.WebhookDeliveryFilter{WebhookId: id}
+	for name, dst := range map[string]*int{
+		"before_id": &filter.BeforeId,
+		"limit":     &filter.Limit,
+	} {
+		if value := r.URL.Query().Get(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				response.Error(w, http.StatusBadRequest, err)
+				return
+			}
+			*dst = n
+		}
+	}
+
+	deliveries, err := wc.service.GetDeliveries(r.Context(), roomId, filter)
+	if err != nil {
+		webhookError(w, err)
+		return
+	}
+
+	res := response.ConvertWebhookDeliveriesResponse(deliveries)
+	response.Basic(w, http.StatusOK, res)
+}
+
+// SendTest answers with the delivery of the test event, which tells whether the receiver
+// accepted it. A receiver that failed does not fail the request.
+func (wc *WebhookController) SendTest(w http.ResponseWriter, r *http.Request) {
+	roomId, id, err := parseWebhookPath(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	delivery, err := wc.service.SendTest(r.Context(), roomId, id)
+	if err != nil {
+		webhookError(w, err)
+		return
+	}
+
+	res := response.ConvertWebhookDeliveryResponse(delivery)
+	response.Basic(w, http.StatusOK, res)
+}
+
+func webhookError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		response.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, entities.ErrInvalidWebhookURL),
+		errors.Is(err, entities.ErrInvalidWebhookSecret),
+		errors.Is(err, entities.ErrInvalidWebhookEventTypes),
+		errors.Is(err, entities.ErrInvalidWebhookDeliveryFilter):
+		response.Error(w, http.StatusBadRequest, err)
+	default:
+		response.Error(w, http.StatusInternalServerError, err)
+	}
+}
+
+func parseWebhookPath(r *http.Request) (int, int, error) {
+	roomId, err := strconv.Atoi(r.PathValue("roomId"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return roomId, id, nil
+}
+
+func decodeWebhook(r *http.Request) (*request.Webhook, error) {
+	var req request.Webhook
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
@@ -0,0 +1,349 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_service "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockWebhookServicer(ctrl)
+	controller := NewWebhookController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/webhooks/", controller.Create)
+
+	testCases := []struct {
+		name             string
+		requestBody      string
+		setupMock        func()
+		expectedStatus   int
+		expectedLocation string
+		expectedBody     string
+	}{
+		{
+			name:        "Success to create webhook without exposing its secret",
+			requestBody: `{"url":"https://example.com/hook","secret":"s3cret","event_types":["todo.created"]}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, "https://example.com/hook", "s3cret", []string{entities.EventTodoCreated}).
+					Return(&entities.Webhook{
+						Id:         2,
+						RoomId:     1,
+						URL:        "https://example.com/hook",
+						Secret:     "s3cret",
+						EventTypes: []string{entities.EventTodoCreated},
+						CreatedAt:  time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC),
+						UpdatedAt:  time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			expectedStatus:   201,
+			expectedLocation: "/v1/rooms/1/webhooks/2",
+			expectedBody: `{
+				"id":2,
+				"room_id":1,
+				"url":"https://example.com/hook",
+				"event_types":["todo.created"],
+				"enabled":true,
+				"failure_count":0,
+				"created_at":"2025-09-06T09:00:00Z",
+				"updated_at":"2025-09-06T09:00:00Z"
+			}`,
+		},
+		{
+			name:           "Failed with bad request - Due to no event types",
+			requestBody:    `{"url":"https://example.com/hook","secret":"s3cret","event_types":[]}`,
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with bad request - Due to unknown event type",
+			requestBody: `{"url":"https://example.com/hook","secret":"s3cret","event_types":["board.renamed"]}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, "https://example.com/hook", "s3cret", []string{"board.renamed"}).
+					Return(nil, entities.ErrInvalidWebhookEventTypes)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:        "Failed with not found - Due to the room does not exist",
+			requestBody: `{"url":"https://example.com/hook","secret":"s3cret","event_types":["todo.created"]}`,
+			setupMock: func() {
+				mockService.EXPECT().Create(gomock.Any(), 1, "https://example.com/hook", "s3cret", []string{entities.EventTodoCreated}).
+					Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/v1/rooms/1/webhooks/", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.Equal(t, tc.expectedLocation, res.Header().Get("Location"))
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockWebhookServicer(ctrl)
+	controller := NewWebhookController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/webhooks/{id}", controller.Update)
+
+	disabledAt := time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success to update webhook, enabling it by default",
+			requestBody: `{"url":"https://example.com/hook","event_types":["todo.deleted"]}`,
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), 1, 2, "https://example.com/hook", "", []string{entities.EventTodoDeleted}, true).
+					Return(&entities.Webhook{Id: 2, RoomId: 1, URL: "https://example.com/hook", EventTypes: []string{entities.EventTodoDeleted}}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"id":2,
+				"room_id":1,
+				"url":"https://example.com/hook",
+				"event_types":["todo.deleted"],
+				"enabled":true,
+				"failure_count":0,
+				"created_at":"0001-01-01T00:00:00Z",
+				"updated_at":"0001-01-01T00:00:00Z"
+			}`,
+		},
+		{
+			name:        "Success to disable webhook",
+			requestBody: `{"url":"https://example.com/hook","event_types":["todo.deleted"],"enabled":false}`,
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), 1, 2, "https://example.com/hook", "", []string{entities.EventTodoDeleted}, false).
+					Return(&entities.Webhook{Id: 2, RoomId: 1, URL: "https://example.com/hook", EventTypes: []string{entities.EventTodoDeleted}, DisabledAt: &disabledAt}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"id":2,
+				"room_id":1,
+				"url":"https://example.com/hook",
+				"event_types":["todo.deleted"],
+				"enabled":false,
+				"failure_count":0,
+				"disabled_at":"2025-09-06T09:00:00Z",
+				"created_at":"0001-01-01T00:00:00Z",
+				"updated_at":"0001-01-01T00:00:00Z"
+			}`,
+		},
+		{
+			name:        "Failed with not found - Due to the webhook belongs to another room",
+			requestBody: `{"url":"https://example.com/hook","event_types":["todo.deleted"]}`,
+			setupMock: func() {
+				mockService.EXPECT().Update(gomock.Any(), 1, 2, "https://example.com/hook", "", []string{entities.EventTodoDeleted}, true).
+					Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: 404,
+			expectedBody:   `{"message":"Not Found"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+			body := bytes.NewBufferString(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/v1/rooms/1/webhooks/2", body)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestGetWebhookDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockWebhookServicer(ctrl)
+	controller := NewWebhookController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/webhooks/{id}/deliveries", controller.GetDeliveries)
+
+	statusCode := http.StatusServiceUnavailable
+	nextAttemptAt := time.Date(2025, 9, 6, 9, 1, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		query          string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "Success to get deliveries",
+			query: "?before_id=10&limit=1",
+			setupMock: func() {
+				mockService.EXPECT().GetDeliveries(gomock.Any(), 1, &entities.WebhookDeliveryFilter{WebhookId: 2, BeforeId: 10, Limit: 1}).
+					Return([]*entities.WebhookDelivery{{
+						Id:            9,
+						WebhookId:     2,
+						EventId:       30,
+						EventType:     entities.EventTodoCreated,
+						Attempts:      1,
+						StatusCode:    &statusCode,
+						LastError:     "webhook responded with 503 Service Unavailable",
+						NextAttemptAt: &nextAttemptAt,
+						CreatedAt:     time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC),
+					}}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{"deliveries":[{
+				"id":9,
+				"webhook_id":2,
+				"event_id":30,
+				"event_type":"todo.created",
+				"status":"pending",
+				"attempts":1,
+				"status_code":503,
+				"last_error":"webhook responded with 503 Service Unavailable",
+				"next_attempt_at":"2025-09-06T09:01:00Z",
+				"created_at":"2025-09-06T09:00:00Z"
+			}]}`,
+		},
+		{
+			name:           "Failed with bad request - Due to non numeric limit",
+			query:          "?limit=ten",
+			setupMock:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+		{
+			name:  "Failed with bad request - Due to a limit above the maximum",
+			query: "?limit=1000",
+			setupMock: func() {
+				mockService.EXPECT().GetDeliveries(gomock.Any(), 1, &entities.WebhookDeliveryFilter{WebhookId: 2, Limit: 1000}).
+					Return(nil, entities.ErrInvalidWebhookDeliveryFilter)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"message":"Bad Request"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/rooms/1/webhooks/2/deliveries"+tc.query, nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
+
+func TestSendTestWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockWebhookServicer(ctrl)
+	controller := NewWebhookController(mockService)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/rooms/{roomId}/webhooks/{id}/test", controller.SendTest)
+
+	statusCode := http.StatusNotFound
+	failedAt := time.Date(2025, 9, 6, 9, 0, 1, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success to report a test event the receiver rejected",
+			setupMock: func() {
+				mockService.EXPECT().SendTest(gomock.Any(), 1, 2).
+					Return(&entities.WebhookDelivery{
+						Id:         9,
+						WebhookId:  2,
+						EventType:  entities.WebhookEventTest,
+						Attempts:   1,
+						StatusCode: &statusCode,
+						LastError:  "webhook responded with 404 Not Found",
+						FailedAt:   &failedAt,
+						CreatedAt:  time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: `{
+				"id":9,
+				"webhook_id":2,
+				"event_type":"webhook.test",
+				"status":"failed",
+				"attempts":1,
+				"status_code":404,
+				"last_error":"webhook responded with 404 Not Found",
+				"failed_at":"2025-09-06T09:00:01Z",
+				"created_at":"2025-09-06T09:00:00Z"
+			}`,
+		},
+		{
+			name: "Failed with internal server error - Due to unexpected errors",
+			setupMock: func() {
+				mockService.EXPECT().SendTest(gomock.Any(), 1, 2).Return(nil, errors.New("unexpected error"))
+			},
+			expectedStatus: 500,
+			expectedBody:   `{"message":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/rooms/1/webhooks/2/test", nil)
+			res := httptest.NewRecorder()
+
+			mux.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			assert.JSONEq(t, tc.expectedBody, res.Body.String())
+		})
+	}
+}
@@ -2,14 +2,31 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type (
 	Config struct {
-		Port string `mapstructure:"PORT"`
-		DB   DB     `mapstructure:",squash"`
+		Port        string      `mapstructure:"PORT"`
+		CORS        CORS        `mapstructure:",squash"`
+		DB          DB          `mapstructure:",squash"`
+		Todo        Todo        `mapstructure:",squash"`
+		Reminder    Reminder    `mapstructure:",squash"`
+		Idempotency Idempotency `mapstructure:",squash"`
+		Trash       Trash       `mapstructure:",squash"`
+		Audit       Audit       `mapstructure:",squash"`
+		Events      Events      `mapstructure:",squash"`
+		Outbox      Outbox      `mapstructure:",squash"`
+		Webhook     Webhook     `mapstructure:",squash"`
+		Sync        Sync        `mapstructure:",squash"`
+	}
+
+	CORS struct {
+		// AllowedOrigins are the origins, such as https://app.example.com, that browsers may call
+		// the API and open board sockets from besides its own. Empty allows the same origin only.
+		AllowedOrigins []string `mapstructure:"CORS_ALLOWED_ORIGINS"`
 	}
 
 	DB struct {
@@ -18,6 +35,86 @@ type (
 		Password string `mapstructure:"MYSQL_PASSWORD"`
 		Host     string `mapstructure:"MYSQL_HOST"`
 		Port     string `mapstructure:"MYSQL_PORT"`
+		// QueryTimeout bounds the queries of a single request; zero disables it.
+		QueryTimeout time.Duration `mapstructure:"MYSQL_QUERY_TIMEOUT"`
+	}
+
+	Todo struct {
+		// RequireBlockersDone rejects completing a todo while any of its blockers is still open.
+		RequireBlockersDone bool `mapstructure:"TODO_REQUIRE_BLOCKERS_DONE"`
+		// RankMaxLength is the rank length above which a move rebalances the whole board.
+		RankMaxLength int `mapstructure:"TODO_RANK_MAX_LENGTH"`
+		BatchMaxSize  int `mapstructure:"TODO_BATCH_MAX_SIZE"`
+	}
+
+	Reminder struct {
+		PollInterval time.Duration `mapstructure:"REMINDER_POLL_INTERVAL"`
+		BatchSize    int           `mapstructure:"REMINDER_BATCH_SIZE"`
+		// ClaimTimeout is how long a claim by a replica that died mid-dispatch blocks a reminder.
+		ClaimTimeout time.Duration `mapstructure:"REMINDER_CLAIM_TIMEOUT"`
+		WebhookURL   string        `mapstructure:"REMINDER_WEBHOOK_URL"`
+	}
+
+	Idempotency struct {
+		// KeyTTL is how long a response is replayed for repeats of an Idempotency-Key.
+		KeyTTL time.Duration `mapstructure:"IDEMPOTENCY_KEY_TTL"`
+		// LockTimeout is how long a request that died before responding holds its key. It has to
+		// outlast the longest request.
+		LockTimeout   time.Duration `mapstructure:"IDEMPOTENCY_LOCK_TIMEOUT"`
+		PurgeInterval time.Duration `mapstructure:"IDEMPOTENCY_PURGE_INTERVAL"`
+	}
+
+	Trash struct {
+		// Retention is how long deleted rooms, boards and todos can be restored before they are purged.
+		Retention     time.Duration `mapstructure:"TRASH_RETENTION"`
+		PurgeInterval time.Duration `mapstructure:"TRASH_PURGE_INTERVAL"`
+	}
+
+	Audit struct {
+		// AdminToken is the bearer token for reading the audit log; empty disables the endpoint.
+		AdminToken string `mapstructure:"AUDIT_ADMIN_TOKEN"`
+	}
+
+	Events struct {
+		// HeartbeatInterval is how often an idle stream gets a comment to keep proxies from closing it.
+		HeartbeatInterval time.Duration `mapstructure:"EVENTS_HEARTBEAT_INTERVAL"`
+		// BacklogSize is how many recent events of each room are kept for clients resuming with Last-Event-ID.
+		BacklogSize int `mapstructure:"EVENTS_BACKLOG_SIZE"`
+		// SubscriberBuffer is how many events a stream may fall behind before it is dropped.
+		SubscriberBuffer int           `mapstructure:"EVENTS_SUBSCRIBER_BUFFER"`
+		WriteTimeout     time.Duration `mapstructure:"EVENTS_WRITE_TIMEOUT"`
+	}
+
+	Outbox struct {
+		PollInterval time.Duration `mapstructure:"OUTBOX_POLL_INTERVAL"`
+		BatchSize    int           `mapstructure:"OUTBOX_BATCH_SIZE"`
+		// RelayTimeout is how long events are kept for a replica whose relay stopped polling.
+		// The outbox is purged as often.
+		RelayTimeout time.Duration `mapstructure:"OUTBOX_RELAY_TIMEOUT"`
+	}
+
+	Webhook struct {
+		PollInterval time.Duration `mapstructure:"WEBHOOK_POLL_INTERVAL"`
+		BatchSize    int           `mapstructure:"WEBHOOK_BATCH_SIZE"`
+		// Workers is how many deliveries are sent at once.
+		Workers int           `mapstructure:"WEBHOOK_WORKERS"`
+		Timeout time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+		// ClaimTimeout is how long a claim by a replica that died mid-dispatch blocks a delivery.
+		ClaimTimeout time.Duration `mapstructure:"WEBHOOK_CLAIM_TIMEOUT"`
+		// MaxAttempts is how many times a delivery is sent before it is given up.
+		MaxAttempts int `mapstructure:"WEBHOOK_MAX_ATTEMPTS"`
+		// RetryBaseDelay is the wait after the first failed attempt, doubled after each further one
+		// up to RetryMaxDelay.
+		RetryBaseDelay time.Duration `mapstructure:"WEBHOOK_RETRY_BASE_DELAY"`
+		RetryMaxDelay  time.Duration `mapstructure:"WEBHOOK_RETRY_MAX_DELAY"`
+		// DisableAfter is how many failed attempts in a row disable a webhook.
+		DisableAfter int `mapstructure:"WEBHOOK_DISABLE_AFTER"`
+	}
+
+	Sync struct {
+		// PageSize is how many changes a pull reads at most; clients pull again while there are more.
+		PageSize     int `mapstructure:"SYNC_PAGE_SIZE"`
+		MaxMutations int `mapstructure:"SYNC_MAX_MUTATIONS"`
 	}
 )
 
@@ -27,8 +124,39 @@ func NewConfig() (*Config, error) {
 	viper.AddConfigPath(".")
 
 	viper.SetDefault("PORT", "8080")
+	viper.SetDefault("CORS_ALLOWED_ORIGINS", "")
 	viper.SetDefault("MYSQL_HOST", "mysql")
 	viper.SetDefault("MYSQL_PORT", "3306")
+	viper.SetDefault("MYSQL_QUERY_TIMEOUT", "5s")
+	viper.SetDefault("TODO_REQUIRE_BLOCKERS_DONE", false)
+	viper.SetDefault("TODO_RANK_MAX_LENGTH", 32)
+	viper.SetDefault("TODO_BATCH_MAX_SIZE", 100)
+	viper.SetDefault("REMINDER_POLL_INTERVAL", "30s")
+	viper.SetDefault("REMINDER_BATCH_SIZE", 100)
+	viper.SetDefault("REMINDER_CLAIM_TIMEOUT", "5m")
+	viper.SetDefault("IDEMPOTENCY_KEY_TTL", "24h")
+	viper.SetDefault("IDEMPOTENCY_LOCK_TIMEOUT", "1m")
+	viper.SetDefault("IDEMPOTENCY_PURGE_INTERVAL", "1h")
+	viper.SetDefault("TRASH_RETENTION", "720h")
+	viper.SetDefault("TRASH_PURGE_INTERVAL", "1h")
+	viper.SetDefault("EVENTS_HEARTBEAT_INTERVAL", "15s")
+	viper.SetDefault("EVENTS_BACKLOG_SIZE", 100)
+	viper.SetDefault("EVENTS_SUBSCRIBER_BUFFER", 32)
+	viper.SetDefault("EVENTS_WRITE_TIMEOUT", "10s")
+	viper.SetDefault("OUTBOX_POLL_INTERVAL", "500ms")
+	viper.SetDefault("OUTBOX_BATCH_SIZE", 500)
+	viper.SetDefault("OUTBOX_RELAY_TIMEOUT", "1m")
+	viper.SetDefault("WEBHOOK_POLL_INTERVAL", "5s")
+	viper.SetDefault("WEBHOOK_BATCH_SIZE", 100)
+	viper.SetDefault("WEBHOOK_WORKERS", 4)
+	viper.SetDefault("WEBHOOK_TIMEOUT", "10s")
+	viper.SetDefault("WEBHOOK_CLAIM_TIMEOUT", "5m")
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 8)
+	viper.SetDefault("WEBHOOK_RETRY_BASE_DELAY", "30s")
+	viper.SetDefault("WEBHOOK_RETRY_MAX_DELAY", "1h")
+	viper.SetDefault("WEBHOOK_DISABLE_AFTER", 20)
+	viper.SetDefault("SYNC_PAGE_SIZE", 500)
+	viper.SetDefault("SYNC_MAX_MUTATIONS", 100)
 
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("Failed to reading config file: %v", err)
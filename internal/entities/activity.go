@@ -0,0 +1,110 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidActivityFilter = errors.New("Invalid activity filter")
+	ErrInvalidFeedToken      = errors.New("Invalid feed token")
+	ErrActorRequired         = errors.New("Actor is required")
+)
+
+const (
+	ActivityTodoCreated   = "todo_created"
+	ActivityTodoCompleted = "todo_completed"
+	ActivityTodoMoved     = "todo_moved"
+	ActivityTodoCommented = "todo_commented"
+	ActivityMemberJoined  = "member_joined"
+)
+
+const (
+	activityDefaultLimit = 50
+	activityMaxLimit     = 200
+)
+
+// Activity is one entry of a room's activity stream. RoomId is taken from the board when
+// the activity is stored; FromBoardId is set for moves only. Members joining concern the
+// room alone, so their activity has no board, todo or title.
+type Activity struct {
+	Id          int
+	RoomId      int
+	BoardId     int
+	TodoId      int
+	FromBoardId *int
+	Kind        string
+	Actor       string
+	Title       string // title of the todo at the time
+	CreatedAt   time.Time
+}
+
+func NewActivity(kind string, todo *Todo, actor string) *Activity {
+	return &Activity{
+		BoardId: todo.BoardId,
+		TodoId:  todo.Id,
+		Kind:    kind,
+		Actor:   actor,
+		Title:   todo.Title,
+	}
+}
+
+func NewMemberActivity(member *RoomMember) *Activity {
+	return &Activity{
+		RoomId: member.RoomId,
+		Kind:   ActivityMemberJoined,
+		Actor:  member.Actor,
+	}
+}
+
+// ActivityFilter pages back through a room's activity, newest first.
+type ActivityFilter struct {
+	RoomId   int
+	BeforeId int
+	Limit    int
+}
+
+// Validate also fills in the default limit.
+func (f *ActivityFilter) Validate() error {
+	if f.BeforeId < 0 || f.Limit < 0 || f.Limit > activityMaxLimit {
+		return ErrInvalidActivityFilter
+	}
+
+	if f.Limit == 0 {
+		f.Limit = activityDefaultLimit
+	}
+
+	return nil
+}
+
+// FeedToken lets a feed reader, which cannot send headers, read activity feeds on behalf of
+// an actor. Only the hash of the token is stored.
+type FeedToken struct {
+	Id        int
+	Actor     string
+	TokenHash string
+	CreatedAt time.Time
+}
+
+// NewFeedToken returns a random token for actor along with the FeedToken to store.
+func NewFeedToken(actor string) (string, *FeedToken, error) {
+	if actor == "" {
+		return "", nil, ErrActorRequired
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	token := hex.EncodeToString(b)
+
+	return token, &FeedToken{Actor: actor, TokenHash: HashFeedToken(token)}, nil
+}
+
+func HashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
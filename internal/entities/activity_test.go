@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateActivityFilter(t *testing.T) {
+	testCases := []struct {
+		name          string
+		filter        ActivityFilter
+		expectedLimit int
+		expectedError error
+	}{
+		{
+			name:          "Success to validate with the default limit",
+			filter:        ActivityFilter{RoomId: 1},
+			expectedLimit: activityDefaultLimit,
+			expectedError: nil,
+		},
+		{
+			name:          "Success to validate with the maximum limit",
+			filter:        ActivityFilter{RoomId: 1, BeforeId: 10, Limit: activityMaxLimit},
+			expectedLimit: activityMaxLimit,
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate - Due to too large limit",
+			filter:        ActivityFilter{RoomId: 1, Limit: activityMaxLimit + 1},
+			expectedLimit: activityMaxLimit + 1,
+			expectedError: ErrInvalidActivityFilter,
+		},
+		{
+			name:          "Failed to validate - Due to negative before id",
+			filter:        ActivityFilter{RoomId: 1, BeforeId: -1},
+			expectedLimit: 0,
+			expectedError: ErrInvalidActivityFilter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.filter.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedLimit, tc.filter.Limit)
+		})
+	}
+}
+
+func TestNewFeedToken(t *testing.T) {
+	token, feedToken, err := NewFeedToken("alice")
+	assert.NoError(t, err)
+	assert.Len(t, token, 64)
+	assert.Equal(t, "alice", feedToken.Actor)
+	assert.Equal(t, HashFeedToken(token), feedToken.TokenHash)
+	assert.NotEqual(t, token, feedToken.TokenHash)
+
+	_, _, err = NewFeedToken("")
+	assert.Equal(t, ErrActorRequired, err)
+}
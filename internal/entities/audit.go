@@ -0,0 +1,105 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrInvalidAuditFilter = errors.New("Invalid audit filter")
+
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+)
+
+const (
+	AuditEntityRoom            = "room"
+	AuditEntityBoard           = "board"
+	AuditEntityStatus          = "status"
+	AuditEntityTodo            = "todo"
+	AuditEntityChecklistItem   = "checklist_item"
+	AuditEntityDependency      = "dependency"
+	AuditEntityReminder        = "reminder"
+	AuditEntityWebhook         = "webhook"
+	AuditEntityIncomingWebhook = "incoming_webhook"
+	AuditEntityComment         = "comment"
+	AuditEntityRoomMember      = "room_member"
+)
+
+const (
+	auditDefaultLimit = 100
+	auditMaxLimit     = 500
+)
+
+// Actor is who made a request, as recorded in the audit log.
+type Actor struct {
+	Name      string
+	RequestId string
+	IP        string
+}
+
+// AuditEvent records one change to an entity. Before and After are JSON snapshots of the
+// entity; Before is nil for creations and After is nil for deletions.
+type AuditEvent struct {
+	Id         int
+	Actor      string
+	EntityType string
+	EntityId   int
+	Action     string
+	Before     json.RawMessage
+	After      json.RawMessage
+	RequestId  string
+	IP         string
+	CreatedAt  time.Time
+}
+
+func NewAuditEvent(actor Actor, entityType string, entityId int, action string, before, after json.RawMessage) *AuditEvent {
+	return &AuditEvent{
+		Actor:      actor.Name,
+		EntityType: entityType,
+		EntityId:   entityId,
+		Action:     action,
+		Before:     before,
+		After:      after,
+		RequestId:  actor.RequestId,
+		IP:         actor.IP,
+	}
+}
+
+// AuditFilter narrows the audit log, newest first. Zero fields match every event, and
+// BeforeId pages back from the last event of the previous page.
+type AuditFilter struct {
+	Actor      string
+	EntityType string
+	EntityId   int
+	Action     string
+	Since      *time.Time
+	Until      *time.Time
+	BeforeId   int
+	Limit      int
+}
+
+// Validate also fills in the default limit.
+func (f *AuditFilter) Validate() error {
+	if f.EntityId < 0 || f.BeforeId < 0 || f.Limit < 0 || f.Limit > auditMaxLimit {
+		return ErrInvalidAuditFilter
+	}
+	if f.Since != nil && f.Until != nil && f.Until.Before(*f.Since) {
+		return ErrInvalidAuditFilter
+	}
+
+	switch f.Action {
+	case "", AuditActionCreate, AuditActionUpdate, AuditActionDelete, AuditActionRestore:
+	default:
+		return ErrInvalidAuditFilter
+	}
+
+	if f.Limit == 0 {
+		f.Limit = auditDefaultLimit
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAuditFilter(t *testing.T) {
+	since := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 8, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		filter        AuditFilter
+		expectedLimit int
+		expectedError error
+	}{
+		{
+			name:          "Success to validate empty filter with the default limit",
+			filter:        AuditFilter{},
+			expectedLimit: 100,
+			expectedError: nil,
+		},
+		{
+			name:          "Success to validate filter with every field",
+			filter:        AuditFilter{Actor: "alice", EntityType: AuditEntityBoard, EntityId: 1, Action: AuditActionDelete, Since: &since, Until: &until, BeforeId: 10, Limit: 20},
+			expectedLimit: 20,
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate filter - Due to unknown action",
+			filter:        AuditFilter{Action: "archive"},
+			expectedError: ErrInvalidAuditFilter,
+		},
+		{
+			name:          "Failed to validate filter - Due to the limit is too large",
+			filter:        AuditFilter{Limit: 501},
+			expectedError: ErrInvalidAuditFilter,
+		},
+		{
+			name:          "Failed to validate filter - Due to until is before since",
+			filter:        AuditFilter{Since: &until, Until: &since},
+			expectedError: ErrInvalidAuditFilter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.filter.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedLimit, tc.filter.Limit)
+			}
+		})
+	}
+}
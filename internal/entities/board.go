@@ -5,13 +5,19 @@ import (
 	"time"
 )
 
+var ErrInvalidBoardOrder = errors.New("Invalid board order")
+
 type Board struct {
-	Id        int
-	Name      string
-	Priority  int
-	RoomId    int
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id           int
+	Name         string
+	Priority     int
+	Position     int // display order within the room
+	RoomId       int
+	ArchivedAt   *time.Time
+	RoomArchived bool // whether the board's room is archived
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time // set while the board is in the trash
 }
 
 func NewBoard(name string, priority, roomId int) *Board {
@@ -38,3 +44,12 @@ func (b *Board) UpdateAttributes(name string, priority int) {
 	b.Name = name
 	b.Priority = priority
 }
+
+// Writable reports ErrArchived while the board or its room is archived.
+func (b *Board) Writable() error {
+	if b.ArchivedAt != nil || b.RoomArchived {
+		return ErrArchived
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -56,3 +57,37 @@ func TestValidateBoard(t *testing.T) {
 		})
 	}
 }
+
+func TestWritableBoard(t *testing.T) {
+	archivedAt := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		board         *Board
+		expectedError error
+	}{
+		{
+			name:          "Success to write - Due to neither the board nor its room is archived",
+			board:         &Board{Name: "test board"},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to write - Due to the board is archived",
+			board:         &Board{Name: "test board", ArchivedAt: &archivedAt},
+			expectedError: ErrArchived,
+		},
+		{
+			name:          "Failed to write - Due to the room is archived",
+			board:         &Board{Name: "test board", RoomArchived: true},
+			expectedError: ErrArchived,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.board.Writable()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
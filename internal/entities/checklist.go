@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidChecklistOrder = errors.New("Invalid checklist order")
+
+type ChecklistItem struct {
+	Id        int
+	TodoId    int
+	Text      string
+	Checked   bool
+	Position  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ChecklistProgress struct {
+	Checked int
+	Total   int
+}
+
+func NewChecklistItem(todoId int, text string, checked bool) *ChecklistItem {
+	return &ChecklistItem{
+		TodoId:  todoId,
+		Text:    text,
+		Checked: checked,
+	}
+}
+
+func (c *ChecklistItem) Validate() error {
+	if c.Text == "" || len(c.Text) > 100 {
+		return errors.New("Invalid text")
+	}
+
+	return nil
+}
+
+func (c *ChecklistItem) UpdateAttributes(text string, checked bool) {
+	c.Text = text
+	c.Checked = checked
+}
@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateChecklistItem(t *testing.T) {
+	testCases := []struct {
+		name          string
+		item          *ChecklistItem
+		expectedError error
+	}{
+		{
+			name:          "Success to validate",
+			item:          &ChecklistItem{Text: "buy milk"},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate - Due to the text is empty",
+			item:          &ChecklistItem{Text: ""},
+			expectedError: errors.New("Invalid text"),
+		},
+		{
+			name:          "Failed to validate - Due to the text is larger than 100 characters",
+			item:          &ChecklistItem{Text: strings.Repeat("a", 101)},
+			expectedError: errors.New("Invalid text"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.item.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
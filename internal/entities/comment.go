@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// Comment is a note left on a todo by its author, the actor who posted it.
+type Comment struct {
+	Id        int
+	TodoId    int
+	Author    string
+	Body      string
+	CreatedAt time.Time
+}
+
+func NewComment(todoId int, author, body string) *Comment {
+	return &Comment{
+		TodoId: todoId,
+		Author: author,
+		Body:   body,
+	}
+}
+
+func (c *Comment) Validate() error {
+	if c.Body == "" || len(c.Body) > 1000 {
+		return errors.New("Invalid body")
+	}
+
+	return nil
+}
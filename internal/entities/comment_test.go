@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateComment(t *testing.T) {
+	testCases := []struct {
+		name          string
+		comment       *Comment
+		expectedError error
+	}{
+		{
+			name:          "Success to validate",
+			comment:       NewComment(1, "alice", "Looks good"),
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate - Due to the body is empty",
+			comment:       NewComment(1, "alice", ""),
+			expectedError: errors.New("Invalid body"),
+		},
+		{
+			name:          "Failed to validate - Due to the body is larger than 1000 characters",
+			comment:       NewComment(1, "alice", strings.Repeat("a", 1001)),
+			expectedError: errors.New("Invalid body"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.comment.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
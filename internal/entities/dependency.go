@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrDependencyCycle       = errors.New("Dependency cycle detected")
+	ErrDependencyOutsideRoom = errors.New("Dependency must be in the same room")
+	ErrTodoBlocked           = errors.New("Todo is blocked by open todos")
+)
+
+// TodoDependency expresses that TodoId is blocked by BlockerId.
+type TodoDependency struct {
+	TodoId    int
+	BlockerId int
+	CreatedAt time.Time
+}
+
+func NewTodoDependency(todoId, blockerId int) *TodoDependency {
+	return &TodoDependency{
+		TodoId:    todoId,
+		BlockerId: blockerId,
+	}
+}
+
+func (d *TodoDependency) Validate() error {
+	if d.TodoId == d.BlockerId {
+		return errors.New("Invalid dependency")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTodoDependency(t *testing.T) {
+	testCases := []struct {
+		name          string
+		dependency    *TodoDependency
+		expectedError error
+	}{
+		{
+			name:          "Success to validate",
+			dependency:    &TodoDependency{TodoId: 1, BlockerId: 2},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate - Due to the todo blocks itself",
+			dependency:    &TodoDependency{TodoId: 1, BlockerId: 1},
+			expectedError: errors.New("Invalid dependency"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.dependency.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
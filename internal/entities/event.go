@@ -0,0 +1,44 @@
+package entities
+
+import "errors"
+
+var ErrEventsClosed = errors.New("Event stream is closed")
+
+const (
+	EventTodoCreated = "todo.created"
+	EventTodoUpdated = "todo.updated"
+	EventTodoMoved   = "todo.moved"
+	EventTodoDeleted = "todo.deleted"
+)
+
+// Event tells the subscribers of a room about a committed change. Ids are those of the
+// outbox, so every server numbers events alike and a client can resume on any of them after
+// the last id it saw.
+type Event struct {
+	Id     int64
+	RoomId int
+	Type   string
+	Data   any // snapshot of the changed entity
+}
+
+// EventSubscription follows the events of one room. Events is closed when the subscriber
+// falls too far behind or the server shuts down; the client then reconnects and resumes.
+type EventSubscription struct {
+	RoomId int
+	// Backlog holds the recent events the client missed since the id it resumed from.
+	Backlog []*Event
+	// Reset reports that the client missed more than the backlog and should reload the room.
+	Reset  bool
+	Events <-chan *Event
+}
+
+// ConcernsBoard reports whether clients viewing the board need the event. Moves are always
+// passed on, as the board a todo left cannot be told from its snapshot.
+func (e *Event) ConcernsBoard(boardId int) bool {
+	todo, ok := e.Data.(*Todo)
+	if !ok {
+		return false
+	}
+
+	return todo.BoardId == boardId || e.Type == EventTodoMoved
+}
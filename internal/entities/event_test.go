@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventConcernsBoard(t *testing.T) {
+	testCases := []struct {
+		name     string
+		event    *Event
+		expected bool
+	}{
+		{
+			name:     "Success to pass on a change to a todo of the board",
+			event:    &Event{Type: EventTodoUpdated, Data: &Todo{Id: 1, BoardId: 1}},
+			expected: true,
+		},
+		{
+			name:     "Success to pass on a todo moved to another board",
+			event:    &Event{Type: EventTodoMoved, Data: &Todo{Id: 1, BoardId: 2}},
+			expected: true,
+		},
+		{
+			name:     "Failed to pass on a change to a todo of another board",
+			event:    &Event{Type: EventTodoUpdated, Data: &Todo{Id: 1, BoardId: 2}},
+			expected: false,
+		},
+		{
+			name:     "Failed to pass on an event without a todo",
+			event:    &Event{Type: EventTodoUpdated, Data: nil},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.event.ConcernsBoard(1))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidIdempotencyKey  = errors.New("Invalid idempotency key")
+	ErrIdempotencyKeyReused   = errors.New("Idempotency key was used for a different request")
+	ErrIdempotencyKeyInFlight = errors.New("Idempotency key is used by a request in progress")
+)
+
+const idempotencyKeyMaxLength = 255
+
+// IdempotencyRecord remembers the response to a request sent with an Idempotency-Key.
+// StatusCode stays zero while the original request is still being handled, which holds the
+// key until LockedUntil at the latest.
+type IdempotencyRecord struct {
+	Id          int
+	UserId      string
+	Key         string
+	RequestHash string
+	StatusCode  int
+	Header      map[string][]string
+	Body        []byte
+	LockedUntil time.Time
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+func NewIdempotencyRecord(userId, key, requestHash string, lockedUntil, expiresAt time.Time) *IdempotencyRecord {
+	return &IdempotencyRecord{
+		UserId:      userId,
+		Key:         key,
+		RequestHash: requestHash,
+		LockedUntil: lockedUntil,
+		ExpiresAt:   expiresAt,
+	}
+}
+
+func (r *IdempotencyRecord) Validate() error {
+	if r.Key == "" || len(r.Key) > idempotencyKeyMaxLength {
+		return ErrInvalidIdempotencyKey
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// Complete records the response to replay for repeats of the request.
+func (r *IdempotencyRecord) Complete(statusCode int, header map[string][]string, body []byte) {
+	r.StatusCode = statusCode
+	r.Header = header
+	r.Body = body
+}
@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIdempotencyRecord(t *testing.T) {
+	testCases := []struct {
+		name          string
+		record        *IdempotencyRecord
+		expectedError error
+	}{
+		{
+			name:          "Success to validate",
+			record:        &IdempotencyRecord{Key: "0b6f0d1e-7c1f-4b7a-9a57-3d0e6f3c2a11"},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate - Due to the key is empty",
+			record:        &IdempotencyRecord{Key: ""},
+			expectedError: ErrInvalidIdempotencyKey,
+		},
+		{
+			name:          "Failed to validate - Due to the key is larger than 255 characters",
+			record:        &IdempotencyRecord{Key: strings.Repeat("a", 256)},
+			expectedError: ErrInvalidIdempotencyKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.record.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var ErrInvalidIncomingWebhookToken = errors.New("Invalid incoming webhook token")
+
+// IncomingWebhookActor is the actor of changes made through incoming webhooks whose
+// senders do not name one.
+const IncomingWebhookActor = "incoming-webhook"
+
+// IncomingWebhook lets an external system create todos on a board by posting to a URL that
+// holds a secret token. Only the hash of the token is stored.
+type IncomingWebhook struct {
+	Id        int
+	BoardId   int
+	TokenHash string `json:"-"`
+	CreatedAt time.Time
+}
+
+// NewIncomingWebhook returns a random token along with the IncomingWebhook to store.
+func NewIncomingWebhook(boardId int) (string, *IncomingWebhook, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	token := hex.EncodeToString(b)
+
+	return token, &IncomingWebhook{BoardId: boardId, TokenHash: HashIncomingWebhookToken(token)}, nil
+}
+
+func HashIncomingWebhookToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IncomingTodo is a todo sent through an incoming webhook. Todos sent again with the same
+// ExternalKey update the todo created first instead of adding another one; nil attributes
+// then keep their current value.
+type IncomingTodo struct {
+	ExternalKey string
+	Title       string
+	Priority    *int
+	DueDate     *time.Time
+}
+
+// TitleFromText turns a chat message into a todo title: its first non-blank line, cut to
+// TodoTitleMaxLength without splitting a character.
+func TitleFromText(text string) string {
+	var title string
+	for line := range strings.Lines(text) {
+		if title = strings.TrimSpace(line); title != "" {
+			break
+		}
+	}
+
+	if len(title) <= TodoTitleMaxLength {
+		return title
+	}
+	title = title[:TodoTitleMaxLength]
+	for !utf8.ValidString(title) {
+		title = title[:len(title)-1]
+	}
+
+	return strings.TrimSpace(title)
+}
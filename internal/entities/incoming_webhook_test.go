@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIncomingWebhook(t *testing.T) {
+	token, webhook, err := NewIncomingWebhook(1)
+
+	assert.NoError(t, err)
+	assert.Len(t, token, 64)
+	assert.Equal(t, 1, webhook.BoardId)
+	assert.Equal(t, HashIncomingWebhookToken(token), webhook.TokenHash)
+	assert.NotEqual(t, token, webhook.TokenHash)
+}
+
+func TestTitleFromText(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "Success to take the first line",
+			text:     "disk full\non db-1",
+			expected: "disk full",
+		},
+		{
+			name:     "Success to skip blank lines and surrounding spaces",
+			text:     "\n   \n  disk full  \non db-1",
+			expected: "disk full",
+		},
+		{
+			name:     "Success to cut long line",
+			text:     strings.Repeat("a", 60),
+			expected: strings.Repeat("a", 50),
+		},
+		{
+			name:     "Success to cut long line without splitting a character",
+			text:     strings.Repeat("a", 49) + "éb",
+			expected: strings.Repeat("a", 49),
+		},
+		{
+			name:     "Success to return empty title for blank text",
+			text:     " \n\t\n",
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, TitleFromText(tc.text))
+		})
+	}
+}
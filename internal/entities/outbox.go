@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// OutboxEvent is an event stored in the transaction of its change. A relay on every server
+// reads the outbox in id order and hands the events to local subscribers.
+type OutboxEvent struct {
+	Id        int64
+	RoomId    int
+	Type      string
+	Payload   []byte // JSON snapshot of the changed entity
+	CreatedAt time.Time
+}
+
+func NewOutboxEvent(roomId int, eventType string, data any) (*OutboxEvent, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxEvent{RoomId: roomId, Type: eventType, Payload: payload}, nil
+}
+
+// Event restores the event with the snapshot it was stored with.
+func (oe *OutboxEvent) Event() (*Event, error) {
+	data, err := DecodeEventData(oe.Type, oe.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{Id: oe.Id, RoomId: oe.RoomId, Type: oe.Type, Data: data}, nil
+}
+
+// DecodeEventData restores the snapshot stored with an event of eventType.
+func DecodeEventData(eventType string, payload []byte) (any, error) {
+	switch eventType {
+	case EventTodoCreated, EventTodoUpdated, EventTodoMoved, EventTodoDeleted:
+		var todo Todo
+		if err := json.Unmarshal(payload, &todo); err != nil {
+			return nil, err
+		}
+		return &todo, nil
+	case WebhookEventTest:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown event type %q", eventType)
+	}
+}
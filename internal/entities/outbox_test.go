@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxEventRestoresEvent(t *testing.T) {
+	dueDate := time.Date(2025, 8, 30, 9, 0, 0, 0, time.UTC)
+	todo := &Todo{
+		Id:                3,
+		BoardId:           2,
+		Title:             "Buy milk",
+		StatusId:          1,
+		Rank:              "V",
+		DueDate:           &dueDate,
+		Recurrence:        &Recurrence{Rule: "FREQ=DAILY", Timezone: "Asia/Tokyo"},
+		ChecklistProgress: ChecklistProgress{Checked: 1, Total: 2},
+	}
+
+	oe, err := NewOutboxEvent(1, EventTodoMoved, todo)
+	require.NoError(t, err)
+	oe.Id = 7
+
+	testCases := []struct {
+		name          string
+		eventType     string
+		expectedEvent *Event
+		expectedError bool
+	}{
+		{
+			name:          "Success to restore a todo event",
+			eventType:     EventTodoMoved,
+			expectedEvent: &Event{Id: 7, RoomId: 1, Type: EventTodoMoved, Data: todo},
+		},
+		{
+			name:          "Failed to restore - Due to unknown event type",
+			eventType:     "board.renamed",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			oe.Type = tc.eventType
+
+			event, err := oe.Event()
+
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedEvent, event)
+		})
+	}
+}
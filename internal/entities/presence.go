@@ -0,0 +1,14 @@
+package entities
+
+// Presence is one client viewing a board, and the todo it is editing if any.
+type Presence struct {
+	Actor         string
+	EditingTodoId *int
+}
+
+// PresenceSession is one client viewing a board. Updates receives the board's viewers
+// whenever they change; a receiver that falls behind only gets the latest list.
+type PresenceSession struct {
+	BoardId int
+	Updates <-chan []Presence
+}
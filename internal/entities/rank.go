@@ -0,0 +1,129 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidRank = errors.New("Invalid rank")
+
+// rankDigits are ordered as in ASCII, so ranks compare correctly as plain strings
+// and under a binary collation.
+const rankDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// RankBetween returns a rank that sorts strictly between before and after.
+// An empty before means the start of the list and an empty after means its end.
+func RankBetween(before, after string) (string, error) {
+	if !validRank(before) || !validRank(after) {
+		return "", ErrInvalidRank
+	}
+	if after != "" && before >= after {
+		return "", ErrInvalidRank
+	}
+
+	if after == "" {
+		return increment(before), nil
+	}
+	return midpoint(before, after), nil
+}
+
+// EvenRanks returns n ascending ranks of equal length, spread over the lower half
+// of the key space so that appending after a rebalance stays short.
+func EvenRanks(n int) []string {
+	base := int64(len(rankDigits))
+
+	length := 1
+	space := base
+	for space < 2*int64(n+1)*base {
+		length++
+		space *= base
+	}
+	step := space / (2 * int64(n+1))
+
+	ranks := make([]string, n)
+	for i := range ranks {
+		ranks[i] = encodeRank(int64(i+1)*step, length)
+	}
+
+	return ranks
+}
+
+// validRank rejects characters outside the alphabet and a trailing zero digit,
+// which would make two different strings denote the same position.
+func validRank(rank string) bool {
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(rankDigits, rank[i]) < 0 {
+			return false
+		}
+	}
+
+	return rank == "" || rank[len(rank)-1] != rankDigits[0]
+}
+
+// increment returns a short rank after a. Appending moves to the next digit rather than
+// halving the gap, so ranks grow by one character per len(rankDigits) appends.
+func increment(a string) string {
+	if a == "" {
+		return rankDigits[1:2]
+	}
+
+	digit := strings.IndexByte(rankDigits, a[0])
+	if digit+1 < len(rankDigits) {
+		return string(rankDigits[digit+1])
+	}
+	return a[:1] + increment(a[1:])
+}
+
+// midpoint returns the rank halfway between a and b, where an empty b is the end of the key space.
+func midpoint(a, b string) string {
+	if b != "" {
+		n := 0
+		for n < len(b) && rankDigitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(a[min(n, len(a)):], b[n:])
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(rankDigits, a[0])
+	}
+	digitB := len(rankDigits)
+	if b != "" {
+		digitB = strings.IndexByte(rankDigits, b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(rankDigits[(digitA+digitB+1)/2])
+	}
+	if len(b) > 1 {
+		return b[:1]
+	}
+
+	tail := ""
+	if a != "" {
+		tail = a[1:]
+	}
+	return string(rankDigits[digitA]) + midpoint(tail, "")
+}
+
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+	return rankDigits[0]
+}
+
+func encodeRank(value int64, length int) string {
+	base := int64(len(rankDigits))
+
+	buf := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		buf[i] = rankDigits[value%base]
+		value /= base
+	}
+
+	return strings.TrimRight(string(buf), rankDigits[:1])
+}
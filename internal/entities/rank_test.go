@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankBetween(t *testing.T) {
+	testCases := []struct {
+		name          string
+		before        string
+		after         string
+		expectedError error
+	}{
+		{name: "First rank of an empty list", before: "", after: ""},
+		{name: "Append to the end", before: "V", after: ""},
+		{name: "Append after the last digit", before: "z", after: ""},
+		{name: "Prepend to the start", before: "", after: "V"},
+		{name: "Prepend before a rank with leading zeros", before: "", after: "01"},
+		{name: "Between distant ranks", before: "A", after: "z"},
+		{name: "Between adjacent digits", before: "A", after: "B"},
+		{name: "Between a rank and its extension", before: "A", after: "A1"},
+		{name: "Between ranks with a common prefix", before: "AbC", after: "AbD"},
+		{name: "Failed - Due to equal ranks", before: "A", after: "A", expectedError: ErrInvalidRank},
+		{name: "Failed - Due to reversed ranks", before: "B", after: "A", expectedError: ErrInvalidRank},
+		{name: "Failed - Due to trailing zero", before: "A0", after: "", expectedError: ErrInvalidRank},
+		{name: "Failed - Due to invalid character", before: "A-", after: "", expectedError: ErrInvalidRank},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rank, err := RankBetween(tc.before, tc.after)
+
+			assert.Equal(t, tc.expectedError, err)
+			if tc.expectedError != nil {
+				return
+			}
+			assert.True(t, validRank(rank) && rank != "", "invalid rank %q", rank)
+			assert.Less(t, tc.before, rank)
+			if tc.after != "" {
+				assert.Less(t, rank, tc.after)
+			}
+		})
+	}
+}
+
+func TestRankBetweenRepeatedInsertsStayOrdered(t *testing.T) {
+	ranks := []string{}
+
+	last := ""
+	for i := 0; i < 200; i++ {
+		rank, err := RankBetween(last, "")
+		require.NoError(t, err)
+		ranks = append(ranks, rank)
+		last = rank
+	}
+	assert.LessOrEqual(t, len(last), 4, "appends grow slowly")
+
+	// Always insert right after the first rank, the worst case for growth.
+	first := ranks[0]
+	next := ranks[1]
+	for i := 0; i < 200; i++ {
+		rank, err := RankBetween(first, next)
+		require.NoError(t, err)
+		ranks = append(ranks, rank)
+		next = rank
+	}
+
+	assert.True(t, sort.StringsAreSorted(append([]string{first}, reverse(ranks[200:])...)))
+}
+
+func TestEvenRanks(t *testing.T) {
+	for _, n := range []int{0, 1, 30, 5000} {
+		ranks := EvenRanks(n)
+
+		require.Len(t, ranks, n)
+		assert.True(t, sort.StringsAreSorted(ranks))
+		for i, rank := range ranks {
+			assert.True(t, validRank(rank) && rank != "", "invalid rank %q", rank)
+			if i > 0 {
+				assert.NotEqual(t, ranks[i-1], rank)
+			}
+		}
+		if n > 0 {
+			_, err := RankBetween(ranks[n-1], "")
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func reverse(values []string) []string {
+	reversed := make([]string, len(values))
+	for i, v := range values {
+		reversed[len(values)-1-i] = v
+	}
+	return reversed
+}
@@ -0,0 +1,403 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRecurrenceSteps bounds the search for the next occurrence of rules
+// that can never match, e.g. FREQ=DAILY;INTERVAL=7;BYDAY=MO from a Tuesday.
+const maxRecurrenceSteps = 1000
+
+var ErrInvalidRecurrence = errors.New("Invalid recurrence")
+
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// Recurrence is an RFC 5545 RRULE expanded in the wall clock of Timezone.
+type Recurrence struct {
+	Rule     string
+	Timezone string
+}
+
+// WeekdayNum is a BYDAY entry such as MO, 1MO or -1FR.
+// Ordinal is zero when every such weekday of the period matches.
+type WeekdayNum struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// RRule is the supported subset of RFC 5545 recurrence rules.
+type RRule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []WeekdayNum
+	// Count is the number of occurrences left including the current one; zero means unbounded.
+	Count int
+	Until *time.Time
+}
+
+func (r *Recurrence) Location() (*time.Location, error) {
+	if r.Timezone == "" {
+		return time.UTC, nil
+	}
+
+	return time.LoadLocation(r.Timezone)
+}
+
+func (r *Recurrence) Validate() error {
+	loc, err := r.Location()
+	if err != nil {
+		return ErrInvalidRecurrence
+	}
+
+	if _, err := ParseRRule(r.Rule, loc); err != nil {
+		return ErrInvalidRecurrence
+	}
+
+	return nil
+}
+
+// Next returns the occurrence following current and the recurrence the
+// spawned occurrence should carry. ok is false when the rule is exhausted.
+func (r *Recurrence) Next(current time.Time) (next time.Time, following *Recurrence, ok bool) {
+	loc, err := r.Location()
+	if err != nil {
+		return time.Time{}, nil, false
+	}
+
+	rule, err := ParseRRule(r.Rule, loc)
+	if err != nil {
+		return time.Time{}, nil, false
+	}
+
+	next, ok = rule.Next(current, loc)
+	if !ok {
+		return time.Time{}, nil, false
+	}
+
+	if rule.Count > 0 {
+		rule.Count--
+	}
+
+	return next, &Recurrence{Rule: rule.String(), Timezone: r.Timezone}, true
+}
+
+// ParseRRule parses a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=5".
+// A floating UNTIL (without trailing Z) is interpreted in loc.
+func ParseRRule(value string, loc *time.Location) (*RRule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, errors.New("empty rule")
+	}
+
+	rule := &RRule{Interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		key, val, found := strings.Cut(part, "=")
+		if !found || val == "" {
+			return nil, fmt.Errorf("malformed rule part %q", part)
+		}
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			switch freq := Frequency(strings.ToUpper(val)); freq {
+			case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+				rule.Freq = freq
+			default:
+				return nil, fmt.Errorf("unsupported FREQ %q", val)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q", val)
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("invalid COUNT %q", val)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseUntil(val, loc)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, day := range strings.Split(val, ",") {
+				weekdayNum, err := parseWeekdayNum(day)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, weekdayNum)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rule part %q", key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, errors.New("FREQ is required")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, errors.New("COUNT and UNTIL are mutually exclusive")
+	}
+	if rule.Freq != FrequencyMonthly {
+		for _, day := range rule.ByDay {
+			if day.Ordinal != 0 {
+				return nil, errors.New("BYDAY ordinals are only supported with FREQ=MONTHLY")
+			}
+		}
+	}
+
+	return rule, nil
+}
+
+func parseUntil(value string, loc *time.Location) (time.Time, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return until, nil
+	}
+	if until, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return until, nil
+	}
+	// A DATE value includes every occurrence on that day.
+	if until, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		return until.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q", value)
+}
+
+func parseWeekdayNum(value string) (WeekdayNum, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if len(value) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", value)
+	}
+
+	weekday, ok := weekdayCodes[value[len(value)-2:]]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", value)
+	}
+
+	var ordinal int
+	if prefix := value[:len(value)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", value)
+		}
+		ordinal = n
+	}
+
+	return WeekdayNum{Ordinal: ordinal, Weekday: weekday}, nil
+}
+
+func (r *RRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			code := strings.ToUpper(day.Weekday.String()[:2])
+			if day.Ordinal != 0 {
+				code = strconv.Itoa(day.Ordinal) + code
+			}
+			days = append(days, code)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Next returns the first occurrence after current. Dates are stepped in the
+// wall clock of loc so that an occurrence keeps its local time of day across
+// DST transitions.
+func (r *RRule) Next(current time.Time, loc *time.Location) (time.Time, bool) {
+	if r.Count == 1 {
+		return time.Time{}, false
+	}
+
+	local := current.In(loc)
+	date := civilDate(local)
+
+	var nextDate time.Time
+	var ok bool
+	switch r.Freq {
+	case FrequencyDaily:
+		nextDate, ok = r.nextDaily(date)
+	case FrequencyWeekly:
+		nextDate, ok = r.nextWeekly(date)
+	case FrequencyMonthly:
+		nextDate, ok = r.nextMonthly(date)
+	}
+	if !ok {
+		return time.Time{}, false
+	}
+
+	next := atWallClock(nextDate, local, loc)
+	if r.Until != nil && next.After(*r.Until) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+func (r *RRule) nextDaily(date time.Time) (time.Time, bool) {
+	for i := 0; i < maxRecurrenceSteps; i++ {
+		date = date.AddDate(0, 0, r.Interval)
+		if r.matchesWeekday(date.Weekday()) {
+			return date, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (r *RRule) nextWeekly(date time.Time) (time.Time, bool) {
+	if len(r.ByDay) == 0 {
+		return date.AddDate(0, 0, 7*r.Interval), true
+	}
+
+	// Weeks start on Monday (RFC 5545 default WKST=MO).
+	offsets := make([]int, 0, len(r.ByDay))
+	for _, day := range r.ByDay {
+		offsets = append(offsets, mondayOffset(day.Weekday))
+	}
+	sort.Ints(offsets)
+
+	current := mondayOffset(date.Weekday())
+	weekStart := date.AddDate(0, 0, -current)
+	for _, offset := range offsets {
+		if offset > current {
+			return weekStart.AddDate(0, 0, offset), true
+		}
+	}
+
+	return weekStart.AddDate(0, 0, 7*r.Interval+offsets[0]), true
+}
+
+func (r *RRule) nextMonthly(date time.Time) (time.Time, bool) {
+	monthStart := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	if len(r.ByDay) == 0 {
+		// Months without the start's day of month are skipped.
+		for i := 1; i < maxRecurrenceSteps; i++ {
+			month := monthStart.AddDate(0, i*r.Interval, 0)
+			candidate := month.AddDate(0, 0, date.Day()-1)
+			if candidate.Month() == month.Month() {
+				return candidate, true
+			}
+		}
+		return time.Time{}, false
+	}
+
+	for _, candidate := range r.monthlyCandidates(monthStart) {
+		if candidate.After(date) {
+			return candidate, true
+		}
+	}
+	for i := 1; i < maxRecurrenceSteps; i++ {
+		candidates := r.monthlyCandidates(monthStart.AddDate(0, i*r.Interval, 0))
+		if len(candidates) > 0 {
+			return candidates[0], true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// monthlyCandidates lists the dates of the month matching BYDAY in ascending order.
+func (r *RRule) monthlyCandidates(monthStart time.Time) []time.Time {
+	var matches []time.Time
+	daysInMonth := monthStart.AddDate(0, 1, -1).Day()
+
+	for day := 1; day <= daysInMonth; day++ {
+		date := monthStart.AddDate(0, 0, day-1)
+		nth := (day-1)/7 + 1
+		nthFromEnd := -((daysInMonth-day)/7 + 1)
+
+		for _, weekdayNum := range r.ByDay {
+			if weekdayNum.Weekday != date.Weekday() {
+				continue
+			}
+			if weekdayNum.Ordinal == 0 || weekdayNum.Ordinal == nth || weekdayNum.Ordinal == nthFromEnd {
+				matches = append(matches, date)
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+func (r *RRule) matchesWeekday(weekday time.Weekday) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+
+	for _, day := range r.ByDay {
+		if day.Weekday == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// atWallClock places the time of day of clock on date in loc. Following
+// RFC 5545, a time skipped by a DST gap uses the offset before the gap and an
+// ambiguous time resolves to its first occurrence.
+func atWallClock(date, clock time.Time, loc *time.Location) time.Time {
+	hour, min, sec := clock.Clock()
+	t := time.Date(date.Year(), date.Month(), date.Day(), hour, min, sec, 0, loc)
+
+	if h, m, _ := t.Clock(); h != hour || m != min {
+		_, offset := t.Add(-12 * time.Hour).Zone()
+		naive := time.Date(date.Year(), date.Month(), date.Day(), hour, min, sec, 0, time.UTC)
+		return naive.Add(-time.Duration(offset) * time.Second).In(loc)
+	}
+
+	if earlier := t.Add(-time.Hour); earlier.Day() == t.Day() && earlier.Hour() == hour && earlier.Minute() == min {
+		return earlier
+	}
+
+	return t
+}
+
+// civilDate drops the time of day and location so that date arithmetic is
+// unaffected by DST.
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func mondayOffset(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
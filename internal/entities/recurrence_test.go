@@ -0,0 +1,275 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+
+	return loc
+}
+
+func TestParseRRule(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rule        string
+		expectError bool
+		expected    string
+	}{
+		{
+			name:     "Success to parse daily rule",
+			rule:     "FREQ=DAILY",
+			expected: "FREQ=DAILY",
+		},
+		{
+			name:     "Success to parse rule with RRULE prefix",
+			rule:     "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=3",
+			expected: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=3",
+		},
+		{
+			name:     "Success to parse monthly rule with ordinal BYDAY and UNTIL",
+			rule:     "FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20251231T235959Z",
+			expected: "FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20251231T235959Z",
+		},
+		{
+			name:        "Failed to parse - Due to missing FREQ",
+			rule:        "INTERVAL=2",
+			expectError: true,
+		},
+		{
+			name:        "Failed to parse - Due to unsupported FREQ",
+			rule:        "FREQ=YEARLY",
+			expectError: true,
+		},
+		{
+			name:        "Failed to parse - Due to COUNT and UNTIL together",
+			rule:        "FREQ=DAILY;COUNT=2;UNTIL=20251231",
+			expectError: true,
+		},
+		{
+			name:        "Failed to parse - Due to ordinal BYDAY with WEEKLY",
+			rule:        "FREQ=WEEKLY;BYDAY=1MO",
+			expectError: true,
+		},
+		{
+			name:        "Failed to parse - Due to invalid INTERVAL",
+			rule:        "FREQ=DAILY;INTERVAL=0",
+			expectError: true,
+		},
+		{
+			name:        "Failed to parse - Due to unsupported part",
+			rule:        "FREQ=DAILY;BYHOUR=9",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := ParseRRule(tc.rule, time.UTC)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, rule.String())
+		})
+	}
+}
+
+func TestRRuleNext(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	testCases := []struct {
+		name     string
+		rule     string
+		loc      *time.Location
+		current  time.Time
+		expected time.Time
+		ok       bool
+	}{
+		{
+			name:     "Daily keeps local time across spring forward",
+			rule:     "FREQ=DAILY",
+			loc:      newYork,
+			current:  time.Date(2025, 3, 8, 9, 0, 0, 0, newYork),
+			expected: time.Date(2025, 3, 9, 13, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:     "Daily keeps local time across fall back",
+			rule:     "FREQ=DAILY",
+			loc:      newYork,
+			current:  time.Date(2025, 11, 1, 9, 0, 0, 0, newYork),
+			expected: time.Date(2025, 11, 2, 14, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:     "Daily occurrence in the skipped hour moves forward",
+			rule:     "FREQ=DAILY",
+			loc:      newYork,
+			current:  time.Date(2025, 3, 8, 2, 30, 0, 0, newYork),
+			expected: time.Date(2025, 3, 9, 3, 30, 0, 0, newYork),
+			ok:       true,
+		},
+		{
+			name:     "Daily occurrence in the repeated hour uses the first instance",
+			rule:     "FREQ=DAILY",
+			loc:      newYork,
+			current:  time.Date(2025, 11, 1, 1, 30, 0, 0, newYork),
+			expected: time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:     "Weekly keeps local time across European DST end",
+			rule:     "FREQ=WEEKLY",
+			loc:      berlin,
+			current:  time.Date(2025, 10, 20, 8, 0, 0, 0, berlin),
+			expected: time.Date(2025, 10, 27, 7, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:     "Daily with BYDAY skips weekend",
+			rule:     "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			loc:      time.UTC,
+			current:  time.Date(2025, 6, 13, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 6, 16, 9, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:     "Weekly with BYDAY moves to next day in the same week",
+			rule:     "FREQ=WEEKLY;BYDAY=MO,TH",
+			loc:      time.UTC,
+			current:  time.Date(2025, 6, 16, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 6, 19, 9, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:     "Weekly with BYDAY and INTERVAL skips a week",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+			loc:      time.UTC,
+			current:  time.Date(2025, 6, 19, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 6, 30, 9, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:     "Monthly skips months without the day",
+			rule:     "FREQ=MONTHLY",
+			loc:      time.UTC,
+			current:  time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:     "Monthly on the last Friday",
+			rule:     "FREQ=MONTHLY;BYDAY=-1FR",
+			loc:      time.UTC,
+			current:  time.Date(2025, 5, 30, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 6, 27, 9, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:     "Monthly on the first Monday across spring forward",
+			rule:     "FREQ=MONTHLY;BYDAY=1MO",
+			loc:      newYork,
+			current:  time.Date(2025, 2, 3, 9, 0, 0, 0, newYork),
+			expected: time.Date(2025, 3, 3, 14, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:    "Exhausted when COUNT reaches the last occurrence",
+			rule:    "FREQ=DAILY;COUNT=1",
+			loc:     time.UTC,
+			current: time.Date(2025, 6, 13, 9, 0, 0, 0, time.UTC),
+			ok:      false,
+		},
+		{
+			name:    "Exhausted when next occurrence is after UNTIL",
+			rule:    "FREQ=DAILY;UNTIL=20250613",
+			loc:     time.UTC,
+			current: time.Date(2025, 6, 13, 9, 0, 0, 0, time.UTC),
+			ok:      false,
+		},
+		{
+			name:     "Date UNTIL includes the whole day",
+			rule:     "FREQ=DAILY;UNTIL=20250614",
+			loc:      time.UTC,
+			current:  time.Date(2025, 6, 13, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 6, 14, 9, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := ParseRRule(tc.rule, tc.loc)
+			require.NoError(t, err)
+
+			next, ok := rule.Next(tc.current, tc.loc)
+
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.True(t, tc.expected.Equal(next), "expected %v, got %v", tc.expected, next)
+			}
+		})
+	}
+}
+
+func TestRecurrenceNextDecrementsCount(t *testing.T) {
+	recurrence := &Recurrence{Rule: "FREQ=WEEKLY;COUNT=2", Timezone: "Asia/Tokyo"}
+
+	next, following, ok := recurrence.Next(time.Date(2025, 6, 13, 0, 0, 0, 0, time.UTC))
+
+	require.True(t, ok)
+	assert.True(t, time.Date(2025, 6, 20, 0, 0, 0, 0, time.UTC).Equal(next))
+	assert.Equal(t, &Recurrence{Rule: "FREQ=WEEKLY;COUNT=1", Timezone: "Asia/Tokyo"}, following)
+
+	_, _, ok = following.Next(next)
+	assert.False(t, ok)
+}
+
+func TestValidateRecurrence(t *testing.T) {
+	testCases := []struct {
+		name          string
+		recurrence    *Recurrence
+		expectedError error
+	}{
+		{
+			name:          "Success to validate",
+			recurrence:    &Recurrence{Rule: "FREQ=DAILY", Timezone: "Europe/Berlin"},
+			expectedError: nil,
+		},
+		{
+			name:          "Success to validate without timezone",
+			recurrence:    &Recurrence{Rule: "FREQ=DAILY"},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate - Due to unknown timezone",
+			recurrence:    &Recurrence{Rule: "FREQ=DAILY", Timezone: "Mars/Olympus"},
+			expectedError: ErrInvalidRecurrence,
+		},
+		{
+			name:          "Failed to validate - Due to invalid rule",
+			recurrence:    &Recurrence{Rule: "FREQ=HOURLY"},
+			expectedError: ErrInvalidRecurrence,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recurrence.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidReminderTime    = errors.New("Invalid reminder time")
+	ErrInvalidReminderChannel = errors.New("Invalid channel")
+	// ErrReminderChannelUnavailable is returned for a channel the server has no way to send on.
+	ErrReminderChannelUnavailable = errors.New("Channel is not configured")
+)
+
+const (
+	ReminderChannelLog     = "log"
+	ReminderChannelWebhook = "webhook"
+)
+
+// Reminder fires either at RemindAt or OffsetMinutes before the todo's due date.
+type Reminder struct {
+	Id            int
+	TodoId        int
+	RemindAt      *time.Time
+	OffsetMinutes *int
+	Channel       string
+	SentAt        *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// DueReminder is a reminder claimed for dispatch together with what it reminds of.
+type DueReminder struct {
+	Reminder
+	TodoTitle string
+	FireAt    time.Time
+}
+
+func NewReminder(todoId int, remindAt *time.Time, offsetMinutes *int, channel string) *Reminder {
+	if channel == "" {
+		channel = ReminderChannelLog
+	}
+
+	return &Reminder{
+		TodoId:        todoId,
+		RemindAt:      remindAt,
+		OffsetMinutes: offsetMinutes,
+		Channel:       channel,
+	}
+}
+
+func (r *Reminder) Validate() error {
+	if (r.RemindAt == nil) == (r.OffsetMinutes == nil) {
+		return ErrInvalidReminderTime
+	}
+
+	if r.OffsetMinutes != nil && *r.OffsetMinutes < 0 {
+		return ErrInvalidReminderTime
+	}
+
+	switch r.Channel {
+	case ReminderChannelLog, ReminderChannelWebhook:
+	default:
+		return ErrInvalidReminderChannel
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateReminder(t *testing.T) {
+	now := time.Now()
+	offset := 30
+	negativeOffset := -1
+
+	testCases := []struct {
+		name          string
+		reminder      *Reminder
+		expectedError error
+	}{
+		{
+			name:          "Success to validate - Absolute time",
+			reminder:      &Reminder{RemindAt: &now, Channel: ReminderChannelLog},
+			expectedError: nil,
+		},
+		{
+			name:          "Success to validate - Offset before due date",
+			reminder:      &Reminder{OffsetMinutes: &offset, Channel: ReminderChannelWebhook},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate - Due to neither time nor offset",
+			reminder:      &Reminder{Channel: ReminderChannelLog},
+			expectedError: ErrInvalidReminderTime,
+		},
+		{
+			name:          "Failed to validate - Due to both time and offset",
+			reminder:      &Reminder{RemindAt: &now, OffsetMinutes: &offset, Channel: ReminderChannelLog},
+			expectedError: ErrInvalidReminderTime,
+		},
+		{
+			name:          "Failed to validate - Due to negative offset",
+			reminder:      &Reminder{OffsetMinutes: &negativeOffset, Channel: ReminderChannelLog},
+			expectedError: ErrInvalidReminderTime,
+		},
+		{
+			name:          "Failed to validate - Due to unknown channel",
+			reminder:      &Reminder{RemindAt: &now, Channel: "pigeon"},
+			expectedError: ErrInvalidReminderChannel,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.reminder.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
@@ -5,11 +5,16 @@ import (
 	"time"
 )
 
+// ErrArchived rejects changes to an archived room or board and to what they contain.
+var ErrArchived = errors.New("Archived rooms and boards are read-only")
+
 type Room struct {
-	Id        int
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id         int
+	Name       string
+	ArchivedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  *time.Time // set while the room is in the trash
 }
 
 func NewRoom(name string) *Room {
@@ -29,3 +34,32 @@ func (r *Room) Validate() error {
 func (r *Room) UpdateAttributes(name string) {
 	r.Name = name
 }
+
+// Writable reports ErrArchived while the room is archived.
+func (r *Room) Writable() error {
+	if r.ArchivedAt != nil {
+		return ErrArchived
+	}
+
+	return nil
+}
+
+// RoomMember is an actor who joined the room.
+type RoomMember struct {
+	Id       int
+	RoomId   int
+	Actor    string
+	JoinedAt time.Time
+}
+
+// NewRoomMember requires an actor, as anonymous requests cannot join.
+func NewRoomMember(roomId int, actor string) (*RoomMember, error) {
+	if actor == "" {
+		return nil, ErrActorRequired
+	}
+
+	return &RoomMember{
+		RoomId: roomId,
+		Actor:  actor,
+	}, nil
+}
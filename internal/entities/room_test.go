@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -39,3 +40,41 @@ func TestValidateRoom(t *testing.T) {
 		})
 	}
 }
+
+func TestWritableRoom(t *testing.T) {
+	archivedAt := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		room          *Room
+		expectedError error
+	}{
+		{
+			name:          "Success to write - Due to the room is not archived",
+			room:          &Room{Name: "test room"},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to write - Due to the room is archived",
+			room:          &Room{Name: "test room", ArchivedAt: &archivedAt},
+			expectedError: ErrArchived,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.room.Writable()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestNewRoomMember(t *testing.T) {
+	member, err := NewRoomMember(1, "alice")
+	assert.NoError(t, err)
+	assert.Equal(t, &RoomMember{RoomId: 1, Actor: "alice"}, member)
+
+	_, err = NewRoomMember(1, "")
+	assert.Equal(t, ErrActorRequired, err)
+}
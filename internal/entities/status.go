@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidStatus  = errors.New("Invalid status")
+	ErrStatusInUse    = errors.New("Status is in use")
+	ErrStatusRequired = errors.New("Room needs at least one todo and one done status")
+	// ErrTransitionNotAllowed rejects moving a todo to a status its current one has no
+	// transition to.
+	ErrTransitionNotAllowed = errors.New("Transition is not allowed by the workflow")
+)
+
+const (
+	StatusCategoryTodo  = "todo"
+	StatusCategoryDoing = "doing"
+	StatusCategoryDone  = "done"
+)
+
+// Status is a per-room workflow step. Its category decides whether todos in it count as done.
+type Status struct {
+	Id        int
+	RoomId    int
+	Name      string
+	Category  string
+	Position  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Transitions lists the ids of the statuses todos may move to from this one. When it is
+	// empty they may move to any status of the room.
+	Transitions []int
+}
+
+func NewStatus(roomId int, name, category string) *Status {
+	return &Status{
+		RoomId:   roomId,
+		Name:     name,
+		Category: category,
+	}
+}
+
+// DefaultStatuses are created with every room.
+func DefaultStatuses(roomId int) []*Status {
+	return []*Status{
+		{RoomId: roomId, Name: "To Do", Category: StatusCategoryTodo, Position: 0},
+		{RoomId: roomId, Name: "In Progress", Category: StatusCategoryDoing, Position: 1},
+		{RoomId: roomId, Name: "Done", Category: StatusCategoryDone, Position: 2},
+	}
+}
+
+func (s *Status) Validate() error {
+	if s.Name == "" || len(s.Name) > 30 {
+		return errors.New("Invalid name")
+	}
+
+	switch s.Category {
+	case StatusCategoryTodo, StatusCategoryDoing, StatusCategoryDone:
+	default:
+		return errors.New("Invalid category")
+	}
+
+	return nil
+}
+
+func (s *Status) UpdateAttributes(name, category string) {
+	s.Name = name
+	s.Category = category
+}
+
+func (s *Status) IsDone() bool {
+	return s.Category == StatusCategoryDone
+}
+
+// AllowsTransitionTo reports whether a todo in the status may move to next. Staying in the
+// status is always allowed.
+func (s *Status) AllowsTransitionTo(next *Status) bool {
+	if next.Id == s.Id || len(s.Transitions) == 0 {
+		return true
+	}
+
+	return slices.Contains(s.Transitions, next.Id)
+}
@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateStatus(t *testing.T) {
+	testCases := []struct {
+		name          string
+		status        *Status
+		expectedError error
+	}{
+		{
+			name:          "Success to validate",
+			status:        &Status{Name: "In Review", Category: StatusCategoryDoing},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate - Due to the name is empty",
+			status:        &Status{Name: "", Category: StatusCategoryTodo},
+			expectedError: errors.New("Invalid name"),
+		},
+		{
+			name:          "Failed to validate - Due to the name is larger than 30 characters",
+			status:        &Status{Name: strings.Repeat("a", 31), Category: StatusCategoryTodo},
+			expectedError: errors.New("Invalid name"),
+		},
+		{
+			name:          "Failed to validate - Due to unknown category",
+			status:        &Status{Name: "Blocked", Category: "blocked"},
+			expectedError: errors.New("Invalid category"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.status.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestStatusAllowsTransitionTo(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   *Status
+		next     *Status
+		expected bool
+	}{
+		{
+			name:     "Success to allow any status - Due to no transitions",
+			status:   &Status{Id: 1},
+			next:     &Status{Id: 3},
+			expected: true,
+		},
+		{
+			name:     "Success to allow listed status",
+			status:   &Status{Id: 1, Transitions: []int{2, 3}},
+			next:     &Status{Id: 3},
+			expected: true,
+		},
+		{
+			name:     "Success to allow staying in the status",
+			status:   &Status{Id: 1, Transitions: []int{2}},
+			next:     &Status{Id: 1},
+			expected: true,
+		},
+		{
+			name:     "Failed to allow status - Due to not listed",
+			status:   &Status{Id: 1, Transitions: []int{2}},
+			next:     &Status{Id: 3},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.status.AllowsTransitionTo(tc.next))
+		})
+	}
+}
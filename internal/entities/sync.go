@@ -0,0 +1,119 @@
+package entities
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrInvalidSyncToken    = errors.New("Invalid sync token")
+	ErrInvalidSyncMutation = errors.New("Invalid sync mutation")
+	// ErrSyncConflict rejects a mutation of an entity that changed since the version the
+	// client based it on.
+	ErrSyncConflict = errors.New("Entity changed since the base version")
+)
+
+// Entity types that clients sync, named as in the audit log.
+const (
+	SyncEntityRoom  = AuditEntityRoom
+	SyncEntityBoard = AuditEntityBoard
+	SyncEntityTodo  = AuditEntityTodo
+)
+
+const (
+	SyncOpCreate = "create"
+	SyncOpUpdate = "update"
+	SyncOpDelete = "delete"
+)
+
+// SyncChange is the current state of an entity that changed, with Entity nil when it was
+// deleted. Version is the change_seq the entity was last written with.
+type SyncChange struct {
+	EntityType string
+	EntityId   int
+	Version    int64
+	Entity     any // *Room, *Board or *Todo
+}
+
+// SyncPage is a page of changes. Token is passed as since to get the changes after them.
+type SyncPage struct {
+	Changes []*SyncChange
+	Token   string
+	HasMore bool
+}
+
+// FormatSyncToken and ParseSyncToken convert the last change_seq a client has seen to the
+// opaque token it is handed.
+func FormatSyncToken(seq int64) string {
+	return strconv.FormatInt(seq, 10)
+}
+
+func ParseSyncToken(token string) (int64, error) {
+	seq, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, ErrInvalidSyncToken
+	}
+
+	return seq, nil
+}
+
+// SyncMutation is a change a client made while offline. Updates replace the attributes of
+// the entity type like the PUT endpoints do, and both updates and deletes apply only while
+// the entity is still at BaseVersion.
+type SyncMutation struct {
+	EntityType  string
+	Op          string
+	Id          int // zero for creations
+	BaseVersion int64
+
+	Name     string // rooms and boards
+	Priority int    // boards and todos
+	RoomId   int    // board creations
+	BoardId  int    // todo creations
+	Title    string
+	Done     bool
+	StatusId *int
+	DueDate  *time.Time
+}
+
+func (m *SyncMutation) Validate() error {
+	switch m.EntityType {
+	case SyncEntityRoom, SyncEntityBoard, SyncEntityTodo:
+	default:
+		return ErrInvalidSyncMutation
+	}
+
+	switch m.Op {
+	case SyncOpCreate:
+		if m.Id != 0 ||
+			(m.EntityType == SyncEntityBoard && m.RoomId == 0) ||
+			(m.EntityType == SyncEntityTodo && m.BoardId == 0) {
+			return ErrInvalidSyncMutation
+		}
+	case SyncOpUpdate, SyncOpDelete:
+		if m.Id <= 0 || m.BaseVersion < 0 {
+			return ErrInvalidSyncMutation
+		}
+	default:
+		return ErrInvalidSyncMutation
+	}
+
+	// Rooms and boards report invalid names with errors of their own, so they are checked
+	// here like the request bodies of their endpoints are.
+	if m.Op != SyncOpDelete && m.EntityType != SyncEntityTodo && (m.Name == "" || len(m.Name) > 50) {
+		return ErrInvalidSyncMutation
+	}
+	if m.Priority < 0 {
+		return ErrInvalidSyncMutation
+	}
+
+	return nil
+}
+
+// SyncResult is the outcome of a mutation. Change is the entity as it is now: the one
+// written when Err is nil, and the one the client has to reconcile with on ErrSyncConflict.
+type SyncResult struct {
+	Err    error
+	Change *SyncChange
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSyncToken(t *testing.T) {
+	testCases := []struct {
+		name          string
+		token         string
+		expectedSeq   int64
+		expectedError error
+	}{
+		{
+			name:          "Success to parse token",
+			token:         FormatSyncToken(42),
+			expectedSeq:   42,
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to parse token - Due to negative sequence",
+			token:         "-1",
+			expectedError: ErrInvalidSyncToken,
+		},
+		{
+			name:          "Failed to parse token - Due to non numeric token",
+			token:         "abc",
+			expectedError: ErrInvalidSyncToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			seq, err := ParseSyncToken(tc.token)
+
+			assert.ErrorIs(t, err, tc.expectedError)
+			assert.Equal(t, tc.expectedSeq, seq)
+		})
+	}
+}
+
+func TestValidateSyncMutation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		mutation      *SyncMutation
+		expectedError error
+	}{
+		{
+			name:          "Success to validate board creation",
+			mutation:      &SyncMutation{EntityType: SyncEntityBoard, Op: SyncOpCreate, Name: "board", RoomId: 1},
+			expectedError: nil,
+		},
+		{
+			name:          "Success to validate todo update",
+			mutation:      &SyncMutation{EntityType: SyncEntityTodo, Op: SyncOpUpdate, Id: 1, BaseVersion: 3, Title: "todo"},
+			expectedError: nil,
+		},
+		{
+			name:          "Success to validate room deletion without name",
+			mutation:      &SyncMutation{EntityType: SyncEntityRoom, Op: SyncOpDelete, Id: 1},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate mutation - Due to unknown entity type",
+			mutation:      &SyncMutation{EntityType: "status", Op: SyncOpDelete, Id: 1},
+			expectedError: ErrInvalidSyncMutation,
+		},
+		{
+			name:          "Failed to validate mutation - Due to creation with id",
+			mutation:      &SyncMutation{EntityType: SyncEntityRoom, Op: SyncOpCreate, Id: 1, Name: "room"},
+			expectedError: ErrInvalidSyncMutation,
+		},
+		{
+			name:          "Failed to validate mutation - Due to todo creation without board",
+			mutation:      &SyncMutation{EntityType: SyncEntityTodo, Op: SyncOpCreate, Title: "todo"},
+			expectedError: ErrInvalidSyncMutation,
+		},
+		{
+			name:          "Failed to validate mutation - Due to update without id",
+			mutation:      &SyncMutation{EntityType: SyncEntityTodo, Op: SyncOpUpdate, Title: "todo"},
+			expectedError: ErrInvalidSyncMutation,
+		},
+		{
+			name:          "Failed to validate mutation - Due to room update without name",
+			mutation:      &SyncMutation{EntityType: SyncEntityRoom, Op: SyncOpUpdate, Id: 1},
+			expectedError: ErrInvalidSyncMutation,
+		},
+		{
+			name:          "Failed to validate mutation - Due to negative priority",
+			mutation:      &SyncMutation{EntityType: SyncEntityBoard, Op: SyncOpUpdate, Id: 1, Name: "board", Priority: -1},
+			expectedError: ErrInvalidSyncMutation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.mutation.Validate(), tc.expectedError)
+		})
+	}
+}
@@ -5,41 +5,114 @@ import (
 	"time"
 )
 
+var (
+	ErrInvalidTitle    = errors.New("Invalid title")
+	ErrInvalidPriority = errors.New("Invalid priority size")
+)
+
 type Todo struct {
-	Id        int
-	BoardId   int
-	Title     string
-	Done      bool
-	Priority  int
-	DueDate   *time.Time
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id          int
+	BoardId     int
+	Title       string
+	StatusId    int
+	Done        bool // whether the status is in the done category
+	Priority    int
+	Rank        string // position within the board, see RankBetween
+	DueDate     *time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time // set while the todo is in the trash
+
+	// Recurrence is nil for one-off todos.
+	Recurrence *Recurrence
+
+	ChecklistProgress ChecklistProgress
+	Blocked           bool
 }
 
-func NewTodo(boardId int, title string, done bool, priority int, dueDate *time.Time) *Todo {
+func NewTodo(boardId int, title string, priority int, dueDate *time.Time, recurrence *Recurrence) *Todo {
 	return &Todo{
-		BoardId:  boardId,
-		Title:    title,
-		Done:     done,
-		Priority: priority,
-		DueDate:  dueDate,
+		BoardId:    boardId,
+		Title:      title,
+		Priority:   priority,
+		DueDate:    dueDate,
+		Recurrence: recurrence,
 	}
 }
 
+// TodoTitleMaxLength is the longest title in bytes.
+const TodoTitleMaxLength = 50
+
 func (t *Todo) Validate() error {
-	if t.Title == "" || len(t.Title) > 50 {
-		return errors.New("Invalid title")
+	if t.Title == "" || len(t.Title) > TodoTitleMaxLength {
+		return ErrInvalidTitle
 	}
 
 	if t.Priority < 0 {
-		return errors.New("Invalid priority size")
+		return ErrInvalidPriority
+	}
+
+	if t.Recurrence != nil {
+		if t.DueDate == nil {
+			return ErrInvalidRecurrence
+		}
+		if err := t.Recurrence.Validate(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func (t *Todo) UpdateAttributes(title string, done bool, priority int, dueDate *time.Time) {
+func (t *Todo) UpdateAttributes(title string, priority int, dueDate *time.Time, recurrence *Recurrence) {
 	t.Title = title
-	t.Done = done
 	t.Priority = priority
 	t.DueDate = dueDate
+	t.Recurrence = recurrence
+}
+
+// Transition moves the todo to status, stamping CompletedAt when it enters the done category
+// and clearing it when it leaves.
+func (t *Todo) Transition(status *Status, now time.Time) {
+	if status.IsDone() && !t.Done {
+		t.CompletedAt = &now
+	}
+	if !status.IsDone() {
+		t.CompletedAt = nil
+	}
+
+	t.StatusId = status.Id
+	t.Done = status.IsDone()
+}
+
+// CopyTo builds a copy of the todo on boardId, keeping its status until it is transitioned.
+func (t *Todo) CopyTo(boardId int) *Todo {
+	copied := NewTodo(boardId, t.Title, t.Priority, t.DueDate, t.Recurrence)
+	copied.StatusId = t.StatusId
+	copied.Done = t.Done
+	copied.CompletedAt = t.CompletedAt
+	if t.Recurrence != nil {
+		recurrence := *t.Recurrence
+		copied.Recurrence = &recurrence
+	}
+
+	return copied
+}
+
+// NextOccurrence builds the open todo that follows a recurring todo and hands the
+// recurrence over to it, so that reopening and completing t again spawns no other.
+// ok is false for one-off todos and exhausted rules.
+func (t *Todo) NextOccurrence() (next *Todo, ok bool) {
+	if t.Recurrence == nil || t.DueDate == nil {
+		return nil, false
+	}
+
+	dueDate, recurrence, ok := t.Recurrence.Next(*t.DueDate)
+	if !ok {
+		return nil, false
+	}
+	dueDate = dueDate.UTC()
+	t.Recurrence = nil
+
+	return NewTodo(t.BoardId, t.Title, t.Priority, &dueDate, recurrence), true
 }
@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrBatchTooLarge         = errors.New("Batch has too many operations")
+	ErrBatchAborted          = errors.New("Batch aborted because an operation failed")
+	ErrInvalidBatchOperation = errors.New("Invalid batch operation")
+)
+
+const (
+	TodoBatchUpdate = "update"
+	TodoBatchMove   = "move"
+	TodoBatchDelete = "delete"
+)
+
+// TodoBatchOp is one operation of a batch. Update only changes the fields that are set,
+// and removes the due date when ClearDueDate is set. Move appends the todo to BoardId.
+type TodoBatchOp struct {
+	Op           string
+	Id           int
+	Title        *string
+	Done         *bool
+	StatusId     *int
+	Priority     *int
+	DueDate      *time.Time
+	ClearDueDate bool
+	BoardId      *int
+}
+
+func (o *TodoBatchOp) Validate() error {
+	switch o.Op {
+	case TodoBatchUpdate:
+		if o.Title == nil && o.Done == nil && o.StatusId == nil && o.Priority == nil && o.DueDate == nil && !o.ClearDueDate {
+			return ErrInvalidBatchOperation
+		}
+		if o.DueDate != nil && o.ClearDueDate {
+			return ErrInvalidBatchOperation
+		}
+	case TodoBatchMove:
+		if o.BoardId == nil {
+			return ErrInvalidBatchOperation
+		}
+	case TodoBatchDelete:
+	default:
+		return ErrInvalidBatchOperation
+	}
+
+	return nil
+}
+
+const (
+	TodoChangeCreate = "create"
+	TodoChangeUpdate = "update"
+	TodoChangeMove   = "move"
+	TodoChangeDelete = "delete"
+)
+
+// TodoChange is a write that a batch applies once every operation has been checked.
+// Before is the todo as it was before the change, nil for creations.
+type TodoChange struct {
+	Kind   string
+	Before *Todo
+	Todo   *Todo
+}
@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTodoBatchOp(t *testing.T) {
+	title := "renamed"
+	boardId := 2
+	dueDate := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		op            *TodoBatchOp
+		expectedError error
+	}{
+		{
+			name:          "Success to validate update",
+			op:            &TodoBatchOp{Op: TodoBatchUpdate, Id: 1, Title: &title},
+			expectedError: nil,
+		},
+		{
+			name:          "Success to validate move",
+			op:            &TodoBatchOp{Op: TodoBatchMove, Id: 1, BoardId: &boardId},
+			expectedError: nil,
+		},
+		{
+			name:          "Success to validate delete",
+			op:            &TodoBatchOp{Op: TodoBatchDelete, Id: 1},
+			expectedError: nil,
+		},
+		{
+			name:          "Success to validate update clearing due date",
+			op:            &TodoBatchOp{Op: TodoBatchUpdate, Id: 1, ClearDueDate: true},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate - Due to update without fields",
+			op:            &TodoBatchOp{Op: TodoBatchUpdate, Id: 1},
+			expectedError: ErrInvalidBatchOperation,
+		},
+		{
+			name:          "Failed to validate - Due to due date both set and cleared",
+			op:            &TodoBatchOp{Op: TodoBatchUpdate, Id: 1, DueDate: &dueDate, ClearDueDate: true},
+			expectedError: ErrInvalidBatchOperation,
+		},
+		{
+			name:          "Failed to validate - Due to move without board",
+			op:            &TodoBatchOp{Op: TodoBatchMove, Id: 1},
+			expectedError: ErrInvalidBatchOperation,
+		},
+		{
+			name:          "Failed to validate - Due to unknown operation",
+			op:            &TodoBatchOp{Op: "archive", Id: 1},
+			expectedError: ErrInvalidBatchOperation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.op.Validate()
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package entities
+
+import "time"
+
+const (
+	TodoFieldTitle      = "title"
+	TodoFieldStatusId   = "status_id"
+	TodoFieldPriority   = "priority"
+	TodoFieldDueDate    = "due_date"
+	TodoFieldRecurrence = "recurrence"
+)
+
+// TodoRevision is the state of the editable fields of a todo after it was created or
+// edited. Versions count up from 1 for each todo.
+type TodoRevision struct {
+	Id         int
+	TodoId     int
+	Version    int
+	Title      string
+	StatusId   int
+	Priority   int
+	DueDate    *time.Time
+	Recurrence *Recurrence
+	Actor      string
+	CreatedAt  time.Time
+}
+
+func NewTodoRevision(todo *Todo, actor string) *TodoRevision {
+	return &TodoRevision{
+		TodoId:     todo.Id,
+		Title:      todo.Title,
+		StatusId:   todo.StatusId,
+		Priority:   todo.Priority,
+		DueDate:    todo.DueDate,
+		Recurrence: todo.Recurrence,
+		Actor:      actor,
+	}
+}
+
+// ChangedFields lists the fields that differ from prev, or the fields that are set when
+// prev is nil.
+func (r *TodoRevision) ChangedFields(prev *TodoRevision) []string {
+	if prev == nil {
+		prev = &TodoRevision{}
+	}
+
+	var fields []string
+	if r.Title != prev.Title {
+		fields = append(fields, TodoFieldTitle)
+	}
+	if r.StatusId != prev.StatusId {
+		fields = append(fields, TodoFieldStatusId)
+	}
+	if r.Priority != prev.Priority {
+		fields = append(fields, TodoFieldPriority)
+	}
+	if !equalTimes(r.DueDate, prev.DueDate) {
+		fields = append(fields, TodoFieldDueDate)
+	}
+	if !equalRecurrences(r.Recurrence, prev.Recurrence) {
+		fields = append(fields, TodoFieldRecurrence)
+	}
+
+	return fields
+}
+
+func equalTimes(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
+
+func equalRecurrences(a, b *Recurrence) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangedFieldsTodoRevision(t *testing.T) {
+	dueDate := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+	sameDueDate := dueDate.In(time.FixedZone("JST", 9*60*60))
+	base := &TodoRevision{Title: "title", StatusId: 1, Priority: 1, DueDate: &dueDate}
+
+	testCases := []struct {
+		name     string
+		revision *TodoRevision
+		prev     *TodoRevision
+		expected []string
+	}{
+		{
+			name:     "Success to list the set fields of the first revision",
+			revision: base,
+			prev:     nil,
+			expected: []string{TodoFieldTitle, TodoFieldStatusId, TodoFieldPriority, TodoFieldDueDate},
+		},
+		{
+			name:     "Success to list the changed fields",
+			revision: &TodoRevision{Title: "renamed", StatusId: 1, Priority: 2, DueDate: &dueDate, Recurrence: &Recurrence{Rule: "FREQ=DAILY"}},
+			prev:     base,
+			expected: []string{TodoFieldTitle, TodoFieldPriority, TodoFieldRecurrence},
+		},
+		{
+			name:     "Success to list nothing - Due to the same due date in another zone",
+			revision: &TodoRevision{Title: "title", StatusId: 1, Priority: 1, DueDate: &sameDueDate},
+			prev:     base,
+			expected: nil,
+		},
+		{
+			name:     "Success to list the cleared due date",
+			revision: &TodoRevision{Title: "title", StatusId: 1, Priority: 1},
+			prev:     base,
+			expected: []string{TodoFieldDueDate},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.revision.ChangedFields(tc.prev))
+		})
+	}
+}
@@ -47,6 +47,35 @@ func TestValidateTodo(t *testing.T) {
 			},
 			expectedError: errors.New("Invalid title"),
 		},
+		{
+			name: "Success to validate recurring todo",
+			todo: &Todo{
+				Title:      "weekly chore",
+				Priority:   1,
+				DueDate:    &now,
+				Recurrence: &Recurrence{Rule: "FREQ=WEEKLY;BYDAY=SA", Timezone: "Asia/Tokyo"},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to validate - Due to recurring todo without due date",
+			todo: &Todo{
+				Title:      "weekly chore",
+				Priority:   1,
+				Recurrence: &Recurrence{Rule: "FREQ=WEEKLY"},
+			},
+			expectedError: ErrInvalidRecurrence,
+		},
+		{
+			name: "Failed to validate - Due to invalid recurrence rule",
+			todo: &Todo{
+				Title:      "weekly chore",
+				Priority:   1,
+				DueDate:    &now,
+				Recurrence: &Recurrence{Rule: "FREQ=SECONDLY"},
+			},
+			expectedError: ErrInvalidRecurrence,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -57,3 +86,143 @@ func TestValidateTodo(t *testing.T) {
 		})
 	}
 }
+
+func TestNextOccurrenceTodo(t *testing.T) {
+	dueDate := time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name               string
+		todo               *Todo
+		expectedOk         bool
+		expectedTodo       *Todo
+		expectedRecurrence *Recurrence
+	}{
+		{
+			name: "Spawns next occurrence with remaining count",
+			todo: &Todo{
+				Id:         1,
+				BoardId:    2,
+				Title:      "weekly chore",
+				Done:       true,
+				Priority:   3,
+				DueDate:    &dueDate,
+				Recurrence: &Recurrence{Rule: "FREQ=WEEKLY;COUNT=3"},
+			},
+			expectedOk: true,
+			expectedTodo: &Todo{
+				BoardId:    2,
+				Title:      "weekly chore",
+				Priority:   3,
+				DueDate:    func() *time.Time { d := time.Date(2025, 6, 21, 0, 0, 0, 0, time.UTC); return &d }(),
+				Recurrence: &Recurrence{Rule: "FREQ=WEEKLY;COUNT=2"},
+			},
+		},
+		{
+			name:       "No occurrence for one-off todo",
+			todo:       &Todo{Title: "one-off", DueDate: &dueDate},
+			expectedOk: false,
+		},
+		{
+			name: "No occurrence when rule is exhausted",
+			todo: &Todo{
+				Title:      "last one",
+				DueDate:    &dueDate,
+				Recurrence: &Recurrence{Rule: "FREQ=WEEKLY;COUNT=1"},
+			},
+			expectedOk:         false,
+			expectedRecurrence: &Recurrence{Rule: "FREQ=WEEKLY;COUNT=1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next, ok := tc.todo.NextOccurrence()
+
+			assert.Equal(t, tc.expectedOk, ok)
+			assert.Equal(t, tc.expectedTodo, next)
+			assert.Equal(t, tc.expectedRecurrence, tc.todo.Recurrence)
+		})
+	}
+}
+
+func TestTransitionTodo(t *testing.T) {
+	todoStatus := &Status{Id: 1, Category: StatusCategoryTodo}
+	doingStatus := &Status{Id: 2, Category: StatusCategoryDoing}
+	doneStatus := &Status{Id: 3, Category: StatusCategoryDone}
+	archivedStatus := &Status{Id: 4, Category: StatusCategoryDone}
+
+	now := time.Date(2025, 6, 28, 9, 0, 0, 0, time.UTC)
+	earlier := time.Date(2025, 6, 27, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		todo         *Todo
+		status       *Status
+		expectedTodo *Todo
+	}{
+		{
+			name:         "Entering done stamps completed_at",
+			todo:         &Todo{StatusId: 2},
+			status:       doneStatus,
+			expectedTodo: &Todo{StatusId: 3, Done: true, CompletedAt: &now},
+		},
+		{
+			name:         "Moving between done statuses keeps completed_at",
+			todo:         &Todo{StatusId: 3, Done: true, CompletedAt: &earlier},
+			status:       archivedStatus,
+			expectedTodo: &Todo{StatusId: 4, Done: true, CompletedAt: &earlier},
+		},
+		{
+			name:         "Leaving done clears completed_at",
+			todo:         &Todo{StatusId: 3, Done: true, CompletedAt: &earlier},
+			status:       todoStatus,
+			expectedTodo: &Todo{StatusId: 1, Done: false},
+		},
+		{
+			name:         "Moving between open statuses",
+			todo:         &Todo{StatusId: 1},
+			status:       doingStatus,
+			expectedTodo: &Todo{StatusId: 2, Done: false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.todo.Transition(tc.status, now)
+
+			assert.Equal(t, tc.expectedTodo, tc.todo)
+		})
+	}
+}
+
+func TestCopyToTodo(t *testing.T) {
+	dueDate := time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC)
+	completedAt := time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)
+	todo := &Todo{
+		Id:          1,
+		BoardId:     1,
+		Title:       "weekly chore",
+		StatusId:    3,
+		Done:        true,
+		Priority:    2,
+		Rank:        "V",
+		DueDate:     &dueDate,
+		CompletedAt: &completedAt,
+		Recurrence:  &Recurrence{Rule: "FREQ=WEEKLY"},
+		Blocked:     true,
+	}
+
+	copied := todo.CopyTo(2)
+
+	assert.Equal(t, &Todo{
+		BoardId:     2,
+		Title:       "weekly chore",
+		StatusId:    3,
+		Done:        true,
+		Priority:    2,
+		DueDate:     &dueDate,
+		CompletedAt: &completedAt,
+		Recurrence:  &Recurrence{Rule: "FREQ=WEEKLY"},
+	}, copied)
+	assert.NotSame(t, todo.Recurrence, copied.Recurrence)
+}
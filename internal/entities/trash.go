@@ -0,0 +1,13 @@
+package entities
+
+import "errors"
+
+var ErrTrashParentDeleted = errors.New("Parent is in the trash")
+
+// Trash holds what was deleted directly. Boards and todos deleted along with their
+// room or board are restored with it and are not listed on their own.
+type Trash struct {
+	Rooms  []*Room
+	Boards []*Board
+	Todos  []*Todo
+}
@@ -0,0 +1,199 @@
+package entities
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidWebhookURL            = errors.New("Invalid webhook URL")
+	ErrInvalidWebhookSecret         = errors.New("Invalid webhook secret")
+	ErrInvalidWebhookEventTypes     = errors.New("Invalid webhook event types")
+	ErrInvalidWebhookDeliveryFilter = errors.New("Invalid webhook delivery filter")
+)
+
+// WebhookEventTest is the type of the deliveries sent by the test endpoint. Webhooks cannot
+// subscribe to it.
+const WebhookEventTest = "webhook.test"
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+const (
+	webhookDeliveryDefaultLimit = 50
+	webhookDeliveryMaxLimit     = 200
+	webhookLastErrorMaxLength   = 255
+)
+
+var webhookEventTypes = map[string]bool{
+	EventTodoCreated: true,
+	EventTodoUpdated: true,
+	EventTodoMoved:   true,
+	EventTodoDeleted: true,
+}
+
+// Webhook posts the room's events of EventTypes to URL.
+type Webhook struct {
+	Id         int
+	RoomId     int
+	URL        string
+	Secret     string // signs the payloads
+	EventTypes []string
+	// FailureCount is the number of attempts in a row that failed.
+	FailureCount int
+	// DisabledAt is set once FailureCount reaches the limit, or by hand.
+	DisabledAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewWebhook(roomId int, url, secret string, eventTypes []string) *Webhook {
+	return &Webhook{
+		RoomId:     roomId,
+		URL:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+	}
+}
+
+func (w *Webhook) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+
+	if w.Secret == "" {
+		return ErrInvalidWebhookSecret
+	}
+
+	if len(w.EventTypes) == 0 {
+		return ErrInvalidWebhookEventTypes
+	}
+	for _, eventType := range w.EventTypes {
+		if !webhookEventTypes[eventType] {
+			return ErrInvalidWebhookEventTypes
+		}
+	}
+
+	return nil
+}
+
+// UpdateAttributes keeps the secret when none is given. Enabling a webhook forgets its
+// failures, so that it gets a fresh run of attempts.
+func (w *Webhook) UpdateAttributes(url, secret string, eventTypes []string, enabled bool, now time.Time) {
+	w.URL = url
+	if secret != "" {
+		w.Secret = secret
+	}
+	w.EventTypes = eventTypes
+
+	switch {
+	case enabled && w.DisabledAt != nil:
+		w.DisabledAt = nil
+		w.FailureCount = 0
+	case !enabled && w.DisabledAt == nil:
+		w.DisabledAt = &now
+	}
+}
+
+// WebhookDelivery is one event on its way to a webhook, and the log of how that went.
+type WebhookDelivery struct {
+	Id        int
+	WebhookId int
+	EventId   int64 // of the outbox, zero for test deliveries
+	EventType string
+	Payload   []byte // snapshot of the changed entity as stored in the outbox
+	Attempts  int
+	// StatusCode is the response to the last attempt, nil when there was none.
+	StatusCode *int
+	LastError  string
+	// NextAttemptAt is when a failed attempt is retried. Deliveries that were not attempted
+	// yet are due right away.
+	NextAttemptAt *time.Time
+	DeliveredAt   *time.Time
+	FailedAt      *time.Time
+	CreatedAt     time.Time
+}
+
+// DueWebhookDelivery is a delivery claimed for an attempt together with where it goes.
+type DueWebhookDelivery struct {
+	WebhookDelivery
+	RoomId int
+	URL    string
+	Secret string
+}
+
+func (d *WebhookDelivery) Status() string {
+	switch {
+	case d.DeliveredAt != nil:
+		return WebhookDeliveryDelivered
+	case d.FailedAt != nil:
+		return WebhookDeliveryFailed
+	default:
+		return WebhookDeliveryPending
+	}
+}
+
+// RecordAttempt counts an attempt that got statusCode, zero when there was no response, and
+// failed with err unless it is nil. A failed attempt is retried at retryAt, or given up when
+// retryAt is nil.
+func (d *WebhookDelivery) RecordAttempt(statusCode int, err error, now time.Time, retryAt *time.Time) {
+	d.Attempts++
+	d.StatusCode = nil
+	if statusCode != 0 {
+		d.StatusCode = &statusCode
+	}
+	d.NextAttemptAt = nil
+
+	if err == nil {
+		d.LastError = ""
+		d.DeliveredAt = &now
+		return
+	}
+
+	d.LastError = err.Error()
+	if len(d.LastError) > webhookLastErrorMaxLength {
+		d.LastError = strings.ToValidUTF8(d.LastError[:webhookLastErrorMaxLength], "")
+	}
+	if retryAt == nil {
+		d.FailedAt = &now
+		return
+	}
+	d.NextAttemptAt = retryAt
+}
+
+// WebhookRetryDelay doubles the wait after every failed attempt, starting from base and
+// going no higher than max.
+func WebhookRetryDelay(attempts int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+
+	return min(delay, max)
+}
+
+// WebhookDeliveryFilter pages back through a webhook's deliveries, newest first.
+type WebhookDeliveryFilter struct {
+	WebhookId int
+	BeforeId  int
+	Limit     int
+}
+
+// Validate also fills in the default limit.
+func (f *WebhookDeliveryFilter) Validate() error {
+	if f.BeforeId < 0 || f.Limit < 0 || f.Limit > webhookDeliveryMaxLimit {
+		return ErrInvalidWebhookDeliveryFilter
+	}
+
+	if f.Limit == 0 {
+		f.Limit = webhookDeliveryDefaultLimit
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWebhook(t *testing.T) {
+	testCases := []struct {
+		name          string
+		webhook       *Webhook
+		expectedError error
+	}{
+		{
+			name:          "Success to validate webhook",
+			webhook:       NewWebhook(1, "https://example.com/hook", "secret", []string{EventTodoCreated, EventTodoDeleted}),
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to validate webhook - Due to a scheme other than http and https",
+			webhook:       NewWebhook(1, "ftp://example.com/hook", "secret", []string{EventTodoCreated}),
+			expectedError: ErrInvalidWebhookURL,
+		},
+		{
+			name:          "Failed to validate webhook - Due to a relative URL",
+			webhook:       NewWebhook(1, "/hook", "secret", []string{EventTodoCreated}),
+			expectedError: ErrInvalidWebhookURL,
+		},
+		{
+			name:          "Failed to validate webhook - Due to empty secret",
+			webhook:       NewWebhook(1, "https://example.com/hook", "", []string{EventTodoCreated}),
+			expectedError: ErrInvalidWebhookSecret,
+		},
+		{
+			name:          "Failed to validate webhook - Due to no event types",
+			webhook:       NewWebhook(1, "https://example.com/hook", "secret", nil),
+			expectedError: ErrInvalidWebhookEventTypes,
+		},
+		{
+			name:          "Failed to validate webhook - Due to unknown event type",
+			webhook:       NewWebhook(1, "https://example.com/hook", "secret", []string{"board.renamed"}),
+			expectedError: ErrInvalidWebhookEventTypes,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedError, tc.webhook.Validate())
+		})
+	}
+}
+
+func TestRecordWebhookAttempt(t *testing.T) {
+	now := time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC)
+	retryAt := now.Add(time.Minute)
+
+	testCases := []struct {
+		name              string
+		statusCode        int
+		err               error
+		retryAt           *time.Time
+		expectedStatus    string
+		expectedCode      *int
+		expectedLastError string
+	}{
+		{
+			name:           "Success to record a delivered attempt",
+			statusCode:     200,
+			expectedStatus: WebhookDeliveryDelivered,
+			expectedCode:   func() *int { c := 200; return &c }(),
+		},
+		{
+			name:              "Success to record a failed attempt that is retried",
+			err:               errors.New("connection refused"),
+			retryAt:           &retryAt,
+			expectedStatus:    WebhookDeliveryPending,
+			expectedLastError: "connection refused",
+		},
+		{
+			name:              "Success to record a failed attempt that is given up",
+			statusCode:        500,
+			err:               errors.New("webhook responded with 500"),
+			expectedStatus:    WebhookDeliveryFailed,
+			expectedCode:      func() *int { c := 500; return &c }(),
+			expectedLastError: "webhook responded with 500",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			delivery := &WebhookDelivery{Attempts: 1, LastError: "timeout"}
+
+			delivery.RecordAttempt(tc.statusCode, tc.err, now, tc.retryAt)
+
+			assert.Equal(t, 2, delivery.Attempts)
+			assert.Equal(t, tc.expectedStatus, delivery.Status())
+			assert.Equal(t, tc.expectedCode, delivery.StatusCode)
+			assert.Equal(t, tc.expectedLastError, delivery.LastError)
+			assert.Equal(t, tc.retryAt, delivery.NextAttemptAt)
+		})
+	}
+
+	t.Run("Success to cut a long error to fit the log", func(t *testing.T) {
+		delivery := &WebhookDelivery{}
+
+		delivery.RecordAttempt(0, errors.New(strings.Repeat("x", 300)), now, nil)
+
+		assert.Len(t, delivery.LastError, webhookLastErrorMaxLength)
+	})
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	testCases := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 1, expected: 30 * time.Second},
+		{attempts: 2, expected: time.Minute},
+		{attempts: 4, expected: 4 * time.Minute},
+		{attempts: 20, expected: time.Hour},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, WebhookRetryDelay(tc.attempts, 30*time.Second, time.Hour), "attempts %d", tc.attempts)
+	}
+}
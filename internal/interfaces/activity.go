@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ActivityRepository interface {
+	Create(ctx context.Context, activity *entities.Activity) error
+	GetAll(ctx context.Context, filter *entities.ActivityFilter) ([]*entities.Activity, error)
+}
+
+type FeedTokenRepository interface {
+	Save(ctx context.Context, token *entities.FeedToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*entities.FeedToken, error)
+}
+
+type ActivityServicer interface {
+	GetAll(ctx context.Context, filter *entities.ActivityFilter) (*entities.Room, []*entities.Activity, error)
+	CreateFeedToken(ctx context.Context) (string, error)
+	Authenticate(ctx context.Context, token string) error
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type AuditRepository interface {
+	Create(ctx context.Context, event *entities.AuditEvent) error
+	GetAll(ctx context.Context, filter *entities.AuditFilter) ([]*entities.AuditEvent, error)
+}
+
+type AuditServicer interface {
+	GetAll(ctx context.Context, filter *entities.AuditFilter) ([]*entities.AuditEvent, error)
+}
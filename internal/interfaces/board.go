@@ -1,18 +1,36 @@
 package interfaces
 
-import "github.com/rm-ryou/sample_todo_app/internal/entities"
+import (
+	"context"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
 
 type BoardRepository interface {
-	GetAll() ([]*entities.Board, error)
-	GetById(id int) (*entities.Board, error)
-	Create(board *entities.Board) error
-	Update(board *entities.Board) error
-	Delete(id int) error
+	GetAll(ctx context.Context, includeArchived bool) ([]*entities.Board, error)
+	GetAllByRoomId(ctx context.Context, roomId int, includeArchived bool) ([]*entities.Board, error)
+	GetAllByRoomIdForUpdate(ctx context.Context, roomId int, includeArchived bool) ([]*entities.Board, error)
+	GetById(ctx context.Context, id int) (*entities.Board, error)
+	Create(ctx context.Context, board *entities.Board) error
+	Update(ctx context.Context, board *entities.Board) error
+	UpdateArchivedAt(ctx context.Context, id int, archivedAt *time.Time) error
+	Delete(ctx context.Context, id int) error
+	Reorder(ctx context.Context, roomId int, ids []int) error
 }
 
 type BoardServicer interface {
-	GetAll() ([]*entities.Board, error)
-	Create(name string, priority, roomId int) error
-	Update(id int, name string, priority int) error
-	Delete(id int) error
+	GetAll(ctx context.Context, includeArchived bool) ([]*entities.Board, error)
+	GetAllByRoomId(ctx context.Context, roomId int, includeArchived bool) ([]*entities.Board, error)
+	GetById(ctx context.Context, id int) (*entities.Board, error)
+	Create(ctx context.Context, name string, priority, roomId int) (*entities.Board, error)
+	Update(ctx context.Context, id int, name string, priority int) error
+	// UpdateIn updates the board like Update, in the unit of work repos belong to.
+	UpdateIn(ctx context.Context, repos *Repositories, id int, name string, priority int) error
+	Archive(ctx context.Context, id int) error
+	Unarchive(ctx context.Context, id int) error
+	Delete(ctx context.Context, id int) error
+	// DeleteIn deletes the board like Delete, in the unit of work repos belong to.
+	DeleteIn(ctx context.Context, repos *Repositories, id int) error
+	Reorder(ctx context.Context, roomId int, ids []int) error
 }
@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ChecklistRepository interface {
+	GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.ChecklistItem, error)
+	GetById(ctx context.Context, id int) (*entities.ChecklistItem, error)
+	Create(ctx context.Context, item *entities.ChecklistItem) error
+	Update(ctx context.Context, item *entities.ChecklistItem) error
+	Delete(ctx context.Context, id int) error
+	Reorder(ctx context.Context, todoId int, ids []int) error
+}
+
+type ChecklistServicer interface {
+	GetAll(ctx context.Context, boardId, todoId int) ([]*entities.ChecklistItem, error)
+	Create(ctx context.Context, boardId, todoId int, text string, checked bool) error
+	Update(ctx context.Context, boardId, todoId, id int, text string, checked bool) error
+	Delete(ctx context.Context, boardId, todoId, id int) error
+	Reorder(ctx context.Context, boardId, todoId int, ids []int) error
+}
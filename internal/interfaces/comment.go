@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type CommentRepository interface {
+	GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.Comment, error)
+	Create(ctx context.Context, comment *entities.Comment) error
+}
+
+type CommentServicer interface {
+	GetAll(ctx context.Context, boardId, todoId int) ([]*entities.Comment, error)
+	Create(ctx context.Context, boardId, todoId int, body string) (*entities.Comment, error)
+}
@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type DependencyRepository interface {
+	GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.TodoDependency, error)
+	GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.TodoDependency, error)
+	GetRoomIdByTodoId(ctx context.Context, todoId int) (int, error)
+	// LockRoom holds the room's row until the transaction ends, so that its dependency graph
+	// is checked and changed by one transaction at a time.
+	LockRoom(ctx context.Context, roomId int) error
+	Create(ctx context.Context, dependency *entities.TodoDependency) error
+	Delete(ctx context.Context, todoId, blockerId int) error
+}
+
+type DependencyServicer interface {
+	GetBlockers(ctx context.Context, boardId, todoId int) ([]*entities.TodoDependency, error)
+	AddBlocker(ctx context.Context, boardId, todoId, blockerId int) error
+	RemoveBlocker(ctx context.Context, boardId, todoId, blockerId int) error
+}
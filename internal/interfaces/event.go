@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type EventHub interface {
+	// Follow tells the hub that the events after lastEventId will be published to it.
+	Follow(lastEventId int64)
+	// Publish tells the subscribers of the event's room about a committed change.
+	Publish(event *entities.Event)
+	Subscribe(roomId int, lastEventId int64) (*entities.EventSubscription, error)
+	Unsubscribe(sub *entities.EventSubscription)
+}
+
+type EventServicer interface {
+	Subscribe(ctx context.Context, roomId int, lastEventId int64) (*entities.EventSubscription, error)
+	SubscribeBoard(ctx context.Context, boardId int, lastEventId int64) (*entities.EventSubscription, error)
+	Unsubscribe(sub *entities.EventSubscription)
+}
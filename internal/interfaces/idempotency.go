@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type IdempotencyRepository interface {
+	// Claim inserts record and sets its id, or returns the stored record when the key is
+	// already taken. A key whose record expired or whose lock ran out before the request
+	// completed at now is taken over.
+	Claim(ctx context.Context, record *entities.IdempotencyRecord, now time.Time) (*entities.IdempotencyRecord, error)
+	Complete(ctx context.Context, record *entities.IdempotencyRecord) error
+	Release(ctx context.Context, id int) error
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+type IdempotencyServicer interface {
+	Begin(ctx context.Context, key, requestHash string) (*entities.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, record *entities.IdempotencyRecord, statusCode int, header map[string][]string, body []byte) error
+	Release(ctx context.Context, record *entities.IdempotencyRecord) error
+}
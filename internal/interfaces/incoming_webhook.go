@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type IncomingWebhookRepository interface {
+	GetAllByBoardId(ctx context.Context, boardId int) ([]*entities.IncomingWebhook, error)
+	GetById(ctx context.Context, id int) (*entities.IncomingWebhook, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entities.IncomingWebhook, error)
+	Create(ctx context.Context, webhook *entities.IncomingWebhook) error
+	Delete(ctx context.Context, id int) error
+	// GetTodoId returns the todo created for externalKey, or sql.ErrNoRows when there is none.
+	GetTodoId(ctx context.Context, webhookId int, externalKey string) (int, error)
+	// ClaimKey adds externalKey unless it exists and locks it until the unit of work ends. It
+	// returns the todo the key points at, zero when there is none yet.
+	ClaimKey(ctx context.Context, webhookId int, externalKey string) (int, error)
+	// SetTodoId points externalKey at todoId, replacing the todo it pointed at before.
+	SetTodoId(ctx context.Context, webhookId int, externalKey string, todoId int) error
+}
+
+type IncomingWebhookServicer interface {
+	GetAll(ctx context.Context, boardId int) ([]*entities.IncomingWebhook, error)
+	// Create returns the token of the new webhook, which cannot be read again later.
+	Create(ctx context.Context, boardId int) (string, *entities.IncomingWebhook, error)
+	Delete(ctx context.Context, boardId, id int) error
+	// Receive creates the todo sent with token, or updates the one created for the same
+	// external key before, and reports whether it created one.
+	Receive(ctx context.Context, token string, todo *entities.IncomingTodo) (*entities.Todo, bool, error)
+}
@@ -0,0 +1,193 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/activity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/activity.go -destination=./internal/interfaces/mock/activity.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockActivityRepository is a mock of ActivityRepository interface.
+type MockActivityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityRepositoryMockRecorder is the mock recorder for MockActivityRepository.
+type MockActivityRepositoryMockRecorder struct {
+	mock *MockActivityRepository
+}
+
+// NewMockActivityRepository creates a new mock instance.
+func NewMockActivityRepository(ctrl *gomock.Controller) *MockActivityRepository {
+	mock := &MockActivityRepository{ctrl: ctrl}
+	mock.recorder = &MockActivityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityRepository) EXPECT() *MockActivityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockActivityRepository) Create(ctx context.Context, activity *entities.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockActivityRepositoryMockRecorder) Create(ctx, activity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockActivityRepository)(nil).Create), ctx, activity)
+}
+
+// GetAll mocks base method.
+func (m *MockActivityRepository) GetAll(ctx context.Context, filter *entities.ActivityFilter) ([]*entities.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockActivityRepositoryMockRecorder) GetAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockActivityRepository)(nil).GetAll), ctx, filter)
+}
+
+// MockFeedTokenRepository is a mock of FeedTokenRepository interface.
+type MockFeedTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedTokenRepositoryMockRecorder is the mock recorder for MockFeedTokenRepository.
+type MockFeedTokenRepositoryMockRecorder struct {
+	mock *MockFeedTokenRepository
+}
+
+// NewMockFeedTokenRepository creates a new mock instance.
+func NewMockFeedTokenRepository(ctrl *gomock.Controller) *MockFeedTokenRepository {
+	mock := &MockFeedTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockFeedTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedTokenRepository) EXPECT() *MockFeedTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByHash mocks base method.
+func (m *MockFeedTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*entities.FeedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*entities.FeedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockFeedTokenRepositoryMockRecorder) GetByHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockFeedTokenRepository)(nil).GetByHash), ctx, tokenHash)
+}
+
+// Save mocks base method.
+func (m *MockFeedTokenRepository) Save(ctx context.Context, token *entities.FeedToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockFeedTokenRepositoryMockRecorder) Save(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockFeedTokenRepository)(nil).Save), ctx, token)
+}
+
+// MockActivityServicer is a mock of ActivityServicer interface.
+type MockActivityServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityServicerMockRecorder is the mock recorder for MockActivityServicer.
+type MockActivityServicerMockRecorder struct {
+	mock *MockActivityServicer
+}
+
+// NewMockActivityServicer creates a new mock instance.
+func NewMockActivityServicer(ctrl *gomock.Controller) *MockActivityServicer {
+	mock := &MockActivityServicer{ctrl: ctrl}
+	mock.recorder = &MockActivityServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityServicer) EXPECT() *MockActivityServicerMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockActivityServicer) Authenticate(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockActivityServicerMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockActivityServicer)(nil).Authenticate), ctx, token)
+}
+
+// CreateFeedToken mocks base method.
+func (m *MockActivityServicer) CreateFeedToken(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeedToken", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeedToken indicates an expected call of CreateFeedToken.
+func (mr *MockActivityServicerMockRecorder) CreateFeedToken(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeedToken", reflect.TypeOf((*MockActivityServicer)(nil).CreateFeedToken), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockActivityServicer) GetAll(ctx context.Context, filter *entities.ActivityFilter) (*entities.Room, []*entities.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter)
+	ret0, _ := ret[0].(*entities.Room)
+	ret1, _ := ret[1].([]*entities.Activity)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockActivityServicerMockRecorder) GetAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockActivityServicer)(nil).GetAll), ctx, filter)
+}
@@ -10,9 +10,12 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	interfaces "github.com/rm-ryou/sample_todo_app/internal/interfaces"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,75 +44,133 @@ func (m *MockBoardRepository) EXPECT() *MockBoardRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockBoardRepository) Create(board *entities.Board) error {
+func (m *MockBoardRepository) Create(ctx context.Context, board *entities.Board) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", board)
+	ret := m.ctrl.Call(m, "Create", ctx, board)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockBoardRepositoryMockRecorder) Create(board any) *gomock.Call {
+func (mr *MockBoardRepositoryMockRecorder) Create(ctx, board any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBoardRepository)(nil).Create), board)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBoardRepository)(nil).Create), ctx, board)
 }
 
 // Delete mocks base method.
-func (m *MockBoardRepository) Delete(id int) error {
+func (m *MockBoardRepository) Delete(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockBoardRepositoryMockRecorder) Delete(id any) *gomock.Call {
+func (mr *MockBoardRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardRepository)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardRepository)(nil).Delete), ctx, id)
 }
 
 // GetAll mocks base method.
-func (m *MockBoardRepository) GetAll() ([]*entities.Board, error) {
+func (m *MockBoardRepository) GetAll(ctx context.Context, includeArchived bool) ([]*entities.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", ctx, includeArchived)
 	ret0, _ := ret[0].([]*entities.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockBoardRepositoryMockRecorder) GetAll() *gomock.Call {
+func (mr *MockBoardRepositoryMockRecorder) GetAll(ctx, includeArchived any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBoardRepository)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBoardRepository)(nil).GetAll), ctx, includeArchived)
+}
+
+// GetAllByRoomId mocks base method.
+func (m *MockBoardRepository) GetAllByRoomId(ctx context.Context, roomId int, includeArchived bool) ([]*entities.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByRoomId", ctx, roomId, includeArchived)
+	ret0, _ := ret[0].([]*entities.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByRoomId indicates an expected call of GetAllByRoomId.
+func (mr *MockBoardRepositoryMockRecorder) GetAllByRoomId(ctx, roomId, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByRoomId", reflect.TypeOf((*MockBoardRepository)(nil).GetAllByRoomId), ctx, roomId, includeArchived)
+}
+
+// GetAllByRoomIdForUpdate mocks base method.
+func (m *MockBoardRepository) GetAllByRoomIdForUpdate(ctx context.Context, roomId int, includeArchived bool) ([]*entities.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByRoomIdForUpdate", ctx, roomId, includeArchived)
+	ret0, _ := ret[0].([]*entities.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByRoomIdForUpdate indicates an expected call of GetAllByRoomIdForUpdate.
+func (mr *MockBoardRepositoryMockRecorder) GetAllByRoomIdForUpdate(ctx, roomId, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByRoomIdForUpdate", reflect.TypeOf((*MockBoardRepository)(nil).GetAllByRoomIdForUpdate), ctx, roomId, includeArchived)
 }
 
 // GetById mocks base method.
-func (m *MockBoardRepository) GetById(id int) (*entities.Board, error) {
+func (m *MockBoardRepository) GetById(ctx context.Context, id int) (*entities.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", id)
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
 	ret0, _ := ret[0].(*entities.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockBoardRepositoryMockRecorder) GetById(id any) *gomock.Call {
+func (mr *MockBoardRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockBoardRepository)(nil).GetById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockBoardRepository)(nil).GetById), ctx, id)
+}
+
+// Reorder mocks base method.
+func (m *MockBoardRepository) Reorder(ctx context.Context, roomId int, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, roomId, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockBoardRepositoryMockRecorder) Reorder(ctx, roomId, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockBoardRepository)(nil).Reorder), ctx, roomId, ids)
 }
 
 // Update mocks base method.
-func (m *MockBoardRepository) Update(board *entities.Board) error {
+func (m *MockBoardRepository) Update(ctx context.Context, board *entities.Board) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", board)
+	ret := m.ctrl.Call(m, "Update", ctx, board)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockBoardRepositoryMockRecorder) Update(board any) *gomock.Call {
+func (mr *MockBoardRepositoryMockRecorder) Update(ctx, board any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBoardRepository)(nil).Update), ctx, board)
+}
+
+// UpdateArchivedAt mocks base method.
+func (m *MockBoardRepository) UpdateArchivedAt(ctx context.Context, id int, archivedAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArchivedAt", ctx, id, archivedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateArchivedAt indicates an expected call of UpdateArchivedAt.
+func (mr *MockBoardRepositoryMockRecorder) UpdateArchivedAt(ctx, id, archivedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBoardRepository)(nil).Update), board)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArchivedAt", reflect.TypeOf((*MockBoardRepository)(nil).UpdateArchivedAt), ctx, id, archivedAt)
 }
 
 // MockBoardServicer is a mock of BoardServicer interface.
@@ -136,59 +197,160 @@ func (m *MockBoardServicer) EXPECT() *MockBoardServicerMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockBoardServicer) Create(name string, priority, roomId int) error {
+// Archive mocks base method.
+func (m *MockBoardServicer) Archive(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", name, priority, roomId)
+	ret := m.ctrl.Call(m, "Archive", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Archive indicates an expected call of Archive.
+func (mr *MockBoardServicerMockRecorder) Archive(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockBoardServicer)(nil).Archive), ctx, id)
+}
+
+// Create mocks base method.
+func (m *MockBoardServicer) Create(ctx context.Context, name string, priority, roomId int) (*entities.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name, priority, roomId)
+	ret0, _ := ret[0].(*entities.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Create indicates an expected call of Create.
-func (mr *MockBoardServicerMockRecorder) Create(name, priority, roomId any) *gomock.Call {
+func (mr *MockBoardServicerMockRecorder) Create(ctx, name, priority, roomId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBoardServicer)(nil).Create), name, priority, roomId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBoardServicer)(nil).Create), ctx, name, priority, roomId)
 }
 
 // Delete mocks base method.
-func (m *MockBoardServicer) Delete(id int) error {
+func (m *MockBoardServicer) Delete(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockBoardServicerMockRecorder) Delete(id any) *gomock.Call {
+func (mr *MockBoardServicerMockRecorder) Delete(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardServicer)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardServicer)(nil).Delete), ctx, id)
+}
+
+// DeleteIn mocks base method.
+func (m *MockBoardServicer) DeleteIn(ctx context.Context, repos *interfaces.Repositories, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIn", ctx, repos, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIn indicates an expected call of DeleteIn.
+func (mr *MockBoardServicerMockRecorder) DeleteIn(ctx, repos, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIn", reflect.TypeOf((*MockBoardServicer)(nil).DeleteIn), ctx, repos, id)
 }
 
 // GetAll mocks base method.
-func (m *MockBoardServicer) GetAll() ([]*entities.Board, error) {
+func (m *MockBoardServicer) GetAll(ctx context.Context, includeArchived bool) ([]*entities.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", ctx, includeArchived)
 	ret0, _ := ret[0].([]*entities.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockBoardServicerMockRecorder) GetAll() *gomock.Call {
+func (mr *MockBoardServicerMockRecorder) GetAll(ctx, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBoardServicer)(nil).GetAll), ctx, includeArchived)
+}
+
+// GetAllByRoomId mocks base method.
+func (m *MockBoardServicer) GetAllByRoomId(ctx context.Context, roomId int, includeArchived bool) ([]*entities.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByRoomId", ctx, roomId, includeArchived)
+	ret0, _ := ret[0].([]*entities.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByRoomId indicates an expected call of GetAllByRoomId.
+func (mr *MockBoardServicerMockRecorder) GetAllByRoomId(ctx, roomId, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByRoomId", reflect.TypeOf((*MockBoardServicer)(nil).GetAllByRoomId), ctx, roomId, includeArchived)
+}
+
+// GetById mocks base method.
+func (m *MockBoardServicer) GetById(ctx context.Context, id int) (*entities.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*entities.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockBoardServicerMockRecorder) GetById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBoardServicer)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockBoardServicer)(nil).GetById), ctx, id)
+}
+
+// Reorder mocks base method.
+func (m *MockBoardServicer) Reorder(ctx context.Context, roomId int, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, roomId, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockBoardServicerMockRecorder) Reorder(ctx, roomId, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockBoardServicer)(nil).Reorder), ctx, roomId, ids)
+}
+
+// Unarchive mocks base method.
+func (m *MockBoardServicer) Unarchive(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockBoardServicerMockRecorder) Unarchive(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockBoardServicer)(nil).Unarchive), ctx, id)
 }
 
 // Update mocks base method.
-func (m *MockBoardServicer) Update(id int, name string, priority int) error {
+func (m *MockBoardServicer) Update(ctx context.Context, id int, name string, priority int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", id, name, priority)
+	ret := m.ctrl.Call(m, "Update", ctx, id, name, priority)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockBoardServicerMockRecorder) Update(id, name, priority any) *gomock.Call {
+func (mr *MockBoardServicerMockRecorder) Update(ctx, id, name, priority any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBoardServicer)(nil).Update), ctx, id, name, priority)
+}
+
+// UpdateIn mocks base method.
+func (m *MockBoardServicer) UpdateIn(ctx context.Context, repos *interfaces.Repositories, id int, name string, priority int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIn", ctx, repos, id, name, priority)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIn indicates an expected call of UpdateIn.
+func (mr *MockBoardServicerMockRecorder) UpdateIn(ctx, repos, id, name, priority any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBoardServicer)(nil).Update), id, name, priority)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIn", reflect.TypeOf((*MockBoardServicer)(nil).UpdateIn), ctx, repos, id, name, priority)
 }
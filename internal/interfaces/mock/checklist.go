@@ -0,0 +1,223 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/checklist.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/checklist.go -destination=./internal/interfaces/mock/checklist.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChecklistRepository is a mock of ChecklistRepository interface.
+type MockChecklistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockChecklistRepositoryMockRecorder is the mock recorder for MockChecklistRepository.
+type MockChecklistRepositoryMockRecorder struct {
+	mock *MockChecklistRepository
+}
+
+// NewMockChecklistRepository creates a new mock instance.
+func NewMockChecklistRepository(ctrl *gomock.Controller) *MockChecklistRepository {
+	mock := &MockChecklistRepository{ctrl: ctrl}
+	mock.recorder = &MockChecklistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistRepository) EXPECT() *MockChecklistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockChecklistRepository) Create(ctx context.Context, item *entities.ChecklistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChecklistRepositoryMockRecorder) Create(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChecklistRepository)(nil).Create), ctx, item)
+}
+
+// Delete mocks base method.
+func (m *MockChecklistRepository) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockChecklistRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChecklistRepository)(nil).Delete), ctx, id)
+}
+
+// GetAllByTodoId mocks base method.
+func (m *MockChecklistRepository) GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByTodoId", ctx, todoId)
+	ret0, _ := ret[0].([]*entities.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByTodoId indicates an expected call of GetAllByTodoId.
+func (mr *MockChecklistRepositoryMockRecorder) GetAllByTodoId(ctx, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByTodoId", reflect.TypeOf((*MockChecklistRepository)(nil).GetAllByTodoId), ctx, todoId)
+}
+
+// GetById mocks base method.
+func (m *MockChecklistRepository) GetById(ctx context.Context, id int) (*entities.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*entities.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockChecklistRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockChecklistRepository)(nil).GetById), ctx, id)
+}
+
+// Reorder mocks base method.
+func (m *MockChecklistRepository) Reorder(ctx context.Context, todoId int, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, todoId, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockChecklistRepositoryMockRecorder) Reorder(ctx, todoId, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockChecklistRepository)(nil).Reorder), ctx, todoId, ids)
+}
+
+// Update mocks base method.
+func (m *MockChecklistRepository) Update(ctx context.Context, item *entities.ChecklistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChecklistRepositoryMockRecorder) Update(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChecklistRepository)(nil).Update), ctx, item)
+}
+
+// MockChecklistServicer is a mock of ChecklistServicer interface.
+type MockChecklistServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockChecklistServicerMockRecorder is the mock recorder for MockChecklistServicer.
+type MockChecklistServicerMockRecorder struct {
+	mock *MockChecklistServicer
+}
+
+// NewMockChecklistServicer creates a new mock instance.
+func NewMockChecklistServicer(ctrl *gomock.Controller) *MockChecklistServicer {
+	mock := &MockChecklistServicer{ctrl: ctrl}
+	mock.recorder = &MockChecklistServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistServicer) EXPECT() *MockChecklistServicerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockChecklistServicer) Create(ctx context.Context, boardId, todoId int, text string, checked bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, boardId, todoId, text, checked)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChecklistServicerMockRecorder) Create(ctx, boardId, todoId, text, checked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChecklistServicer)(nil).Create), ctx, boardId, todoId, text, checked)
+}
+
+// Delete mocks base method.
+func (m *MockChecklistServicer) Delete(ctx context.Context, boardId, todoId, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, boardId, todoId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockChecklistServicerMockRecorder) Delete(ctx, boardId, todoId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChecklistServicer)(nil).Delete), ctx, boardId, todoId, id)
+}
+
+// GetAll mocks base method.
+func (m *MockChecklistServicer) GetAll(ctx context.Context, boardId, todoId int) ([]*entities.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, boardId, todoId)
+	ret0, _ := ret[0].([]*entities.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockChecklistServicerMockRecorder) GetAll(ctx, boardId, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockChecklistServicer)(nil).GetAll), ctx, boardId, todoId)
+}
+
+// Reorder mocks base method.
+func (m *MockChecklistServicer) Reorder(ctx context.Context, boardId, todoId int, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, boardId, todoId, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockChecklistServicerMockRecorder) Reorder(ctx, boardId, todoId, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockChecklistServicer)(nil).Reorder), ctx, boardId, todoId, ids)
+}
+
+// Update mocks base method.
+func (m *MockChecklistServicer) Update(ctx context.Context, boardId, todoId, id int, text string, checked bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, boardId, todoId, id, text, checked)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChecklistServicerMockRecorder) Update(ctx, boardId, todoId, id, text, checked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChecklistServicer)(nil).Update), ctx, boardId, todoId, id, text, checked)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/comment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/comment.go -destination=./internal/interfaces/mock/comment.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCommentRepository is a mock of CommentRepository interface.
+type MockCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentRepositoryMockRecorder is the mock recorder for MockCommentRepository.
+type MockCommentRepositoryMockRecorder struct {
+	mock *MockCommentRepository
+}
+
+// NewMockCommentRepository creates a new mock instance.
+func NewMockCommentRepository(ctrl *gomock.Controller) *MockCommentRepository {
+	mock := &MockCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentRepository) EXPECT() *MockCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCommentRepository) Create(ctx context.Context, comment *entities.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCommentRepositoryMockRecorder) Create(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentRepository)(nil).Create), ctx, comment)
+}
+
+// GetAllByTodoId mocks base method.
+func (m *MockCommentRepository) GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByTodoId", ctx, todoId)
+	ret0, _ := ret[0].([]*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByTodoId indicates an expected call of GetAllByTodoId.
+func (mr *MockCommentRepositoryMockRecorder) GetAllByTodoId(ctx, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByTodoId", reflect.TypeOf((*MockCommentRepository)(nil).GetAllByTodoId), ctx, todoId)
+}
+
+// MockCommentServicer is a mock of CommentServicer interface.
+type MockCommentServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentServicerMockRecorder is the mock recorder for MockCommentServicer.
+type MockCommentServicerMockRecorder struct {
+	mock *MockCommentServicer
+}
+
+// NewMockCommentServicer creates a new mock instance.
+func NewMockCommentServicer(ctrl *gomock.Controller) *MockCommentServicer {
+	mock := &MockCommentServicer{ctrl: ctrl}
+	mock.recorder = &MockCommentServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentServicer) EXPECT() *MockCommentServicerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCommentServicer) Create(ctx context.Context, boardId, todoId int, body string) (*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, boardId, todoId, body)
+	ret0, _ := ret[0].(*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCommentServicerMockRecorder) Create(ctx, boardId, todoId, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentServicer)(nil).Create), ctx, boardId, todoId, body)
+}
+
+// GetAll mocks base method.
+func (m *MockCommentServicer) GetAll(ctx context.Context, boardId, todoId int) ([]*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, boardId, todoId)
+	ret0, _ := ret[0].([]*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCommentServicerMockRecorder) GetAll(ctx, boardId, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCommentServicer)(nil).GetAll), ctx, boardId, todoId)
+}
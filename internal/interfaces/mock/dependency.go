@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/dependency.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/dependency.go -destination=./internal/interfaces/mock/dependency.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDependencyRepository is a mock of DependencyRepository interface.
+type MockDependencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependencyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDependencyRepositoryMockRecorder is the mock recorder for MockDependencyRepository.
+type MockDependencyRepositoryMockRecorder struct {
+	mock *MockDependencyRepository
+}
+
+// NewMockDependencyRepository creates a new mock instance.
+func NewMockDependencyRepository(ctrl *gomock.Controller) *MockDependencyRepository {
+	mock := &MockDependencyRepository{ctrl: ctrl}
+	mock.recorder = &MockDependencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependencyRepository) EXPECT() *MockDependencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDependencyRepository) Create(ctx context.Context, dependency *entities.TodoDependency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dependency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDependencyRepositoryMockRecorder) Create(ctx, dependency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDependencyRepository)(nil).Create), ctx, dependency)
+}
+
+// Delete mocks base method.
+func (m *MockDependencyRepository) Delete(ctx context.Context, todoId, blockerId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, todoId, blockerId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDependencyRepositoryMockRecorder) Delete(ctx, todoId, blockerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDependencyRepository)(nil).Delete), ctx, todoId, blockerId)
+}
+
+// GetAllByRoomId mocks base method.
+func (m *MockDependencyRepository) GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.TodoDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByRoomId", ctx, roomId)
+	ret0, _ := ret[0].([]*entities.TodoDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByRoomId indicates an expected call of GetAllByRoomId.
+func (mr *MockDependencyRepositoryMockRecorder) GetAllByRoomId(ctx, roomId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByRoomId", reflect.TypeOf((*MockDependencyRepository)(nil).GetAllByRoomId), ctx, roomId)
+}
+
+// GetAllByTodoId mocks base method.
+func (m *MockDependencyRepository) GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.TodoDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByTodoId", ctx, todoId)
+	ret0, _ := ret[0].([]*entities.TodoDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByTodoId indicates an expected call of GetAllByTodoId.
+func (mr *MockDependencyRepositoryMockRecorder) GetAllByTodoId(ctx, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByTodoId", reflect.TypeOf((*MockDependencyRepository)(nil).GetAllByTodoId), ctx, todoId)
+}
+
+// GetRoomIdByTodoId mocks base method.
+func (m *MockDependencyRepository) GetRoomIdByTodoId(ctx context.Context, todoId int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomIdByTodoId", ctx, todoId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomIdByTodoId indicates an expected call of GetRoomIdByTodoId.
+func (mr *MockDependencyRepositoryMockRecorder) GetRoomIdByTodoId(ctx, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomIdByTodoId", reflect.TypeOf((*MockDependencyRepository)(nil).GetRoomIdByTodoId), ctx, todoId)
+}
+
+// LockRoom mocks base method.
+func (m *MockDependencyRepository) LockRoom(ctx context.Context, roomId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockRoom", ctx, roomId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockRoom indicates an expected call of LockRoom.
+func (mr *MockDependencyRepositoryMockRecorder) LockRoom(ctx, roomId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockRoom", reflect.TypeOf((*MockDependencyRepository)(nil).LockRoom), ctx, roomId)
+}
+
+// MockDependencyServicer is a mock of DependencyServicer interface.
+type MockDependencyServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependencyServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockDependencyServicerMockRecorder is the mock recorder for MockDependencyServicer.
+type MockDependencyServicerMockRecorder struct {
+	mock *MockDependencyServicer
+}
+
+// NewMockDependencyServicer creates a new mock instance.
+func NewMockDependencyServicer(ctrl *gomock.Controller) *MockDependencyServicer {
+	mock := &MockDependencyServicer{ctrl: ctrl}
+	mock.recorder = &MockDependencyServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependencyServicer) EXPECT() *MockDependencyServicerMockRecorder {
+	return m.recorder
+}
+
+// AddBlocker mocks base method.
+func (m *MockDependencyServicer) AddBlocker(ctx context.Context, boardId, todoId, blockerId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBlocker", ctx, boardId, todoId, blockerId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBlocker indicates an expected call of AddBlocker.
+func (mr *MockDependencyServicerMockRecorder) AddBlocker(ctx, boardId, todoId, blockerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlocker", reflect.TypeOf((*MockDependencyServicer)(nil).AddBlocker), ctx, boardId, todoId, blockerId)
+}
+
+// GetBlockers mocks base method.
+func (m *MockDependencyServicer) GetBlockers(ctx context.Context, boardId, todoId int) ([]*entities.TodoDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockers", ctx, boardId, todoId)
+	ret0, _ := ret[0].([]*entities.TodoDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockers indicates an expected call of GetBlockers.
+func (mr *MockDependencyServicerMockRecorder) GetBlockers(ctx, boardId, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockers", reflect.TypeOf((*MockDependencyServicer)(nil).GetBlockers), ctx, boardId, todoId)
+}
+
+// RemoveBlocker mocks base method.
+func (m *MockDependencyServicer) RemoveBlocker(ctx context.Context, boardId, todoId, blockerId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlocker", ctx, boardId, todoId, blockerId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBlocker indicates an expected call of RemoveBlocker.
+func (mr *MockDependencyServicerMockRecorder) RemoveBlocker(ctx, boardId, todoId, blockerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlocker", reflect.TypeOf((*MockDependencyServicer)(nil).RemoveBlocker), ctx, boardId, todoId, blockerId)
+}
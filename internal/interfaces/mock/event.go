@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/event.go -destination=./internal/interfaces/mock/event.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventHub is a mock of EventHub interface.
+type MockEventHub struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventHubMockRecorder
+	isgomock struct{}
+}
+
+// MockEventHubMockRecorder is the mock recorder for MockEventHub.
+type MockEventHubMockRecorder struct {
+	mock *MockEventHub
+}
+
+// NewMockEventHub creates a new mock instance.
+func NewMockEventHub(ctrl *gomock.Controller) *MockEventHub {
+	mock := &MockEventHub{ctrl: ctrl}
+	mock.recorder = &MockEventHubMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventHub) EXPECT() *MockEventHubMockRecorder {
+	return m.recorder
+}
+
+// Follow mocks base method.
+func (m *MockEventHub) Follow(lastEventId int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Follow", lastEventId)
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockEventHubMockRecorder) Follow(lastEventId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockEventHub)(nil).Follow), lastEventId)
+}
+
+// Publish mocks base method.
+func (m *MockEventHub) Publish(event *entities.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", event)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventHubMockRecorder) Publish(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventHub)(nil).Publish), event)
+}
+
+// Subscribe mocks base method.
+func (m *MockEventHub) Subscribe(roomId int, lastEventId int64) (*entities.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", roomId, lastEventId)
+	ret0, _ := ret[0].(*entities.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventHubMockRecorder) Subscribe(roomId, lastEventId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventHub)(nil).Subscribe), roomId, lastEventId)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockEventHub) Unsubscribe(sub *entities.EventSubscription) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Unsubscribe", sub)
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockEventHubMockRecorder) Unsubscribe(sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockEventHub)(nil).Unsubscribe), sub)
+}
+
+// MockEventServicer is a mock of EventServicer interface.
+type MockEventServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockEventServicerMockRecorder is the mock recorder for MockEventServicer.
+type MockEventServicerMockRecorder struct {
+	mock *MockEventServicer
+}
+
+// NewMockEventServicer creates a new mock instance.
+func NewMockEventServicer(ctrl *gomock.Controller) *MockEventServicer {
+	mock := &MockEventServicer{ctrl: ctrl}
+	mock.recorder = &MockEventServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventServicer) EXPECT() *MockEventServicerMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockEventServicer) Subscribe(ctx context.Context, roomId int, lastEventId int64) (*entities.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, roomId, lastEventId)
+	ret0, _ := ret[0].(*entities.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventServicerMockRecorder) Subscribe(ctx, roomId, lastEventId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventServicer)(nil).Subscribe), ctx, roomId, lastEventId)
+}
+
+// SubscribeBoard mocks base method.
+func (m *MockEventServicer) SubscribeBoard(ctx context.Context, boardId int, lastEventId int64) (*entities.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeBoard", ctx, boardId, lastEventId)
+	ret0, _ := ret[0].(*entities.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeBoard indicates an expected call of SubscribeBoard.
+func (mr *MockEventServicerMockRecorder) SubscribeBoard(ctx, boardId, lastEventId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeBoard", reflect.TypeOf((*MockEventServicer)(nil).SubscribeBoard), ctx, boardId, lastEventId)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockEventServicer) Unsubscribe(sub *entities.EventSubscription) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Unsubscribe", sub)
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockEventServicerMockRecorder) Unsubscribe(sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockEventServicer)(nil).Unsubscribe), sub)
+}
@@ -0,0 +1,244 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/incoming_webhook.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/incoming_webhook.go -destination=./internal/interfaces/mock/incoming_webhook.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIncomingWebhookRepository is a mock of IncomingWebhookRepository interface.
+type MockIncomingWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncomingWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIncomingWebhookRepositoryMockRecorder is the mock recorder for MockIncomingWebhookRepository.
+type MockIncomingWebhookRepositoryMockRecorder struct {
+	mock *MockIncomingWebhookRepository
+}
+
+// NewMockIncomingWebhookRepository creates a new mock instance.
+func NewMockIncomingWebhookRepository(ctrl *gomock.Controller) *MockIncomingWebhookRepository {
+	mock := &MockIncomingWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockIncomingWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncomingWebhookRepository) EXPECT() *MockIncomingWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimKey mocks base method.
+func (m *MockIncomingWebhookRepository) ClaimKey(ctx context.Context, webhookId int, externalKey string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimKey", ctx, webhookId, externalKey)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimKey indicates an expected call of ClaimKey.
+func (mr *MockIncomingWebhookRepositoryMockRecorder) ClaimKey(ctx, webhookId, externalKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimKey", reflect.TypeOf((*MockIncomingWebhookRepository)(nil).ClaimKey), ctx, webhookId, externalKey)
+}
+
+// Create mocks base method.
+func (m *MockIncomingWebhookRepository) Create(ctx context.Context, webhook *entities.IncomingWebhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIncomingWebhookRepositoryMockRecorder) Create(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIncomingWebhookRepository)(nil).Create), ctx, webhook)
+}
+
+// Delete mocks base method.
+func (m *MockIncomingWebhookRepository) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIncomingWebhookRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIncomingWebhookRepository)(nil).Delete), ctx, id)
+}
+
+// GetAllByBoardId mocks base method.
+func (m *MockIncomingWebhookRepository) GetAllByBoardId(ctx context.Context, boardId int) ([]*entities.IncomingWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByBoardId", ctx, boardId)
+	ret0, _ := ret[0].([]*entities.IncomingWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByBoardId indicates an expected call of GetAllByBoardId.
+func (mr *MockIncomingWebhookRepositoryMockRecorder) GetAllByBoardId(ctx, boardId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByBoardId", reflect.TypeOf((*MockIncomingWebhookRepository)(nil).GetAllByBoardId), ctx, boardId)
+}
+
+// GetById mocks base method.
+func (m *MockIncomingWebhookRepository) GetById(ctx context.Context, id int) (*entities.IncomingWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*entities.IncomingWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIncomingWebhookRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIncomingWebhookRepository)(nil).GetById), ctx, id)
+}
+
+// GetByTokenHash mocks base method.
+func (m *MockIncomingWebhookRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.IncomingWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*entities.IncomingWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTokenHash indicates an expected call of GetByTokenHash.
+func (mr *MockIncomingWebhookRepositoryMockRecorder) GetByTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockIncomingWebhookRepository)(nil).GetByTokenHash), ctx, tokenHash)
+}
+
+// GetTodoId mocks base method.
+func (m *MockIncomingWebhookRepository) GetTodoId(ctx context.Context, webhookId int, externalKey string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodoId", ctx, webhookId, externalKey)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodoId indicates an expected call of GetTodoId.
+func (mr *MockIncomingWebhookRepositoryMockRecorder) GetTodoId(ctx, webhookId, externalKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodoId", reflect.TypeOf((*MockIncomingWebhookRepository)(nil).GetTodoId), ctx, webhookId, externalKey)
+}
+
+// SetTodoId mocks base method.
+func (m *MockIncomingWebhookRepository) SetTodoId(ctx context.Context, webhookId int, externalKey string, todoId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTodoId", ctx, webhookId, externalKey, todoId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTodoId indicates an expected call of SetTodoId.
+func (mr *MockIncomingWebhookRepositoryMockRecorder) SetTodoId(ctx, webhookId, externalKey, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTodoId", reflect.TypeOf((*MockIncomingWebhookRepository)(nil).SetTodoId), ctx, webhookId, externalKey, todoId)
+}
+
+// MockIncomingWebhookServicer is a mock of IncomingWebhookServicer interface.
+type MockIncomingWebhookServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncomingWebhookServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockIncomingWebhookServicerMockRecorder is the mock recorder for MockIncomingWebhookServicer.
+type MockIncomingWebhookServicerMockRecorder struct {
+	mock *MockIncomingWebhookServicer
+}
+
+// NewMockIncomingWebhookServicer creates a new mock instance.
+func NewMockIncomingWebhookServicer(ctrl *gomock.Controller) *MockIncomingWebhookServicer {
+	mock := &MockIncomingWebhookServicer{ctrl: ctrl}
+	mock.recorder = &MockIncomingWebhookServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncomingWebhookServicer) EXPECT() *MockIncomingWebhookServicerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIncomingWebhookServicer) Create(ctx context.Context, boardId int) (string, *entities.IncomingWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, boardId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*entities.IncomingWebhook)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIncomingWebhookServicerMockRecorder) Create(ctx, boardId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIncomingWebhookServicer)(nil).Create), ctx, boardId)
+}
+
+// Delete mocks base method.
+func (m *MockIncomingWebhookServicer) Delete(ctx context.Context, boardId, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, boardId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIncomingWebhookServicerMockRecorder) Delete(ctx, boardId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIncomingWebhookServicer)(nil).Delete), ctx, boardId, id)
+}
+
+// GetAll mocks base method.
+func (m *MockIncomingWebhookServicer) GetAll(ctx context.Context, boardId int) ([]*entities.IncomingWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, boardId)
+	ret0, _ := ret[0].([]*entities.IncomingWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIncomingWebhookServicerMockRecorder) GetAll(ctx, boardId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIncomingWebhookServicer)(nil).GetAll), ctx, boardId)
+}
+
+// Receive mocks base method.
+func (m *MockIncomingWebhookServicer) Receive(ctx context.Context, token string, todo *entities.IncomingTodo) (*entities.Todo, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, token, todo)
+	ret0, _ := ret[0].(*entities.Todo)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockIncomingWebhookServicerMockRecorder) Receive(ctx, token, todo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockIncomingWebhookServicer)(nil).Receive), ctx, token, todo)
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/presence.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/presence.go -destination=./internal/interfaces/mock/presence.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPresenceServicer is a mock of PresenceServicer interface.
+type MockPresenceServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPresenceServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockPresenceServicerMockRecorder is the mock recorder for MockPresenceServicer.
+type MockPresenceServicerMockRecorder struct {
+	mock *MockPresenceServicer
+}
+
+// NewMockPresenceServicer creates a new mock instance.
+func NewMockPresenceServicer(ctrl *gomock.Controller) *MockPresenceServicer {
+	mock := &MockPresenceServicer{ctrl: ctrl}
+	mock.recorder = &MockPresenceServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPresenceServicer) EXPECT() *MockPresenceServicerMockRecorder {
+	return m.recorder
+}
+
+// Join mocks base method.
+func (m *MockPresenceServicer) Join(boardId int, actor string) *entities.PresenceSession {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Join", boardId, actor)
+	ret0, _ := ret[0].(*entities.PresenceSession)
+	return ret0
+}
+
+// Join indicates an expected call of Join.
+func (mr *MockPresenceServicerMockRecorder) Join(boardId, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Join", reflect.TypeOf((*MockPresenceServicer)(nil).Join), boardId, actor)
+}
+
+// Leave mocks base method.
+func (m *MockPresenceServicer) Leave(session *entities.PresenceSession) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Leave", session)
+}
+
+// Leave indicates an expected call of Leave.
+func (mr *MockPresenceServicerMockRecorder) Leave(session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leave", reflect.TypeOf((*MockPresenceServicer)(nil).Leave), session)
+}
+
+// SetEditing mocks base method.
+func (m *MockPresenceServicer) SetEditing(session *entities.PresenceSession, todoId *int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetEditing", session, todoId)
+}
+
+// SetEditing indicates an expected call of SetEditing.
+func (mr *MockPresenceServicerMockRecorder) SetEditing(session, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEditing", reflect.TypeOf((*MockPresenceServicer)(nil).SetEditing), session, todoId)
+}
@@ -0,0 +1,249 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/reminder.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/reminder.go -destination=./internal/interfaces/mock/reminder.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReminderRepository is a mock of ReminderRepository interface.
+type MockReminderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReminderRepositoryMockRecorder is the mock recorder for MockReminderRepository.
+type MockReminderRepositoryMockRecorder struct {
+	mock *MockReminderRepository
+}
+
+// NewMockReminderRepository creates a new mock instance.
+func NewMockReminderRepository(ctrl *gomock.Controller) *MockReminderRepository {
+	mock := &MockReminderRepository{ctrl: ctrl}
+	mock.recorder = &MockReminderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderRepository) EXPECT() *MockReminderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockReminderRepository) ClaimDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]*entities.DueReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, staleBefore, limit)
+	ret0, _ := ret[0].([]*entities.DueReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockReminderRepositoryMockRecorder) ClaimDue(ctx, now, staleBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockReminderRepository)(nil).ClaimDue), ctx, now, staleBefore, limit)
+}
+
+// Create mocks base method.
+func (m *MockReminderRepository) Create(ctx context.Context, reminder *entities.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReminderRepositoryMockRecorder) Create(ctx, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReminderRepository)(nil).Create), ctx, reminder)
+}
+
+// Delete mocks base method.
+func (m *MockReminderRepository) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReminderRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReminderRepository)(nil).Delete), ctx, id)
+}
+
+// GetAllByTodoId mocks base method.
+func (m *MockReminderRepository) GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByTodoId", ctx, todoId)
+	ret0, _ := ret[0].([]*entities.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByTodoId indicates an expected call of GetAllByTodoId.
+func (mr *MockReminderRepositoryMockRecorder) GetAllByTodoId(ctx, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByTodoId", reflect.TypeOf((*MockReminderRepository)(nil).GetAllByTodoId), ctx, todoId)
+}
+
+// GetById mocks base method.
+func (m *MockReminderRepository) GetById(ctx context.Context, id int) (*entities.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*entities.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockReminderRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockReminderRepository)(nil).GetById), ctx, id)
+}
+
+// MarkSent mocks base method.
+func (m *MockReminderRepository) MarkSent(ctx context.Context, id int, sentAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSent", ctx, id, sentAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSent indicates an expected call of MarkSent.
+func (mr *MockReminderRepositoryMockRecorder) MarkSent(ctx, id, sentAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSent", reflect.TypeOf((*MockReminderRepository)(nil).MarkSent), ctx, id, sentAt)
+}
+
+// Release mocks base method.
+func (m *MockReminderRepository) Release(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockReminderRepositoryMockRecorder) Release(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockReminderRepository)(nil).Release), ctx, id)
+}
+
+// MockReminderServicer is a mock of ReminderServicer interface.
+type MockReminderServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockReminderServicerMockRecorder is the mock recorder for MockReminderServicer.
+type MockReminderServicerMockRecorder struct {
+	mock *MockReminderServicer
+}
+
+// NewMockReminderServicer creates a new mock instance.
+func NewMockReminderServicer(ctrl *gomock.Controller) *MockReminderServicer {
+	mock := &MockReminderServicer{ctrl: ctrl}
+	mock.recorder = &MockReminderServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderServicer) EXPECT() *MockReminderServicerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReminderServicer) Create(ctx context.Context, boardId, todoId int, remindAt *time.Time, offsetMinutes *int, channel string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, boardId, todoId, remindAt, offsetMinutes, channel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReminderServicerMockRecorder) Create(ctx, boardId, todoId, remindAt, offsetMinutes, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReminderServicer)(nil).Create), ctx, boardId, todoId, remindAt, offsetMinutes, channel)
+}
+
+// Delete mocks base method.
+func (m *MockReminderServicer) Delete(ctx context.Context, boardId, todoId, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, boardId, todoId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReminderServicerMockRecorder) Delete(ctx, boardId, todoId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReminderServicer)(nil).Delete), ctx, boardId, todoId, id)
+}
+
+// GetAll mocks base method.
+func (m *MockReminderServicer) GetAll(ctx context.Context, boardId, todoId int) ([]*entities.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, boardId, todoId)
+	ret0, _ := ret[0].([]*entities.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockReminderServicerMockRecorder) GetAll(ctx, boardId, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockReminderServicer)(nil).GetAll), ctx, boardId, todoId)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+	isgomock struct{}
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(ctx context.Context, reminder *entities.DueReminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(ctx, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, reminder)
+}
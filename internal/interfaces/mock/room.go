@@ -10,9 +10,12 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	interfaces "github.com/rm-ryou/sample_todo_app/internal/interfaces"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,75 +44,143 @@ func (m *MockRoomRepository) EXPECT() *MockRoomRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockRoomRepository) Create(room *entities.Room) error {
+func (m *MockRoomRepository) Create(ctx context.Context, room *entities.Room) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", room)
+	ret := m.ctrl.Call(m, "Create", ctx, room)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockRoomRepositoryMockRecorder) Create(room any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) Create(ctx, room any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoomRepository)(nil).Create), room)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoomRepository)(nil).Create), ctx, room)
 }
 
 // Delete mocks base method.
-func (m *MockRoomRepository) Delete(id int) error {
+func (m *MockRoomRepository) Delete(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRoomRepositoryMockRecorder) Delete(id any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoomRepository)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoomRepository)(nil).Delete), ctx, id)
 }
 
 // GetAll mocks base method.
-func (m *MockRoomRepository) GetAll() ([]*entities.Room, error) {
+func (m *MockRoomRepository) GetAll(ctx context.Context, includeArchived bool) ([]*entities.Room, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", ctx, includeArchived)
 	ret0, _ := ret[0].([]*entities.Room)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockRoomRepositoryMockRecorder) GetAll() *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) GetAll(ctx, includeArchived any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRoomRepository)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRoomRepository)(nil).GetAll), ctx, includeArchived)
 }
 
 // GetById mocks base method.
-func (m *MockRoomRepository) GetById(id int) (*entities.Room, error) {
+func (m *MockRoomRepository) GetById(ctx context.Context, id int) (*entities.Room, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", id)
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
 	ret0, _ := ret[0].(*entities.Room)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockRoomRepositoryMockRecorder) GetById(id any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockRoomRepository)(nil).GetById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockRoomRepository)(nil).GetById), ctx, id)
 }
 
 // Update mocks base method.
-func (m *MockRoomRepository) Update(room *entities.Room) error {
+func (m *MockRoomRepository) Update(ctx context.Context, room *entities.Room) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", room)
+	ret := m.ctrl.Call(m, "Update", ctx, room)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockRoomRepositoryMockRecorder) Update(room any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) Update(ctx, room any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoomRepository)(nil).Update), room)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoomRepository)(nil).Update), ctx, room)
+}
+
+// UpdateArchivedAt mocks base method.
+func (m *MockRoomRepository) UpdateArchivedAt(ctx context.Context, id int, archivedAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArchivedAt", ctx, id, archivedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateArchivedAt indicates an expected call of UpdateArchivedAt.
+func (mr *MockRoomRepositoryMockRecorder) UpdateArchivedAt(ctx, id, archivedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArchivedAt", reflect.TypeOf((*MockRoomRepository)(nil).UpdateArchivedAt), ctx, id, archivedAt)
+}
+
+// MockRoomMemberRepository is a mock of RoomMemberRepository interface.
+type MockRoomMemberRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomMemberRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRoomMemberRepositoryMockRecorder is the mock recorder for MockRoomMemberRepository.
+type MockRoomMemberRepositoryMockRecorder struct {
+	mock *MockRoomMemberRepository
+}
+
+// NewMockRoomMemberRepository creates a new mock instance.
+func NewMockRoomMemberRepository(ctrl *gomock.Controller) *MockRoomMemberRepository {
+	mock := &MockRoomMemberRepository{ctrl: ctrl}
+	mock.recorder = &MockRoomMemberRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomMemberRepository) EXPECT() *MockRoomMemberRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRoomMemberRepository) Create(ctx context.Context, member *entities.RoomMember) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, member)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoomMemberRepositoryMockRecorder) Create(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoomMemberRepository)(nil).Create), ctx, member)
+}
+
+// GetAllByRoomId mocks base method.
+func (m *MockRoomMemberRepository) GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.RoomMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByRoomId", ctx, roomId)
+	ret0, _ := ret[0].([]*entities.RoomMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByRoomId indicates an expected call of GetAllByRoomId.
+func (mr *MockRoomMemberRepositoryMockRecorder) GetAllByRoomId(ctx, roomId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByRoomId", reflect.TypeOf((*MockRoomMemberRepository)(nil).GetAllByRoomId), ctx, roomId)
 }
 
 // MockRoomServicer is a mock of RoomServicer interface.
@@ -136,59 +207,185 @@ func (m *MockRoomServicer) EXPECT() *MockRoomServicerMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockRoomServicer) Create(name string) error {
+// Archive mocks base method.
+func (m *MockRoomServicer) Archive(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", name)
+	ret := m.ctrl.Call(m, "Archive", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Archive indicates an expected call of Archive.
+func (mr *MockRoomServicerMockRecorder) Archive(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockRoomServicer)(nil).Archive), ctx, id)
+}
+
+// Create mocks base method.
+func (m *MockRoomServicer) Create(ctx context.Context, name string) (*entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name)
+	ret0, _ := ret[0].(*entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Create indicates an expected call of Create.
-func (mr *MockRoomServicerMockRecorder) Create(name any) *gomock.Call {
+func (mr *MockRoomServicerMockRecorder) Create(ctx, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoomServicer)(nil).Create), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoomServicer)(nil).Create), ctx, name)
 }
 
 // Delete mocks base method.
-func (m *MockRoomServicer) Delete(id int) error {
+func (m *MockRoomServicer) Delete(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRoomServicerMockRecorder) Delete(id any) *gomock.Call {
+func (mr *MockRoomServicerMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoomServicer)(nil).Delete), ctx, id)
+}
+
+// DeleteIn mocks base method.
+func (m *MockRoomServicer) DeleteIn(ctx context.Context, repos *interfaces.Repositories, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIn", ctx, repos, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIn indicates an expected call of DeleteIn.
+func (mr *MockRoomServicerMockRecorder) DeleteIn(ctx, repos, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoomServicer)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIn", reflect.TypeOf((*MockRoomServicer)(nil).DeleteIn), ctx, repos, id)
 }
 
 // GetAll mocks base method.
-func (m *MockRoomServicer) GetAll() ([]*entities.Room, error) {
+func (m *MockRoomServicer) GetAll(ctx context.Context, includeArchived bool) ([]*entities.Room, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", ctx, includeArchived)
 	ret0, _ := ret[0].([]*entities.Room)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockRoomServicerMockRecorder) GetAll() *gomock.Call {
+func (mr *MockRoomServicerMockRecorder) GetAll(ctx, includeArchived any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRoomServicer)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRoomServicer)(nil).GetAll), ctx, includeArchived)
+}
+
+// GetById mocks base method.
+func (m *MockRoomServicer) GetById(ctx context.Context, id int) (*entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockRoomServicerMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockRoomServicer)(nil).GetById), ctx, id)
+}
+
+// Unarchive mocks base method.
+func (m *MockRoomServicer) Unarchive(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockRoomServicerMockRecorder) Unarchive(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockRoomServicer)(nil).Unarchive), ctx, id)
 }
 
 // Update mocks base method.
-func (m *MockRoomServicer) Update(id int, name string) error {
+func (m *MockRoomServicer) Update(ctx context.Context, id int, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", id, name)
+	ret := m.ctrl.Call(m, "Update", ctx, id, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockRoomServicerMockRecorder) Update(id, name any) *gomock.Call {
+func (mr *MockRoomServicerMockRecorder) Update(ctx, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoomServicer)(nil).Update), ctx, id, name)
+}
+
+// UpdateIn mocks base method.
+func (m *MockRoomServicer) UpdateIn(ctx context.Context, repos *interfaces.Repositories, id int, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIn", ctx, repos, id, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIn indicates an expected call of UpdateIn.
+func (mr *MockRoomServicerMockRecorder) UpdateIn(ctx, repos, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIn", reflect.TypeOf((*MockRoomServicer)(nil).UpdateIn), ctx, repos, id, name)
+}
+
+// MockRoomMemberServicer is a mock of RoomMemberServicer interface.
+type MockRoomMemberServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomMemberServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockRoomMemberServicerMockRecorder is the mock recorder for MockRoomMemberServicer.
+type MockRoomMemberServicerMockRecorder struct {
+	mock *MockRoomMemberServicer
+}
+
+// NewMockRoomMemberServicer creates a new mock instance.
+func NewMockRoomMemberServicer(ctrl *gomock.Controller) *MockRoomMemberServicer {
+	mock := &MockRoomMemberServicer{ctrl: ctrl}
+	mock.recorder = &MockRoomMemberServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomMemberServicer) EXPECT() *MockRoomMemberServicerMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockRoomMemberServicer) GetAll(ctx context.Context, roomId int) ([]*entities.RoomMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, roomId)
+	ret0, _ := ret[0].([]*entities.RoomMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockRoomMemberServicerMockRecorder) GetAll(ctx, roomId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRoomMemberServicer)(nil).GetAll), ctx, roomId)
+}
+
+// Join mocks base method.
+func (m *MockRoomMemberServicer) Join(ctx context.Context, roomId int) (*entities.RoomMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Join", ctx, roomId)
+	ret0, _ := ret[0].(*entities.RoomMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Join indicates an expected call of Join.
+func (mr *MockRoomMemberServicerMockRecorder) Join(ctx, roomId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoomServicer)(nil).Update), id, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Join", reflect.TypeOf((*MockRoomMemberServicer)(nil).Join), ctx, roomId)
 }
@@ -0,0 +1,282 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/status.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/status.go -destination=./internal/interfaces/mock/status.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStatusRepository is a mock of StatusRepository interface.
+type MockStatusRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockStatusRepositoryMockRecorder is the mock recorder for MockStatusRepository.
+type MockStatusRepositoryMockRecorder struct {
+	mock *MockStatusRepository
+}
+
+// NewMockStatusRepository creates a new mock instance.
+func NewMockStatusRepository(ctrl *gomock.Controller) *MockStatusRepository {
+	mock := &MockStatusRepository{ctrl: ctrl}
+	mock.recorder = &MockStatusRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusRepository) EXPECT() *MockStatusRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountTodos mocks base method.
+func (m *MockStatusRepository) CountTodos(ctx context.Context, id int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTodos", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTodos indicates an expected call of CountTodos.
+func (mr *MockStatusRepositoryMockRecorder) CountTodos(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTodos", reflect.TypeOf((*MockStatusRepository)(nil).CountTodos), ctx, id)
+}
+
+// Create mocks base method.
+func (m *MockStatusRepository) Create(ctx context.Context, status *entities.Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStatusRepositoryMockRecorder) Create(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStatusRepository)(nil).Create), ctx, status)
+}
+
+// Delete mocks base method.
+func (m *MockStatusRepository) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStatusRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStatusRepository)(nil).Delete), ctx, id)
+}
+
+// GetAllByBoardId mocks base method.
+func (m *MockStatusRepository) GetAllByBoardId(ctx context.Context, boardId int) ([]*entities.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByBoardId", ctx, boardId)
+	ret0, _ := ret[0].([]*entities.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByBoardId indicates an expected call of GetAllByBoardId.
+func (mr *MockStatusRepositoryMockRecorder) GetAllByBoardId(ctx, boardId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByBoardId", reflect.TypeOf((*MockStatusRepository)(nil).GetAllByBoardId), ctx, boardId)
+}
+
+// GetAllByRoomId mocks base method.
+func (m *MockStatusRepository) GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByRoomId", ctx, roomId)
+	ret0, _ := ret[0].([]*entities.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByRoomId indicates an expected call of GetAllByRoomId.
+func (mr *MockStatusRepositoryMockRecorder) GetAllByRoomId(ctx, roomId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByRoomId", reflect.TypeOf((*MockStatusRepository)(nil).GetAllByRoomId), ctx, roomId)
+}
+
+// GetById mocks base method.
+func (m *MockStatusRepository) GetById(ctx context.Context, id int) (*entities.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*entities.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockStatusRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockStatusRepository)(nil).GetById), ctx, id)
+}
+
+// GetByIdForUpdate mocks base method.
+func (m *MockStatusRepository) GetByIdForUpdate(ctx context.Context, id int) (*entities.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIdForUpdate", ctx, id)
+	ret0, _ := ret[0].(*entities.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIdForUpdate indicates an expected call of GetByIdForUpdate.
+func (mr *MockStatusRepositoryMockRecorder) GetByIdForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIdForUpdate", reflect.TypeOf((*MockStatusRepository)(nil).GetByIdForUpdate), ctx, id)
+}
+
+// LockWorkflow mocks base method.
+func (m *MockStatusRepository) LockWorkflow(ctx context.Context, roomId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockWorkflow", ctx, roomId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockWorkflow indicates an expected call of LockWorkflow.
+func (mr *MockStatusRepositoryMockRecorder) LockWorkflow(ctx, roomId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockWorkflow", reflect.TypeOf((*MockStatusRepository)(nil).LockWorkflow), ctx, roomId)
+}
+
+// SetTransitions mocks base method.
+func (m *MockStatusRepository) SetTransitions(ctx context.Context, id int, toIds []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransitions", ctx, id, toIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTransitions indicates an expected call of SetTransitions.
+func (mr *MockStatusRepositoryMockRecorder) SetTransitions(ctx, id, toIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransitions", reflect.TypeOf((*MockStatusRepository)(nil).SetTransitions), ctx, id, toIds)
+}
+
+// Update mocks base method.
+func (m *MockStatusRepository) Update(ctx context.Context, status *entities.Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStatusRepositoryMockRecorder) Update(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStatusRepository)(nil).Update), ctx, status)
+}
+
+// MockStatusServicer is a mock of StatusServicer interface.
+type MockStatusServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockStatusServicerMockRecorder is the mock recorder for MockStatusServicer.
+type MockStatusServicerMockRecorder struct {
+	mock *MockStatusServicer
+}
+
+// NewMockStatusServicer creates a new mock instance.
+func NewMockStatusServicer(ctrl *gomock.Controller) *MockStatusServicer {
+	mock := &MockStatusServicer{ctrl: ctrl}
+	mock.recorder = &MockStatusServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusServicer) EXPECT() *MockStatusServicerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStatusServicer) Create(ctx context.Context, roomId int, name, category string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, roomId, name, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStatusServicerMockRecorder) Create(ctx, roomId, name, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStatusServicer)(nil).Create), ctx, roomId, name, category)
+}
+
+// Delete mocks base method.
+func (m *MockStatusServicer) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStatusServicerMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStatusServicer)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockStatusServicer) GetAll(ctx context.Context, roomId int) ([]*entities.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, roomId)
+	ret0, _ := ret[0].([]*entities.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockStatusServicerMockRecorder) GetAll(ctx, roomId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockStatusServicer)(nil).GetAll), ctx, roomId)
+}
+
+// SetTransitions mocks base method.
+func (m *MockStatusServicer) SetTransitions(ctx context.Context, id int, toIds []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransitions", ctx, id, toIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTransitions indicates an expected call of SetTransitions.
+func (mr *MockStatusServicerMockRecorder) SetTransitions(ctx, id, toIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransitions", reflect.TypeOf((*MockStatusServicer)(nil).SetTransitions), ctx, id, toIds)
+}
+
+// Update mocks base method.
+func (m *MockStatusServicer) Update(ctx context.Context, id int, name, category string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, name, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStatusServicerMockRecorder) Update(ctx, id, name, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStatusServicer)(nil).Update), ctx, id, name, category)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/sync.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/sync.go -destination=./internal/interfaces/mock/sync.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSyncRepository is a mock of SyncRepository interface.
+type MockSyncRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSyncRepositoryMockRecorder is the mock recorder for MockSyncRepository.
+type MockSyncRepositoryMockRecorder struct {
+	mock *MockSyncRepository
+}
+
+// NewMockSyncRepository creates a new mock instance.
+func NewMockSyncRepository(ctrl *gomock.Controller) *MockSyncRepository {
+	mock := &MockSyncRepository{ctrl: ctrl}
+	mock.recorder = &MockSyncRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncRepository) EXPECT() *MockSyncRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimVersion mocks base method.
+func (m *MockSyncRepository) ClaimVersion(ctx context.Context, entityType string, id int, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimVersion", ctx, entityType, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimVersion indicates an expected call of ClaimVersion.
+func (mr *MockSyncRepositoryMockRecorder) ClaimVersion(ctx, entityType, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimVersion", reflect.TypeOf((*MockSyncRepository)(nil).ClaimVersion), ctx, entityType, id, version)
+}
+
+// GetChanges mocks base method.
+func (m *MockSyncRepository) GetChanges(ctx context.Context, afterSeq int64, limit int) ([]*entities.SyncChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChanges", ctx, afterSeq, limit)
+	ret0, _ := ret[0].([]*entities.SyncChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChanges indicates an expected call of GetChanges.
+func (mr *MockSyncRepositoryMockRecorder) GetChanges(ctx, afterSeq, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChanges", reflect.TypeOf((*MockSyncRepository)(nil).GetChanges), ctx, afterSeq, limit)
+}
+
+// GetVersions mocks base method.
+func (m *MockSyncRepository) GetVersions(ctx context.Context, entityType string, ids []int) (map[int]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersions", ctx, entityType, ids)
+	ret0, _ := ret[0].(map[int]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersions indicates an expected call of GetVersions.
+func (mr *MockSyncRepositoryMockRecorder) GetVersions(ctx, entityType, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockSyncRepository)(nil).GetVersions), ctx, entityType, ids)
+}
+
+// MockSyncServicer is a mock of SyncServicer interface.
+type MockSyncServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockSyncServicerMockRecorder is the mock recorder for MockSyncServicer.
+type MockSyncServicerMockRecorder struct {
+	mock *MockSyncServicer
+}
+
+// NewMockSyncServicer creates a new mock instance.
+func NewMockSyncServicer(ctrl *gomock.Controller) *MockSyncServicer {
+	mock := &MockSyncServicer{ctrl: ctrl}
+	mock.recorder = &MockSyncServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncServicer) EXPECT() *MockSyncServicerMockRecorder {
+	return m.recorder
+}
+
+// Pull mocks base method.
+func (m *MockSyncServicer) Pull(ctx context.Context, token string) (*entities.SyncPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pull", ctx, token)
+	ret0, _ := ret[0].(*entities.SyncPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pull indicates an expected call of Pull.
+func (mr *MockSyncServicerMockRecorder) Pull(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pull", reflect.TypeOf((*MockSyncServicer)(nil).Pull), ctx, token)
+}
+
+// Push mocks base method.
+func (m *MockSyncServicer) Push(ctx context.Context, mutations []*entities.SyncMutation) ([]*entities.SyncResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Push", ctx, mutations)
+	ret0, _ := ret[0].([]*entities.SyncResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Push indicates an expected call of Push.
+func (mr *MockSyncServicerMockRecorder) Push(ctx, mutations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockSyncServicer)(nil).Push), ctx, mutations)
+}
@@ -10,10 +10,12 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
 	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	interfaces "github.com/rm-ryou/sample_todo_app/internal/interfaces"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,61 +43,206 @@ func (m *MockTodoRepository) EXPECT() *MockTodoRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Copy mocks base method.
+func (m *MockTodoRepository) Copy(ctx context.Context, sourceId int, todo *entities.Todo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", ctx, sourceId, todo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockTodoRepositoryMockRecorder) Copy(ctx, sourceId, todo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockTodoRepository)(nil).Copy), ctx, sourceId, todo)
+}
+
 // Create mocks base method.
-func (m *MockTodoRepository) Create(todo *entities.Todo) error {
+func (m *MockTodoRepository) Create(ctx context.Context, todo *entities.Todo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", todo)
+	ret := m.ctrl.Call(m, "Create", ctx, todo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTodoRepositoryMockRecorder) Create(todo any) *gomock.Call {
+func (mr *MockTodoRepositoryMockRecorder) Create(ctx, todo any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTodoRepository)(nil).Create), todo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTodoRepository)(nil).Create), ctx, todo)
 }
 
 // Delete mocks base method.
-func (m *MockTodoRepository) Delete(id int) error {
+func (m *MockTodoRepository) Delete(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockTodoRepositoryMockRecorder) Delete(id any) *gomock.Call {
+func (mr *MockTodoRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTodoRepository)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTodoRepository)(nil).Delete), ctx, id)
+}
+
+// GetAllByBoardId mocks base method.
+func (m *MockTodoRepository) GetAllByBoardId(ctx context.Context, boardId int) ([]*entities.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByBoardId", ctx, boardId)
+	ret0, _ := ret[0].([]*entities.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByBoardId indicates an expected call of GetAllByBoardId.
+func (mr *MockTodoRepositoryMockRecorder) GetAllByBoardId(ctx, boardId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByBoardId", reflect.TypeOf((*MockTodoRepository)(nil).GetAllByBoardId), ctx, boardId)
 }
 
 // GetById mocks base method.
-func (m *MockTodoRepository) GetById(id int) (*entities.Todo, error) {
+func (m *MockTodoRepository) GetById(ctx context.Context, id int) (*entities.Todo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", id)
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
 	ret0, _ := ret[0].(*entities.Todo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockTodoRepositoryMockRecorder) GetById(id any) *gomock.Call {
+func (mr *MockTodoRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockTodoRepository)(nil).GetById), ctx, id)
+}
+
+// GetByIdForUpdate mocks base method.
+func (m *MockTodoRepository) GetByIdForUpdate(ctx context.Context, id int) (*entities.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIdForUpdate", ctx, id)
+	ret0, _ := ret[0].(*entities.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIdForUpdate indicates an expected call of GetByIdForUpdate.
+func (mr *MockTodoRepositoryMockRecorder) GetByIdForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIdForUpdate", reflect.TypeOf((*MockTodoRepository)(nil).GetByIdForUpdate), ctx, id)
+}
+
+// GetLastRank mocks base method.
+func (m *MockTodoRepository) GetLastRank(ctx context.Context, boardId int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastRank", ctx, boardId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastRank indicates an expected call of GetLastRank.
+func (mr *MockTodoRepositoryMockRecorder) GetLastRank(ctx, boardId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastRank", reflect.TypeOf((*MockTodoRepository)(nil).GetLastRank), ctx, boardId)
+}
+
+// GetNextRank mocks base method.
+func (m *MockTodoRepository) GetNextRank(ctx context.Context, boardId int, rank string, excludeId int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextRank", ctx, boardId, rank, excludeId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextRank indicates an expected call of GetNextRank.
+func (mr *MockTodoRepositoryMockRecorder) GetNextRank(ctx, boardId, rank, excludeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextRank", reflect.TypeOf((*MockTodoRepository)(nil).GetNextRank), ctx, boardId, rank, excludeId)
+}
+
+// GetPrevRank mocks base method.
+func (m *MockTodoRepository) GetPrevRank(ctx context.Context, boardId int, rank string, excludeId int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrevRank", ctx, boardId, rank, excludeId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrevRank indicates an expected call of GetPrevRank.
+func (mr *MockTodoRepositoryMockRecorder) GetPrevRank(ctx, boardId, rank, excludeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrevRank", reflect.TypeOf((*MockTodoRepository)(nil).GetPrevRank), ctx, boardId, rank, excludeId)
+}
+
+// LockBoard mocks base method.
+func (m *MockTodoRepository) LockBoard(ctx context.Context, boardId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockBoard", ctx, boardId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockBoard indicates an expected call of LockBoard.
+func (mr *MockTodoRepositoryMockRecorder) LockBoard(ctx, boardId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockBoard", reflect.TypeOf((*MockTodoRepository)(nil).LockBoard), ctx, boardId)
+}
+
+// MoveToBoard mocks base method.
+func (m *MockTodoRepository) MoveToBoard(ctx context.Context, todo *entities.Todo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToBoard", ctx, todo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToBoard indicates an expected call of MoveToBoard.
+func (mr *MockTodoRepositoryMockRecorder) MoveToBoard(ctx, todo any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockTodoRepository)(nil).GetById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToBoard", reflect.TypeOf((*MockTodoRepository)(nil).MoveToBoard), ctx, todo)
+}
+
+// Rebalance mocks base method.
+func (m *MockTodoRepository) Rebalance(ctx context.Context, boardId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebalance", ctx, boardId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rebalance indicates an expected call of Rebalance.
+func (mr *MockTodoRepositoryMockRecorder) Rebalance(ctx, boardId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebalance", reflect.TypeOf((*MockTodoRepository)(nil).Rebalance), ctx, boardId)
 }
 
 // Update mocks base method.
-func (m *MockTodoRepository) Update(todo *entities.Todo) error {
+func (m *MockTodoRepository) Update(ctx context.Context, todo *entities.Todo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", todo)
+	ret := m.ctrl.Call(m, "Update", ctx, todo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTodoRepositoryMockRecorder) Update(todo any) *gomock.Call {
+func (mr *MockTodoRepositoryMockRecorder) Update(ctx, todo any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoRepository)(nil).Update), todo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoRepository)(nil).Update), ctx, todo)
+}
+
+// UpdateRank mocks base method.
+func (m *MockTodoRepository) UpdateRank(ctx context.Context, id int, rank string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRank", ctx, id, rank)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRank indicates an expected call of UpdateRank.
+func (mr *MockTodoRepositoryMockRecorder) UpdateRank(ctx, id, rank any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRank", reflect.TypeOf((*MockTodoRepository)(nil).UpdateRank), ctx, id, rank)
 }
 
 // MockTodoServicer is a mock of TodoServicer interface.
@@ -122,59 +269,205 @@ func (m *MockTodoServicer) EXPECT() *MockTodoServicerMockRecorder {
 	return m.recorder
 }
 
+// Batch mocks base method.
+func (m *MockTodoServicer) Batch(ctx context.Context, ops []*entities.TodoBatchOp) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Batch", ctx, ops)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Batch indicates an expected call of Batch.
+func (mr *MockTodoServicerMockRecorder) Batch(ctx, ops any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Batch", reflect.TypeOf((*MockTodoServicer)(nil).Batch), ctx, ops)
+}
+
+// CopyToBoard mocks base method.
+func (m *MockTodoServicer) CopyToBoard(ctx context.Context, boardId, id, targetBoardId int) (*entities.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyToBoard", ctx, boardId, id, targetBoardId)
+	ret0, _ := ret[0].(*entities.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyToBoard indicates an expected call of CopyToBoard.
+func (mr *MockTodoServicerMockRecorder) CopyToBoard(ctx, boardId, id, targetBoardId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyToBoard", reflect.TypeOf((*MockTodoServicer)(nil).CopyToBoard), ctx, boardId, id, targetBoardId)
+}
+
 // Create mocks base method.
-func (m *MockTodoServicer) Create(boardId int, title string, done bool, priority int, dueDate *time.Time) error {
+func (m *MockTodoServicer) Create(ctx context.Context, boardId int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) (*entities.Todo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", boardId, title, done, priority, dueDate)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Create", ctx, boardId, title, done, statusId, priority, dueDate, recurrence)
+	ret0, _ := ret[0].(*entities.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTodoServicerMockRecorder) Create(boardId, title, done, priority, dueDate any) *gomock.Call {
+func (mr *MockTodoServicerMockRecorder) Create(ctx, boardId, title, done, statusId, priority, dueDate, recurrence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTodoServicer)(nil).Create), ctx, boardId, title, done, statusId, priority, dueDate, recurrence)
+}
+
+// CreateIn mocks base method.
+func (m *MockTodoServicer) CreateIn(ctx context.Context, repos *interfaces.Repositories, boardId int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) (*entities.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIn", ctx, repos, boardId, title, done, statusId, priority, dueDate, recurrence)
+	ret0, _ := ret[0].(*entities.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIn indicates an expected call of CreateIn.
+func (mr *MockTodoServicerMockRecorder) CreateIn(ctx, repos, boardId, title, done, statusId, priority, dueDate, recurrence any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTodoServicer)(nil).Create), boardId, title, done, priority, dueDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIn", reflect.TypeOf((*MockTodoServicer)(nil).CreateIn), ctx, repos, boardId, title, done, statusId, priority, dueDate, recurrence)
 }
 
 // Delete mocks base method.
-func (m *MockTodoServicer) Delete(id int) error {
+func (m *MockTodoServicer) Delete(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockTodoServicerMockRecorder) Delete(id any) *gomock.Call {
+func (mr *MockTodoServicerMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTodoServicer)(nil).Delete), ctx, id)
+}
+
+// DeleteIn mocks base method.
+func (m *MockTodoServicer) DeleteIn(ctx context.Context, repos *interfaces.Repositories, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIn", ctx, repos, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIn indicates an expected call of DeleteIn.
+func (mr *MockTodoServicerMockRecorder) DeleteIn(ctx, repos, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIn", reflect.TypeOf((*MockTodoServicer)(nil).DeleteIn), ctx, repos, id)
+}
+
+// GetAll mocks base method.
+func (m *MockTodoServicer) GetAll(ctx context.Context, boardId int) ([]*entities.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, boardId)
+	ret0, _ := ret[0].([]*entities.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTodoServicerMockRecorder) GetAll(ctx, boardId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTodoServicer)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTodoServicer)(nil).GetAll), ctx, boardId)
 }
 
 // GetById mocks base method.
-func (m *MockTodoServicer) GetById(id int) (*entities.Todo, error) {
+func (m *MockTodoServicer) GetById(ctx context.Context, id int) (*entities.Todo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", id)
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
 	ret0, _ := ret[0].(*entities.Todo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockTodoServicerMockRecorder) GetById(id any) *gomock.Call {
+func (mr *MockTodoServicerMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockTodoServicer)(nil).GetById), ctx, id)
+}
+
+// GetRevisions mocks base method.
+func (m *MockTodoServicer) GetRevisions(ctx context.Context, boardId, id int) ([]*entities.TodoRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, boardId, id)
+	ret0, _ := ret[0].([]*entities.TodoRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockTodoServicerMockRecorder) GetRevisions(ctx, boardId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockTodoServicer)(nil).GetRevisions), ctx, boardId, id)
+}
+
+// Move mocks base method.
+func (m *MockTodoServicer) Move(ctx context.Context, boardId, id int, beforeId, afterId *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, boardId, id, beforeId, afterId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockTodoServicerMockRecorder) Move(ctx, boardId, id, beforeId, afterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockTodoServicer)(nil).Move), ctx, boardId, id, beforeId, afterId)
+}
+
+// MoveToBoard mocks base method.
+func (m *MockTodoServicer) MoveToBoard(ctx context.Context, boardId, id, targetBoardId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToBoard", ctx, boardId, id, targetBoardId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToBoard indicates an expected call of MoveToBoard.
+func (mr *MockTodoServicerMockRecorder) MoveToBoard(ctx, boardId, id, targetBoardId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockTodoServicer)(nil).GetById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToBoard", reflect.TypeOf((*MockTodoServicer)(nil).MoveToBoard), ctx, boardId, id, targetBoardId)
+}
+
+// Revert mocks base method.
+func (m *MockTodoServicer) Revert(ctx context.Context, boardId, id, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revert", ctx, boardId, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revert indicates an expected call of Revert.
+func (mr *MockTodoServicerMockRecorder) Revert(ctx, boardId, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revert", reflect.TypeOf((*MockTodoServicer)(nil).Revert), ctx, boardId, id, version)
 }
 
 // Update mocks base method.
-func (m *MockTodoServicer) Update(id int, title string, done bool, priority int, dueDate *time.Time) error {
+func (m *MockTodoServicer) Update(ctx context.Context, id int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", id, title, done, priority, dueDate)
+	ret := m.ctrl.Call(m, "Update", ctx, id, title, done, statusId, priority, dueDate, recurrence)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTodoServicerMockRecorder) Update(id, title, done, priority, dueDate any) *gomock.Call {
+func (mr *MockTodoServicerMockRecorder) Update(ctx, id, title, done, statusId, priority, dueDate, recurrence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoServicer)(nil).Update), ctx, id, title, done, statusId, priority, dueDate, recurrence)
+}
+
+// UpdateIn mocks base method.
+func (m *MockTodoServicer) UpdateIn(ctx context.Context, repos *interfaces.Repositories, id int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIn", ctx, repos, id, title, done, statusId, priority, dueDate, recurrence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIn indicates an expected call of UpdateIn.
+func (mr *MockTodoServicerMockRecorder) UpdateIn(ctx, repos, id, title, done, statusId, priority, dueDate, recurrence any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoServicer)(nil).Update), id, title, done, priority, dueDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIn", reflect.TypeOf((*MockTodoServicer)(nil).UpdateIn), ctx, repos, id, title, done, statusId, priority, dueDate, recurrence)
 }
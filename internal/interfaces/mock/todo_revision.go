@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/todo_revision.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/todo_revision.go -destination=./internal/interfaces/mock/todo_revision.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTodoRevisionRepository is a mock of TodoRevisionRepository interface.
+type MockTodoRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTodoRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTodoRevisionRepositoryMockRecorder is the mock recorder for MockTodoRevisionRepository.
+type MockTodoRevisionRepositoryMockRecorder struct {
+	mock *MockTodoRevisionRepository
+}
+
+// NewMockTodoRevisionRepository creates a new mock instance.
+func NewMockTodoRevisionRepository(ctrl *gomock.Controller) *MockTodoRevisionRepository {
+	mock := &MockTodoRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockTodoRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTodoRevisionRepository) EXPECT() *MockTodoRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTodoRevisionRepository) Create(ctx context.Context, revision *entities.TodoRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTodoRevisionRepositoryMockRecorder) Create(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTodoRevisionRepository)(nil).Create), ctx, revision)
+}
+
+// GetAllByTodoId mocks base method.
+func (m *MockTodoRevisionRepository) GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.TodoRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByTodoId", ctx, todoId)
+	ret0, _ := ret[0].([]*entities.TodoRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByTodoId indicates an expected call of GetAllByTodoId.
+func (mr *MockTodoRevisionRepositoryMockRecorder) GetAllByTodoId(ctx, todoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByTodoId", reflect.TypeOf((*MockTodoRevisionRepository)(nil).GetAllByTodoId), ctx, todoId)
+}
+
+// GetByVersion mocks base method.
+func (m *MockTodoRevisionRepository) GetByVersion(ctx context.Context, todoId, version int) (*entities.TodoRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByVersion", ctx, todoId, version)
+	ret0, _ := ret[0].(*entities.TodoRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByVersion indicates an expected call of GetByVersion.
+func (mr *MockTodoRevisionRepositoryMockRecorder) GetByVersion(ctx, todoId, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByVersion", reflect.TypeOf((*MockTodoRevisionRepository)(nil).GetByVersion), ctx, todoId, version)
+}
@@ -0,0 +1,241 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/interfaces/trash.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/interfaces/trash.go -destination=./internal/interfaces/mock/trash.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/rm-ryou/sample_todo_app/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTrashRepository is a mock of TrashRepository interface.
+type MockTrashRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTrashRepositoryMockRecorder is the mock recorder for MockTrashRepository.
+type MockTrashRepositoryMockRecorder struct {
+	mock *MockTrashRepository
+}
+
+// NewMockTrashRepository creates a new mock instance.
+func NewMockTrashRepository(ctrl *gomock.Controller) *MockTrashRepository {
+	mock := &MockTrashRepository{ctrl: ctrl}
+	mock.recorder = &MockTrashRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashRepository) EXPECT() *MockTrashRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockTrashRepository) GetAll(ctx context.Context) (*entities.Trash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(*entities.Trash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTrashRepositoryMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTrashRepository)(nil).GetAll), ctx)
+}
+
+// GetBoardById mocks base method.
+func (m *MockTrashRepository) GetBoardById(ctx context.Context, id int) (*entities.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardById", ctx, id)
+	ret0, _ := ret[0].(*entities.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardById indicates an expected call of GetBoardById.
+func (mr *MockTrashRepositoryMockRecorder) GetBoardById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardById", reflect.TypeOf((*MockTrashRepository)(nil).GetBoardById), ctx, id)
+}
+
+// GetRoomById mocks base method.
+func (m *MockTrashRepository) GetRoomById(ctx context.Context, id int) (*entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomById", ctx, id)
+	ret0, _ := ret[0].(*entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomById indicates an expected call of GetRoomById.
+func (mr *MockTrashRepositoryMockRecorder) GetRoomById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomById", reflect.TypeOf((*MockTrashRepository)(nil).GetRoomById), ctx, id)
+}
+
+// GetTodoById mocks base method.
+func (m *MockTrashRepository) GetTodoById(ctx context.Context, id int) (*entities.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodoById", ctx, id)
+	ret0, _ := ret[0].(*entities.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodoById indicates an expected call of GetTodoById.
+func (mr *MockTrashRepositoryMockRecorder) GetTodoById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodoById", reflect.TypeOf((*MockTrashRepository)(nil).GetTodoById), ctx, id)
+}
+
+// Purge mocks base method.
+func (m *MockTrashRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTrashRepositoryMockRecorder) Purge(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTrashRepository)(nil).Purge), ctx, before)
+}
+
+// RestoreBoard mocks base method.
+func (m *MockTrashRepository) RestoreBoard(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBoard", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreBoard indicates an expected call of RestoreBoard.
+func (mr *MockTrashRepositoryMockRecorder) RestoreBoard(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBoard", reflect.TypeOf((*MockTrashRepository)(nil).RestoreBoard), ctx, id)
+}
+
+// RestoreRoom mocks base method.
+func (m *MockTrashRepository) RestoreRoom(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRoom", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreRoom indicates an expected call of RestoreRoom.
+func (mr *MockTrashRepositoryMockRecorder) RestoreRoom(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRoom", reflect.TypeOf((*MockTrashRepository)(nil).RestoreRoom), ctx, id)
+}
+
+// RestoreTodo mocks base method.
+func (m *MockTrashRepository) RestoreTodo(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTodo", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreTodo indicates an expected call of RestoreTodo.
+func (mr *MockTrashRepositoryMockRecorder) RestoreTodo(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTodo", reflect.TypeOf((*MockTrashRepository)(nil).RestoreTodo), ctx, id)
+}
+
+// MockTrashServicer is a mock of TrashServicer interface.
+type MockTrashServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashServicerMockRecorder
+	isgomock struct{}
+}
+
+// MockTrashServicerMockRecorder is the mock recorder for MockTrashServicer.
+type MockTrashServicerMockRecorder struct {
+	mock *MockTrashServicer
+}
+
+// NewMockTrashServicer creates a new mock instance.
+func NewMockTrashServicer(ctrl *gomock.Controller) *MockTrashServicer {
+	mock := &MockTrashServicer{ctrl: ctrl}
+	mock.recorder = &MockTrashServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashServicer) EXPECT() *MockTrashServicerMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockTrashServicer) GetAll(ctx context.Context) (*entities.Trash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(*entities.Trash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTrashServicerMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTrashServicer)(nil).GetAll), ctx)
+}
+
+// RestoreBoard mocks base method.
+func (m *MockTrashServicer) RestoreBoard(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBoard", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreBoard indicates an expected call of RestoreBoard.
+func (mr *MockTrashServicerMockRecorder) RestoreBoard(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBoard", reflect.TypeOf((*MockTrashServicer)(nil).RestoreBoard), ctx, id)
+}
+
+// RestoreRoom mocks base method.
+func (m *MockTrashServicer) RestoreRoom(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRoom", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreRoom indicates an expected call of RestoreRoom.
+func (mr *MockTrashServicerMockRecorder) RestoreRoom(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRoom", reflect.TypeOf((*MockTrashServicer)(nil).RestoreRoom), ctx, id)
+}
+
+// RestoreTodo mocks base method.
+func (m *MockTrashServicer) RestoreTodo(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTodo", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreTodo indicates an expected call of RestoreTodo.
+func (mr *MockTrashServicerMockRecorder) RestoreTodo(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTodo", reflect.TypeOf((*MockTrashServicer)(nil).RestoreTodo), ctx, id)
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type OutboxRepository interface {
+	Create(ctx context.Context, event *entities.OutboxEvent) error
+	GetAfter(ctx context.Context, afterId int64, limit int) ([]*entities.OutboxEvent, error)
+	Register(ctx context.Context, relayId string, now time.Time) (int64, error)
+	MarkDelivered(ctx context.Context, relayId string, lastId int64, now time.Time) error
+	Purge(ctx context.Context, staleBefore time.Time) (int, error)
+}
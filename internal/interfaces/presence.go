@@ -0,0 +1,9 @@
+package interfaces
+
+import "github.com/rm-ryou/sample_todo_app/internal/entities"
+
+type PresenceServicer interface {
+	Join(boardId int, actor string) *entities.PresenceSession
+	SetEditing(session *entities.PresenceSession, todoId *int)
+	Leave(session *entities.PresenceSession)
+}
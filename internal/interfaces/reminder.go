@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ReminderRepository interface {
+	GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.Reminder, error)
+	GetById(ctx context.Context, id int) (*entities.Reminder, error)
+	Create(ctx context.Context, reminder *entities.Reminder) error
+	Delete(ctx context.Context, id int) error
+	// ClaimDue locks unsent reminders due at now, skipping rows claimed by
+	// other replicas since staleBefore, and marks them claimed.
+	ClaimDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]*entities.DueReminder, error)
+	MarkSent(ctx context.Context, id int, sentAt time.Time) error
+	Release(ctx context.Context, id int) error
+}
+
+type ReminderServicer interface {
+	GetAll(ctx context.Context, boardId, todoId int) ([]*entities.Reminder, error)
+	Create(ctx context.Context, boardId, todoId int, remindAt *time.Time, offsetMinutes *int, channel string) error
+	Delete(ctx context.Context, boardId, todoId, id int) error
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, reminder *entities.DueReminder) error
+}
@@ -1,20 +1,43 @@
 package interfaces
 
 import (
+	"context"
+	"time"
+
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
 )
 
 type RoomRepository interface {
-	GetAll() ([]*entities.Room, error)
-	GetById(id int) (*entities.Room, error)
-	Create(room *entities.Room) error
-	Update(room *entities.Room) error
-	Delete(id int) error
+	GetAll(ctx context.Context, includeArchived bool) ([]*entities.Room, error)
+	GetById(ctx context.Context, id int) (*entities.Room, error)
+	Create(ctx context.Context, room *entities.Room) error
+	Update(ctx context.Context, room *entities.Room) error
+	UpdateArchivedAt(ctx context.Context, id int, archivedAt *time.Time) error
+	Delete(ctx context.Context, id int) error
+}
+
+type RoomMemberRepository interface {
+	GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.RoomMember, error)
+	// Create reports whether the member was added, false when the actor already joined.
+	Create(ctx context.Context, member *entities.RoomMember) (bool, error)
 }
 
 type RoomServicer interface {
-	GetAll() ([]*entities.Room, error)
-	Create(name string) error
-	Update(id int, name string) error
-	Delete(id int) error
+	GetAll(ctx context.Context, includeArchived bool) ([]*entities.Room, error)
+	GetById(ctx context.Context, id int) (*entities.Room, error)
+	Create(ctx context.Context, name string) (*entities.Room, error)
+	Update(ctx context.Context, id int, name string) error
+	// UpdateIn updates the room like Update, in the unit of work repos belong to.
+	UpdateIn(ctx context.Context, repos *Repositories, id int, name string) error
+	Archive(ctx context.Context, id int) error
+	Unarchive(ctx context.Context, id int) error
+	Delete(ctx context.Context, id int) error
+	// DeleteIn deletes the room like Delete, in the unit of work repos belong to.
+	DeleteIn(ctx context.Context, repos *Repositories, id int) error
+}
+
+type RoomMemberServicer interface {
+	GetAll(ctx context.Context, roomId int) ([]*entities.RoomMember, error)
+	// Join adds the actor of ctx to the members of the room. Joining twice keeps the first time.
+	Join(ctx context.Context, roomId int) (*entities.RoomMember, error)
 }
@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type StatusRepository interface {
+	GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.Status, error)
+	GetAllByBoardId(ctx context.Context, boardId int) ([]*entities.Status, error)
+	GetById(ctx context.Context, id int) (*entities.Status, error)
+	GetByIdForUpdate(ctx context.Context, id int) (*entities.Status, error)
+	LockWorkflow(ctx context.Context, roomId int) error
+	CountTodos(ctx context.Context, id int) (int, error)
+	Create(ctx context.Context, status *entities.Status) error
+	Update(ctx context.Context, status *entities.Status) error
+	Delete(ctx context.Context, id int) error
+	SetTransitions(ctx context.Context, id int, toIds []int) error
+}
+
+type StatusServicer interface {
+	GetAll(ctx context.Context, roomId int) ([]*entities.Status, error)
+	Create(ctx context.Context, roomId int, name, category string) error
+	Update(ctx context.Context, id int, name, category string) error
+	Delete(ctx context.Context, id int) error
+	SetTransitions(ctx context.Context, id int, toIds []int) error
+}
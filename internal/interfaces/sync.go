@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type SyncRepository interface {
+	// GetChanges returns the rooms, boards and todos written after afterSeq, the purged ones
+	// included, in the order of their change_seq and with Entity set to those still live. It
+	// returns about limit of them, never splitting those written with the same change_seq
+	// across two pages.
+	GetChanges(ctx context.Context, afterSeq int64, limit int) ([]*entities.SyncChange, error)
+	// GetVersions maps the ids of entities of entityType to their change_seq, trashed and
+	// purged entities included.
+	GetVersions(ctx context.Context, entityType string, ids []int) (map[int]int64, error)
+	// ClaimVersion moves the live entity on to a new change_seq if it is still at version,
+	// locking it until the transaction ends, and returns ErrSyncConflict otherwise.
+	ClaimVersion(ctx context.Context, entityType string, id int, version int64) error
+}
+
+type SyncServicer interface {
+	Pull(ctx context.Context, token string) (*entities.SyncPage, error)
+	Push(ctx context.Context, mutations []*entities.SyncMutation) ([]*entities.SyncResult, error)
+}
@@ -1,21 +1,46 @@
 package interfaces
 
 import (
+	"context"
 	"time"
 
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
 )
 
 type TodoRepository interface {
-	GetById(id int) (*entities.Todo, error)
-	Create(todo *entities.Todo) error
-	Update(todo *entities.Todo) error
-	Delete(id int) error
+	GetAllByBoardId(ctx context.Context, boardId int) ([]*entities.Todo, error)
+	GetById(ctx context.Context, id int) (*entities.Todo, error)
+	GetByIdForUpdate(ctx context.Context, id int) (*entities.Todo, error)
+	LockBoard(ctx context.Context, boardId int) error
+	GetLastRank(ctx context.Context, boardId int) (string, error)
+	GetNextRank(ctx context.Context, boardId int, rank string, excludeId int) (string, error)
+	GetPrevRank(ctx context.Context, boardId int, rank string, excludeId int) (string, error)
+	Create(ctx context.Context, todo *entities.Todo) error
+	Update(ctx context.Context, todo *entities.Todo) error
+	UpdateRank(ctx context.Context, id int, rank string) error
+	Rebalance(ctx context.Context, boardId int) error
+	MoveToBoard(ctx context.Context, todo *entities.Todo) error
+	Copy(ctx context.Context, sourceId int, todo *entities.Todo) error
+	Delete(ctx context.Context, id int) error
 }
 
 type TodoServicer interface {
-	GetById(id int) (*entities.Todo, error)
-	Create(boardId int, title string, done bool, priority int, dueDate *time.Time) error
-	Update(id int, title string, done bool, priority int, dueDate *time.Time) error
-	Delete(id int) error
+	GetAll(ctx context.Context, boardId int) ([]*entities.Todo, error)
+	GetById(ctx context.Context, id int) (*entities.Todo, error)
+	Create(ctx context.Context, boardId int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) (*entities.Todo, error)
+	// CreateIn creates the todo like Create, in the unit of work repos belong to, for callers
+	// that write more along with it.
+	CreateIn(ctx context.Context, repos *Repositories, boardId int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) (*entities.Todo, error)
+	Update(ctx context.Context, id int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) error
+	// UpdateIn updates the todo like Update, in the unit of work repos belong to.
+	UpdateIn(ctx context.Context, repos *Repositories, id int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) error
+	Move(ctx context.Context, boardId, id int, beforeId, afterId *int) error
+	MoveToBoard(ctx context.Context, boardId, id, targetBoardId int) error
+	CopyToBoard(ctx context.Context, boardId, id, targetBoardId int) (*entities.Todo, error)
+	Batch(ctx context.Context, ops []*entities.TodoBatchOp) ([]error, error)
+	GetRevisions(ctx context.Context, boardId, id int) ([]*entities.TodoRevision, error)
+	Revert(ctx context.Context, boardId, id, version int) error
+	Delete(ctx context.Context, id int) error
+	// DeleteIn deletes the todo like Delete, in the unit of work repos belong to.
+	DeleteIn(ctx context.Context, repos *Repositories, id int) error
 }
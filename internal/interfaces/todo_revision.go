@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type TodoRevisionRepository interface {
+	GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.TodoRevision, error)
+	GetByVersion(ctx context.Context, todoId, version int) (*entities.TodoRevision, error)
+	// Create stores the revision as the next version of its todo.
+	Create(ctx context.Context, revision *entities.TodoRevision) error
+}
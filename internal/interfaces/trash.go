@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type TrashRepository interface {
+	GetAll(ctx context.Context) (*entities.Trash, error)
+	GetRoomById(ctx context.Context, id int) (*entities.Room, error)
+	GetBoardById(ctx context.Context, id int) (*entities.Board, error)
+	GetTodoById(ctx context.Context, id int) (*entities.Todo, error)
+	RestoreRoom(ctx context.Context, id int) error
+	RestoreBoard(ctx context.Context, id int) error
+	RestoreTodo(ctx context.Context, id int) error
+	// Purge permanently deletes everything trashed before the given time.
+	Purge(ctx context.Context, before time.Time) (int, error)
+}
+
+type TrashServicer interface {
+	GetAll(ctx context.Context) (*entities.Trash, error)
+	RestoreRoom(ctx context.Context, id int) error
+	RestoreBoard(ctx context.Context, id int) error
+	RestoreTodo(ctx context.Context, id int) error
+}
@@ -0,0 +1,30 @@
+package interfaces
+
+import "context"
+
+// Repositories are bound to the transaction of the UnitOfWork.Do call that handed them out.
+type Repositories struct {
+	Rooms            RoomRepository
+	Boards           BoardRepository
+	Statuses         StatusRepository
+	Todos            TodoRepository
+	Revisions        TodoRevisionRepository
+	Activities       ActivityRepository
+	Checklists       ChecklistRepository
+	Dependencies     DependencyRepository
+	Reminders        ReminderRepository
+	Trash            TrashRepository
+	Audits           AuditRepository
+	Outbox           OutboxRepository
+	Webhooks         WebhookRepository
+	IncomingWebhooks IncomingWebhookRepository
+	Sync             SyncRepository
+	Comments         CommentRepository
+	Members          RoomMemberRepository
+}
+
+type UnitOfWork interface {
+	// Do runs fn in a single transaction, committing when fn returns nil and rolling
+	// back otherwise. fn may be run again when the transaction is chosen as a deadlock victim.
+	Do(ctx context.Context, fn func(repos *Repositories) error) error
+}
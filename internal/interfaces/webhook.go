@@ -0,0 +1,45 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type WebhookRepository interface {
+	GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.Webhook, error)
+	GetById(ctx context.Context, id int) (*entities.Webhook, error)
+	Create(ctx context.Context, webhook *entities.Webhook) error
+	Update(ctx context.Context, webhook *entities.Webhook) error
+	Delete(ctx context.Context, id int) error
+	// Enqueue queues event for every enabled webhook of its room that subscribes to its type.
+	Enqueue(ctx context.Context, event *entities.OutboxEvent) error
+	// CreateDelivery stores a delivery that is claimed at claimedAt by the caller, who sends it.
+	CreateDelivery(ctx context.Context, delivery *entities.WebhookDelivery, claimedAt time.Time) error
+	GetDeliveries(ctx context.Context, filter *entities.WebhookDeliveryFilter) ([]*entities.WebhookDelivery, error)
+	// ClaimDue locks deliveries of enabled webhooks due at now, skipping rows claimed by
+	// other replicas since staleBefore, and marks them claimed.
+	ClaimDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]*entities.DueWebhookDelivery, error)
+	// RecordAttempt saves the outcome of the delivery's last attempt and releases its claim.
+	RecordAttempt(ctx context.Context, delivery *entities.WebhookDelivery) error
+	// RecordResult resets the webhook's failures after a success, or counts a failure and
+	// disables the webhook once disableAfter of them come in a row.
+	RecordResult(ctx context.Context, webhookId int, succeeded bool, disableAfter int, now time.Time) error
+}
+
+type WebhookServicer interface {
+	GetAll(ctx context.Context, roomId int) ([]*entities.Webhook, error)
+	GetById(ctx context.Context, roomId, id int) (*entities.Webhook, error)
+	Create(ctx context.Context, roomId int, url, secret string, eventTypes []string) (*entities.Webhook, error)
+	Update(ctx context.Context, roomId, id int, url, secret string, eventTypes []string, enabled bool) (*entities.Webhook, error)
+	Delete(ctx context.Context, roomId, id int) error
+	GetDeliveries(ctx context.Context, roomId int, filter *entities.WebhookDeliveryFilter) ([]*entities.WebhookDelivery, error)
+	// SendTest posts a webhook.test event right away, without retries, and returns how it went.
+	SendTest(ctx context.Context, roomId, id int) (*entities.WebhookDelivery, error)
+}
+
+type WebhookSender interface {
+	// Send posts the delivery and returns the response status, zero when there was none.
+	Send(ctx context.Context, delivery *entities.DueWebhookDelivery) (int, error)
+}
@@ -0,0 +1,20 @@
+package notifiers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (ln *LogNotifier) Notify(ctx context.Context, reminder *entities.DueReminder) error {
+	log.Printf("reminder %d: todo %d %q is due at %s", reminder.Id, reminder.TodoId, reminder.TodoTitle, reminder.FireAt.Format(time.RFC3339))
+	return nil
+}
@@ -0,0 +1,65 @@
+package notifiers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+type webhookPayload struct {
+	ReminderId int       `json:"reminder_id"`
+	TodoId     int       `json:"todo_id"`
+	Title      string    `json:"title"`
+	FireAt     time.Time `json:"fire_at"`
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (wn *WebhookNotifier) Notify(ctx context.Context, reminder *entities.DueReminder) error {
+	if wn.url == "" {
+		return fmt.Errorf("webhook url is not configured")
+	}
+
+	body, err := json.Marshal(webhookPayload{
+		ReminderId: reminder.Id,
+		TodoId:     reminder.TodoId,
+		Title:      reminder.TodoTitle,
+		FireAt:     reminder.FireAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wn.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := wn.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", res.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package notifiers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+// deliveryPayload is the body posted for a delivery. Receivers get the todo of an event in
+// the shape the API returns todos in, and no data for a test delivery.
+type deliveryPayload struct {
+	Id        int           `json:"id"`
+	Event     string        `json:"event"`
+	EventId   int64         `json:"event_id,omitempty"`
+	RoomId    int           `json:"room_id"`
+	CreatedAt time.Time     `json:"created_at"`
+	Data      *deliveryTodo `json:"data"`
+}
+
+type deliveryTodo struct {
+	Id          int        `json:"id"`
+	Title       string     `json:"title"`
+	StatusId    int        `json:"status_id"`
+	Done        bool       `json:"done"`
+	Priority    int        `json:"priority"`
+	Rank        string     `json:"rank"`
+	BoardId     int        `json:"board_id"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+
+	Recurrence        *deliveryRecurrence       `json:"recurrence,omitempty"`
+	ChecklistProgress deliveryChecklistProgress `json:"checklist_progress"`
+	Blocked           bool                      `json:"blocked"`
+}
+
+type deliveryRecurrence struct {
+	Rule     string `json:"rule"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+type deliveryChecklistProgress struct {
+	Checked int `json:"checked"`
+	Total   int `json:"total"`
+}
+
+func newDeliveryPayload(delivery *entities.DueWebhookDelivery) (*deliveryPayload, error) {
+	data, err := entities.DecodeEventData(delivery.EventType, delivery.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &deliveryPayload{
+		Id:        delivery.Id,
+		Event:     delivery.EventType,
+		EventId:   delivery.EventId,
+		RoomId:    delivery.RoomId,
+		CreatedAt: delivery.CreatedAt,
+	}
+	if todo, ok := data.(*entities.Todo); ok {
+		payload.Data = newDeliveryTodo(todo)
+	}
+
+	return payload, nil
+}
+
+func newDeliveryTodo(todo *entities.Todo) *deliveryTodo {
+	res := &deliveryTodo{
+		Id:          todo.Id,
+		Title:       todo.Title,
+		StatusId:    todo.StatusId,
+		Done:        todo.Done,
+		Priority:    todo.Priority,
+		Rank:        todo.Rank,
+		BoardId:     todo.BoardId,
+		DueDate:     todo.DueDate,
+		CompletedAt: todo.CompletedAt,
+		CreatedAt:   todo.CreatedAt,
+		UpdatedAt:   todo.UpdatedAt,
+		DeletedAt:   todo.DeletedAt,
+
+		ChecklistProgress: deliveryChecklistProgress{
+			Checked: todo.ChecklistProgress.Checked,
+			Total:   todo.ChecklistProgress.Total,
+		},
+		Blocked: todo.Blocked,
+	}
+	if todo.Recurrence != nil {
+		res.Recurrence = &deliveryRecurrence{
+			Rule:     todo.Recurrence.Rule,
+			Timezone: todo.Recurrence.Timezone,
+		}
+	}
+
+	return res
+}
+
+// signPayload returns the X-Webhook-Signature of body sent at timestamp, the Unix seconds
+// of X-Webhook-Timestamp. Receivers recompute it over "<timestamp>.<body>" with the shared
+// secret to check that the payload comes from us unchanged, and reject old timestamps so that
+// a captured request cannot be replayed.
+func signPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,43 @@
+package notifiers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignPayload(t *testing.T) {
+	// Computed with: printf '1757149200.{"id":1}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=4db448314ec492bb5eda60584b8a39dec101bd2809d63de48b8cc7d3b946e868", signPayload("secret", 1757149200, []byte(`{"id":1}`)))
+	assert.NotEqual(t, signPayload("secret", 1757149200, []byte(`{"id":1}`)), signPayload("secret", 1757149201, []byte(`{"id":1}`)))
+}
+
+func TestNewDeliveryPayload(t *testing.T) {
+	createdAt := time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC)
+	todo := &entities.Todo{Id: 3, BoardId: 2, Title: "title", StatusId: 1, Rank: "V", Recurrence: &entities.Recurrence{Rule: "FREQ=DAILY"}}
+	event, err := entities.NewOutboxEvent(1, entities.EventTodoUpdated, todo)
+	assert.NoError(t, err)
+
+	payload, err := newDeliveryPayload(&entities.DueWebhookDelivery{
+		WebhookDelivery: entities.WebhookDelivery{Id: 5, EventId: 7, EventType: event.Type, Payload: event.Payload, CreatedAt: createdAt},
+		RoomId:          1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &deliveryPayload{
+		Id:        5,
+		Event:     entities.EventTodoUpdated,
+		EventId:   7,
+		RoomId:    1,
+		CreatedAt: createdAt,
+		Data:      &deliveryTodo{Id: 3, Title: "title", StatusId: 1, Rank: "V", BoardId: 2, Recurrence: &deliveryRecurrence{Rule: "FREQ=DAILY"}},
+	}, payload)
+
+	payload, err = newDeliveryPayload(&entities.DueWebhookDelivery{WebhookDelivery: entities.WebhookDelivery{Id: 6, EventType: entities.WebhookEventTest}, RoomId: 1})
+	assert.NoError(t, err)
+	assert.Nil(t, payload.Data)
+
+	_, err = newDeliveryPayload(&entities.DueWebhookDelivery{WebhookDelivery: entities.WebhookDelivery{Id: 6, EventType: "board.renamed"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package notifiers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+const (
+	webhookEventHeader     = "X-Webhook-Event"
+	webhookDeliveryHeader  = "X-Webhook-Delivery"
+	webhookSignatureHeader = "X-Webhook-Signature"
+	webhookTimestampHeader = "X-Webhook-Timestamp"
+)
+
+var errWebhookAddressNotPublic = errors.New("webhook address is not public")
+
+// WebhookSender posts the deliveries of room webhooks.
+type WebhookSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewWebhookSender(timeout time.Duration) *WebhookSender {
+	return &WebhookSender{
+		client: newWebhookClient(timeout, refuseNonPublicAddress),
+		now:    time.Now,
+	}
+}
+
+// newWebhookClient returns a client that checks every address it connects to with control,
+// so a webhook URL cannot reach into the private network, whatever its host resolves to.
+// Redirects are not followed, and no proxy is used since control would only see the proxy.
+func newWebhookClient(timeout time.Duration, control func(network, address string, c syscall.RawConn) error) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: control,
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// refuseNonPublicAddress refuses loopback, private, link-local and other addresses that are
+// not routable on the internet.
+func refuseNonPublicAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return fmt.Errorf("%w: %s", errWebhookAddressNotPublic, ip)
+	}
+
+	return nil
+}
+
+// Send signs the body and the time of the attempt with the webhook's secret. The delivery id
+// stays the same across retries, so receivers can drop deliveries they already handled. A
+// redirect counts as a failed attempt.
+func (ws *WebhookSender) Send(ctx context.Context, delivery *entities.DueWebhookDelivery) (int, error) {
+	payload, err := newDeliveryPayload(delivery)
+	if err != nil {
+		return 0, err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, delivery.EventType)
+	req.Header.Set(webhookDeliveryHeader, strconv.Itoa(delivery.Id))
+	timestamp := ws.now().Unix()
+	req.Header.Set(webhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(webhookSignatureHeader, signPayload(delivery.Secret, timestamp, body))
+
+	res, err := ws.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("webhook responded with %s", res.Status)
+	}
+
+	return res.StatusCode, nil
+}
@@ -0,0 +1,155 @@
+package notifiers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSend(t *testing.T) {
+	event, err := entities.NewOutboxEvent(1, entities.EventTodoUpdated, &entities.Todo{Id: 3, BoardId: 2, Title: "title", StatusId: 1, Rank: "V"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		status      int
+		expectError bool
+	}{
+		{
+			name:   "Success to send",
+			status: http.StatusNoContent,
+		},
+		{
+			name:        "Failed to send - Due to non 2xx response",
+			status:      http.StatusInternalServerError,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var received map[string]any
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, entities.EventTodoUpdated, r.Header.Get("X-Webhook-Event"))
+				assert.Equal(t, "5", r.Header.Get("X-Webhook-Delivery"))
+				assert.Equal(t, "1757149200", r.Header.Get("X-Webhook-Timestamp"))
+				assert.Equal(t, signPayload("secret", 1757149200, body), r.Header.Get("X-Webhook-Signature"))
+				require.NoError(t, json.Unmarshal(body, &received))
+				w.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+
+			delivery := &entities.DueWebhookDelivery{
+				WebhookDelivery: entities.WebhookDelivery{
+					Id:        5,
+					EventId:   7,
+					EventType: event.Type,
+					Payload:   event.Payload,
+					CreatedAt: time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC),
+				},
+				RoomId: 1,
+				URL:    srv.URL,
+				Secret: "secret",
+			}
+
+			// The test server listens on loopback, which the sender refuses.
+			sender := &WebhookSender{
+				client: newWebhookClient(time.Second, nil),
+				now:    func() time.Time { return time.Unix(1757149200, 0) },
+			}
+			status, err := sender.Send(context.Background(), delivery)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.status, status)
+			assert.Equal(t, float64(5), received["id"])
+			assert.Equal(t, entities.EventTodoUpdated, received["event"])
+			assert.Equal(t, float64(7), received["event_id"])
+			assert.Equal(t, float64(1), received["room_id"])
+			assert.Equal(t, "2025-09-06T09:00:00Z", received["created_at"])
+			data := received["data"].(map[string]any)
+			assert.Equal(t, float64(3), data["id"])
+			assert.Equal(t, float64(2), data["board_id"])
+			assert.Equal(t, "title", data["title"])
+		})
+	}
+}
+
+func TestWebhookSendRefused(t *testing.T) {
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer srv.Close()
+
+	delivery := &entities.DueWebhookDelivery{
+		WebhookDelivery: entities.WebhookDelivery{Id: 5, EventType: entities.WebhookEventTest},
+		RoomId:          1,
+		URL:             srv.URL,
+		Secret:          "secret",
+	}
+
+	t.Run("Failed to send - Due to loopback address", func(t *testing.T) {
+		status, err := NewWebhookSender(time.Second).Send(context.Background(), delivery)
+
+		assert.ErrorIs(t, err, errWebhookAddressNotPublic)
+		assert.Equal(t, 0, status)
+	})
+
+	t.Run("Failed to send - Due to redirect", func(t *testing.T) {
+		sender := &WebhookSender{client: newWebhookClient(time.Second, nil), now: time.Now}
+		status, err := sender.Send(context.Background(), delivery)
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusTemporaryRedirect, status)
+		assert.False(t, redirected)
+	})
+}
+
+func TestRefuseNonPublicAddress(t *testing.T) {
+	testCases := []struct {
+		address     string
+		expectError bool
+	}{
+		{address: "93.184.215.14:443", expectError: false},
+		{address: "[2606:2800:21f:cb07:6820:80da:af6b:8b2c]:443", expectError: false},
+		{address: "127.0.0.1:80", expectError: true},
+		{address: "[::1]:80", expectError: true},
+		{address: "10.0.0.1:80", expectError: true},
+		{address: "172.16.0.1:80", expectError: true},
+		{address: "192.168.1.1:80", expectError: true},
+		{address: "169.254.169.254:80", expectError: true},
+		{address: "[fe80::1]:80", expectError: true},
+		{address: "[fd00::1]:80", expectError: true},
+		{address: "[::ffff:127.0.0.1]:80", expectError: true},
+		{address: "0.0.0.0:80", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		err := refuseNonPublicAddress("tcp", tc.address, nil)
+
+		if tc.expectError {
+			assert.ErrorIs(t, err, errWebhookAddressNotPublic, tc.address)
+		} else {
+			assert.NoError(t, err, tc.address)
+		}
+	}
+}
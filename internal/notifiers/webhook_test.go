@@ -0,0 +1,84 @@
+package notifiers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotify(t *testing.T) {
+	reminder := &entities.DueReminder{
+		Reminder:  entities.Reminder{Id: 1, TodoId: 2},
+		TodoTitle: "title",
+		FireAt:    time.Date(2025, 6, 20, 9, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name        string
+		status      int
+		expectError bool
+	}{
+		{
+			name:   "Success to notify",
+			status: http.StatusNoContent,
+		},
+		{
+			name:        "Failed to notify - Due to non 2xx response",
+			status:      http.StatusInternalServerError,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var received map[string]any
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+				w.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+
+			err := NewWebhookNotifier(srv.URL).Notify(context.Background(), reminder)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, map[string]any{
+				"reminder_id": float64(1),
+				"todo_id":     float64(2),
+				"title":       "title",
+				"fire_at":     "2025-06-20T09:00:00Z",
+			}, received)
+		})
+	}
+}
+
+func TestWebhookNotifyWithoutURL(t *testing.T) {
+	err := NewWebhookNotifier("").Notify(context.Background(), &entities.DueReminder{})
+
+	assert.Error(t, err)
+}
+
+func TestWebhookNotifyCanceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := NewWebhookNotifier(srv.URL).Notify(ctx, &entities.DueReminder{})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
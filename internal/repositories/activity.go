@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ActivityRepository struct {
+	db dbtx
+}
+
+func NewActivityRepository(db *sql.DB) *ActivityRepository {
+	return &ActivityRepository{
+		db: db,
+	}
+}
+
+// Create stores the activity in the room of its board and sets its id and room id.
+// It returns sql.ErrNoRows when the board does not exist. Activities without board, as
+// members joining, are stored in their RoomId.
+func (ar *ActivityRepository) Create(ctx context.Context, activity *entities.Activity) error {
+	query := `INSERT INTO activities (room_id, board_id, todo_id, from_board_id, kind, actor, title)
+		SELECT room_id, id, ?, ?, ?, ?, ?
+		FROM boards
+		WHERE id = ?`
+	args := []any{activity.TodoId, activity.FromBoardId, activity.Kind, activity.Actor, activity.Title, activity.BoardId}
+	if activity.BoardId == 0 {
+		query = "INSERT INTO activities (room_id, kind, actor) VALUES (?, ?, ?)"
+		args = []any{activity.RoomId, activity.Kind, activity.Actor}
+	}
+
+	res, err := ar.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	activity.Id = int(id)
+	query = "SELECT room_id, created_at FROM activities WHERE id = ?"
+	return ar.db.QueryRowContext(ctx, query, id).Scan(&activity.RoomId, &activity.CreatedAt)
+}
+
+// GetAll returns the activity of filter.RoomId, newest first and at most filter.Limit of it.
+func (ar *ActivityRepository) GetAll(ctx context.Context, filter *entities.ActivityFilter) ([]*entities.Activity, error) {
+	query := `SELECT
+			id,
+			room_id,
+			board_id,
+			todo_id,
+			from_board_id,
+			kind,
+			actor,
+			title,
+			created_at
+		FROM
+			activities
+		WHERE room_id = ?`
+	args := []any{filter.RoomId}
+	if filter.BeforeId != 0 {
+		query += " AND id < ?"
+		args = append(args, filter.BeforeId)
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, filter.Limit)
+
+	rows, err := ar.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var activities []*entities.Activity
+	for rows.Next() {
+		var a entities.Activity
+		var boardId, todoId, fromBoardId sql.NullInt64
+		if err := rows.Scan(
+			&a.Id,
+			&a.RoomId,
+			&boardId,
+			&todoId,
+			&fromBoardId,
+			&a.Kind,
+			&a.Actor,
+			&a.Title,
+			&a.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		a.BoardId = int(boardId.Int64)
+		a.TodoId = int(todoId.Int64)
+		if fromBoardId.Valid {
+			id := int(fromBoardId.Int64)
+			a.FromBoardId = &id
+		}
+		activities = append(activities, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
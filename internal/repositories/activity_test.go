@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deleteAllActivities(t *testing.T) {
+	query := "DELETE FROM activities"
+	_, err := ActivityRepo.db.ExecContext(context.Background(), query)
+	require.NoError(t, err)
+}
+
+func deleteAllFeedTokens(t *testing.T) {
+	query := "DELETE FROM feed_tokens"
+	_, err := FeedTokenRepo.db.ExecContext(context.Background(), query)
+	require.NoError(t, err)
+}
+
+func TestActivities(t *testing.T) {
+	teardown := setupChecklistReferences(t)
+	defer teardown()
+	defer deleteAllActivities(t)
+
+	ctx := context.Background()
+	todo := &entities.Todo{Id: 1, BoardId: 1, Title: "referencedTodo"}
+	activities := []*entities.Activity{
+		entities.NewActivity(entities.ActivityTodoCreated, todo, "alice"),
+		entities.NewActivity(entities.ActivityTodoCompleted, todo, "bob"),
+	}
+	for _, activity := range activities {
+		require.NoError(t, ActivityRepo.Create(ctx, activity))
+		assert.NotZero(t, activity.Id)
+		assert.Equal(t, referencedBoardData.RoomId, activity.RoomId)
+	}
+
+	err := ActivityRepo.Create(ctx, entities.NewActivity(entities.ActivityTodoCreated, &entities.Todo{Id: 1, BoardId: 999}, ""))
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	got, err := ActivityRepo.GetAll(ctx, &entities.ActivityFilter{RoomId: referencedBoardData.RoomId, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, entities.ActivityTodoCompleted, got[0].Kind)
+	assert.Equal(t, "bob", got[0].Actor)
+	assert.Nil(t, got[0].FromBoardId)
+
+	got, err = ActivityRepo.GetAll(ctx, &entities.ActivityFilter{RoomId: referencedBoardData.RoomId, BeforeId: activities[1].Id, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, activities[0].Id, got[0].Id)
+
+	// Members joining have no board to take the room from.
+	joined := entities.NewMemberActivity(&entities.RoomMember{RoomId: referencedBoardData.RoomId, Actor: "carol"})
+	require.NoError(t, ActivityRepo.Create(ctx, joined))
+
+	got, err = ActivityRepo.GetAll(ctx, &entities.ActivityFilter{RoomId: referencedBoardData.RoomId, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, entities.ActivityMemberJoined, got[0].Kind)
+	assert.Equal(t, "carol", got[0].Actor)
+	assert.Zero(t, got[0].BoardId)
+	assert.Zero(t, got[0].TodoId)
+}
+
+func TestFeedTokens(t *testing.T) {
+	defer deleteAllFeedTokens(t)
+
+	ctx := context.Background()
+	_, first, err := entities.NewFeedToken("alice")
+	require.NoError(t, err)
+	require.NoError(t, FeedTokenRepo.Save(ctx, first))
+
+	_, second, err := entities.NewFeedToken("alice")
+	require.NoError(t, err)
+	require.NoError(t, FeedTokenRepo.Save(ctx, second))
+
+	_, err = FeedTokenRepo.GetByHash(ctx, first.TokenHash)
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	got, err := FeedTokenRepo.GetByHash(ctx, second.TokenHash)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", got.Actor)
+}
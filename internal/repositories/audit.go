@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type AuditRepository struct {
+	db dbtx
+}
+
+func NewAuditRepository(db *sql.DB) *AuditRepository {
+	return &AuditRepository{
+		db: db,
+	}
+}
+
+func (ar *AuditRepository) Create(ctx context.Context, event *entities.AuditEvent) error {
+	query := `INSERT INTO audit_events
+			(actor, entity_type, entity_id, action, ` + "`before`, `after`" + `, request_id, ip)
+		VALUES
+			(?, ?, ?, ?, ?, ?, ?, ?)`
+
+	res, err := ar.db.ExecContext(ctx, query,
+		event.Actor,
+		event.EntityType,
+		event.EntityId,
+		event.Action,
+		event.Before,
+		event.After,
+		event.RequestId,
+		event.IP,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	event.Id = int(id)
+
+	return nil
+}
+
+// GetAll returns the events matching filter, newest first and at most filter.Limit of them.
+func (ar *AuditRepository) GetAll(ctx context.Context, filter *entities.AuditFilter) ([]*entities.AuditEvent, error) {
+	var conditions []string
+	var args []any
+	if filter.Actor != "" {
+		conditions = append(conditions, "actor = ?")
+		args = append(args, filter.Actor)
+	}
+	if filter.EntityType != "" {
+		conditions = append(conditions, "entity_type = ?")
+		args = append(args, filter.EntityType)
+	}
+	if filter.EntityId != 0 {
+		conditions = append(conditions, "entity_id = ?")
+		args = append(args, filter.EntityId)
+	}
+	if filter.Action != "" {
+		conditions = append(conditions, "action = ?")
+		args = append(args, filter.Action)
+	}
+	if filter.Since != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, *filter.Since)
+	}
+	if filter.Until != nil {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, *filter.Until)
+	}
+	if filter.BeforeId != 0 {
+		conditions = append(conditions, "id < ?")
+		args = append(args, filter.BeforeId)
+	}
+
+	query := "SELECT id, actor, entity_type, entity_id, action, `before`, `after`, request_id, ip, created_at FROM audit_events"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, filter.Limit)
+
+	rows, err := ar.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*entities.AuditEvent
+	for rows.Next() {
+		var e entities.AuditEvent
+		var before, after []byte
+		if err := rows.Scan(
+			&e.Id,
+			&e.Actor,
+			&e.EntityType,
+			&e.EntityId,
+			&e.Action,
+			&before,
+			&after,
+			&e.RequestId,
+			&e.IP,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		e.Before, e.After = before, after
+		events = append(events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deleteAllAuditEvents(t *testing.T) {
+	query := "DELETE FROM audit_events"
+	_, err := AuditRepo.db.ExecContext(context.Background(), query)
+	require.NoError(t, err)
+}
+
+func TestAuditEvents(t *testing.T) {
+	defer deleteAllAuditEvents(t)
+
+	ctx := context.Background()
+	actor := entities.Actor{Name: "alice", RequestId: "req-1", IP: "192.0.2.1"}
+	events := []*entities.AuditEvent{
+		entities.NewAuditEvent(actor, entities.AuditEntityBoard, 1, entities.AuditActionCreate, nil, json.RawMessage(`{"Id":1,"Name":"backlog"}`)),
+		entities.NewAuditEvent(actor, entities.AuditEntityBoard, 1, entities.AuditActionUpdate, json.RawMessage(`{"Id":1,"Name":"backlog"}`), json.RawMessage(`{"Id":1,"Name":"doing"}`)),
+		entities.NewAuditEvent(entities.Actor{Name: "bob"}, entities.AuditEntityBoard, 1, entities.AuditActionDelete, json.RawMessage(`{"Id":1,"Name":"doing"}`), nil),
+		entities.NewAuditEvent(actor, entities.AuditEntityRoom, 1, entities.AuditActionCreate, nil, json.RawMessage(`{"Id":1}`)),
+	}
+	for _, event := range events {
+		require.NoError(t, AuditRepo.Create(ctx, event))
+		assert.NotZero(t, event.Id)
+	}
+
+	got, err := AuditRepo.GetAll(ctx, &entities.AuditFilter{EntityType: entities.AuditEntityBoard, EntityId: 1, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, events[2].Id, got[0].Id)
+	assert.Equal(t, "bob", got[0].Actor)
+	assert.JSONEq(t, `{"Id":1,"Name":"doing"}`, string(got[0].Before))
+	assert.Nil(t, got[0].After)
+	assert.Nil(t, got[2].Before)
+	assert.Equal(t, "req-1", got[2].RequestId)
+	assert.Equal(t, "192.0.2.1", got[2].IP)
+
+	got, err = AuditRepo.GetAll(ctx, &entities.AuditFilter{Actor: "alice", Action: entities.AuditActionCreate, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, entities.AuditEntityRoom, got[0].EntityType)
+
+	got, err = AuditRepo.GetAll(ctx, &entities.AuditFilter{BeforeId: events[2].Id, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, events[1].Id, got[0].Id)
+}
+
+func TestAuditEventInUnitOfWork(t *testing.T) {
+	defer deleteAllAuditEvents(t)
+	defer deleteAllRooms(t)
+
+	ctx := context.Background()
+	room := entities.NewRoom("test room")
+	err := UnitOfWorkRepo.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Rooms.Create(ctx, room); err != nil {
+			return err
+		}
+		if err := repos.Audits.Create(ctx, entities.NewAuditEvent(entities.Actor{}, entities.AuditEntityRoom, room.Id, entities.AuditActionCreate, nil, json.RawMessage(`{}`))); err != nil {
+			return err
+		}
+
+		return errors.New("rolled back")
+	})
+	require.Error(t, err)
+
+	got, err := AuditRepo.GetAll(ctx, &entities.AuditFilter{Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -1,13 +1,15 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
+	"time"
 
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
 )
 
 type BoardRepository struct {
-	db *sql.DB
+	db dbtx
 }
 
 func NewBoardRepository(db *sql.DB) *BoardRepository {
@@ -16,48 +18,208 @@ func NewBoardRepository(db *sql.DB) *BoardRepository {
 	}
 }
 
-func (br *BoardRepository) GetAll() ([]*entities.Board, error) {
-	query := "SELECT id, name, priority, room_id, created_at, updated_at FROM boards"
+// boardColumns selects a board from boards b joined to its room r.
+const boardColumns = `SELECT
+			b.id,
+			b.name,
+			b.priority,
+			b.position,
+			b.room_id,
+			b.archived_at,
+			r.archived_at IS NOT NULL,
+			b.created_at,
+			b.updated_at
+		FROM
+			boards b
+			INNER JOIN rooms r ON r.id = b.room_id`
+
+// GetAll leaves out archived boards unless includeArchived is set.
+func (br *BoardRepository) GetAll(ctx context.Context, includeArchived bool) ([]*entities.Board, error) {
+	query := boardColumns + `
+		WHERE b.deleted_at IS NULL AND (? OR b.archived_at IS NULL)`
+
+	return br.query(ctx, query, includeArchived)
+}
+
+// GetAllByRoomId leaves out archived boards unless includeArchived is set.
+func (br *BoardRepository) GetAllByRoomId(ctx context.Context, roomId int, includeArchived bool) ([]*entities.Board, error) {
+	query := boardColumns + `
+		WHERE b.room_id = ? AND b.deleted_at IS NULL AND (? OR b.archived_at IS NULL)
+		ORDER BY b.position, b.id`
+
+	return br.query(ctx, query, roomId, includeArchived)
+}
+
+// GetAllByRoomIdForUpdate is GetAllByRoomId locking the room and its boards until the
+// transaction ends, which keeps boards from being added, archived or moved meanwhile.
+func (br *BoardRepository) GetAllByRoomIdForUpdate(ctx context.Context, roomId int, includeArchived bool) ([]*entities.Board, error) {
+	query := boardColumns + `
+		WHERE b.room_id = ? AND b.deleted_at IS NULL AND (? OR b.archived_at IS NULL)
+		ORDER BY b.position, b.id
+		FOR UPDATE`
+
+	return br.query(ctx, query, roomId, includeArchived)
+}
+
+func (br *BoardRepository) GetById(ctx context.Context, id int) (*entities.Board, error) {
+	query := boardColumns + `
+		WHERE b.id = ? AND b.deleted_at IS NULL`
+
+	return scanBoard(br.db.QueryRowContext(ctx, query, id))
+}
+
+// Create appends the board to its room and sets the id, position and timestamps of board.
+// It returns sql.ErrNoRows when the room does not exist or is in the trash.
+func (br *BoardRepository) Create(ctx context.Context, board *entities.Board) error {
+	return inTx(ctx, br.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := `INSERT INTO boards (name, priority, position, room_id, change_seq)
+			SELECT ?, ?, COALESCE(MAX(b.position) + 1, 0), r.id, ?
+			FROM
+				rooms r
+				LEFT JOIN boards b ON b.room_id = r.id
+			WHERE r.id = ? AND r.deleted_at IS NULL
+			GROUP BY r.id`
+
+		res, err := tx.ExecContext(ctx, query, board.Name, board.Priority, seq, board.RoomId)
+		if err != nil {
+			return err
+		}
+
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return sql.ErrNoRows
+		}
+
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		board.Id = int(id)
+		query = "SELECT position, created_at, updated_at FROM boards WHERE id = ?"
+		return tx.QueryRowContext(ctx, query, id).Scan(&board.Position, &board.CreatedAt, &board.UpdatedAt)
+	})
+}
+
+func (br *BoardRepository) Update(ctx context.Context, board *entities.Board) error {
+	return inTx(ctx, br.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := "UPDATE boards SET name = ?, priority = ?, change_seq = ? WHERE id = ?"
+		_, err = tx.ExecContext(ctx, query, board.Name, board.Priority, seq, board.Id)
+		return err
+	})
+}
+
+// UpdateArchivedAt archives the board, or unarchives it when archivedAt is nil.
+func (br *BoardRepository) UpdateArchivedAt(ctx context.Context, id int, archivedAt *time.Time) error {
+	return inTx(ctx, br.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := "UPDATE boards SET archived_at = ?, change_seq = ? WHERE id = ?"
+		_, err = tx.ExecContext(ctx, query, archivedAt, seq, id)
+		return err
+	})
+}
+
+// Delete moves the board to the trash together with its todos, which share its deleted_at and
+// change_seq and are flagged deleted_by_cascade.
+func (br *BoardRepository) Delete(ctx context.Context, id int) error {
+	return inTx(ctx, br.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := "UPDATE boards SET deleted_at = CURRENT_TIMESTAMP, change_seq = ? WHERE id = ? AND deleted_at IS NULL"
+		if _, err := tx.ExecContext(ctx, query, seq, id); err != nil {
+			return err
+		}
+
+		query = `UPDATE todos t
+				INNER JOIN boards b ON b.id = t.board_id
+			SET t.deleted_at = b.deleted_at, t.deleted_by_cascade = TRUE, t.change_seq = ?
+			WHERE b.id = ? AND t.deleted_at IS NULL`
+		if _, err := tx.ExecContext(ctx, query, seq, id); err != nil {
+			return err
+		}
+
+		return touchDependents(ctx, tx, "b.board_id = ?", id)
+	})
+}
+
+// Reorder assigns positions following the order of ids in a single transaction.
+func (br *BoardRepository) Reorder(ctx context.Context, roomId int, ids []int) error {
+	return inTx(ctx, br.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := "UPDATE boards SET position = ?, change_seq = ? WHERE id = ? AND room_id = ?"
+		stmt, err := tx.PrepareContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for position, id := range ids {
+			if _, err := stmt.ExecContext(ctx, position, seq, id, roomId); err != nil {
+				return err
+			}
+		}
 
-	stmt, err := br.db.Prepare(query)
+		return nil
+	})
+}
+
+func (br *BoardRepository) query(ctx context.Context, query string, args ...any) ([]*entities.Board, error) {
+	stmt, err := br.db.PrepareContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 	defer stmt.Close()
 
 	var boards []*entities.Board
-	rows, err := stmt.Query()
+	rows, err := stmt.QueryContext(ctx, args...)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
-		var b entities.Board
-		if err := rows.Scan(
-			&b.Id,
-			&b.Name,
-			&b.Priority,
-			&b.RoomId,
-			&b.CreatedAt,
-			&b.UpdatedAt,
-		); err != nil {
+		board, err := scanBoard(rows)
+		if err != nil {
 			return nil, err
 		}
-		boards = append(boards, &b)
+		boards = append(boards, board)
 	}
 
 	return boards, nil
 }
 
-func (br *BoardRepository) GetById(id int) (*entities.Board, error) {
+func scanBoard(row rowScanner) (*entities.Board, error) {
 	var board entities.Board
-	query := "SELECT id, name, priority, room_id, created_at, updated_at FROM boards WHERE id = ?"
-
-	if err := br.db.QueryRow(query, id).Scan(
+	if err := row.Scan(
 		&board.Id,
 		&board.Name,
 		&board.Priority,
+		&board.Position,
 		&board.RoomId,
+		&board.ArchivedAt,
+		&board.RoomArchived,
 		&board.CreatedAt,
 		&board.UpdatedAt,
 	); err != nil {
@@ -66,48 +228,3 @@ func (br *BoardRepository) GetById(id int) (*entities.Board, error) {
 
 	return &board, nil
 }
-
-func (br *BoardRepository) Create(board *entities.Board) error {
-	query := "INSERT INTO boards (name, priority, room_id) VALUES (?, ?, ?)"
-
-	stmt, err := br.db.Prepare(query)
-	if err != nil {
-		return err
-	}
-	defer stmt.Close()
-
-	_, err = stmt.Exec(board.Name, board.Priority, board.RoomId)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (br *BoardRepository) Update(board *entities.Board) error {
-	query := "UPDATE boards SET name = ?, priority = ? WHERE id = ?"
-
-	stmt, err := br.db.Prepare(query)
-	if err != nil {
-		return err
-	}
-	defer stmt.Close()
-
-	_, err = stmt.Exec(board.Name, board.Priority, board.Id)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (br *BoardRepository) Delete(id int) error {
-	query := "DELETE FROM boards WHERE id = ?"
-
-	_, err := br.db.Exec(query, id)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
@@ -1,7 +1,9 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"testing"
 	"time"
 
@@ -20,8 +22,8 @@ var referencedRoomData = entities.Room{
 func getBoardCount(t *testing.T) int {
 	var count int
 
-	query := "SELECT COUNT(*) FROM boards"
-	err := BoardRepo.db.QueryRow(query).Scan(&count)
+	query := "SELECT COUNT(*) FROM boards WHERE deleted_at IS NULL"
+	err := BoardRepo.db.QueryRowContext(context.Background(), query).Scan(&count)
 	require.NoError(t, err)
 
 	return count
@@ -31,13 +33,16 @@ func getBoardById(t *testing.T, id int) *entities.Board {
 	var board entities.Board
 	query := "SELECT * FROM boards WHERE id = ?"
 
-	err := BoardRepo.db.QueryRow(query, id).Scan(
+	err := BoardRepo.db.QueryRowContext(context.Background(), query, id).Scan(
 		&board.Id,
 		&board.RoomId,
 		&board.Name,
 		&board.Priority,
+		&board.Position,
+		&board.ArchivedAt,
 		&board.CreatedAt,
 		&board.UpdatedAt,
+		&board.DeletedAt,
 	)
 	require.NoError(t, err)
 
@@ -46,17 +51,18 @@ func getBoardById(t *testing.T, id int) *entities.Board {
 
 func insertDummyBoard(t *testing.T, board *entities.Board) {
 	query := `INSERT INTO boards
-		(id, room_id, name, priority, created_at, updated_at)
+		(id, room_id, name, priority, position, created_at, updated_at)
 	VALUES
-		(?, ?, ?, ?, ?, ?)
+		(?, ?, ?, ?, ?, ?, ?)
 	`
 
-	_, err := BoardRepo.db.Exec(
+	_, err := BoardRepo.db.ExecContext(context.Background(),
 		query,
 		board.Id,
 		board.RoomId,
 		board.Name,
 		board.Priority,
+		board.Position,
 		board.CreatedAt,
 		board.UpdatedAt,
 	)
@@ -65,7 +71,7 @@ func insertDummyBoard(t *testing.T, board *entities.Board) {
 
 func deleteAllBoards(t *testing.T) {
 	query := "DELETE FROM boards"
-	_, err := BoardRepo.db.Exec(query)
+	_, err := BoardRepo.db.ExecContext(context.Background(), query)
 	require.NoError(t, err)
 }
 
@@ -139,7 +145,7 @@ func TestGetAllBoards(t *testing.T) {
 			tc.setup(t, tc.savedBoard)
 			defer deleteAllBoards(t)
 
-			rooms, err := BoardRepo.GetAll()
+			rooms, err := BoardRepo.GetAll(context.Background(), false)
 
 			assert.Equal(t, tc.expectedError, err)
 			assert.Equal(t, tc.expectedData, rooms)
@@ -198,7 +204,7 @@ func TestGetByIdBoard(t *testing.T) {
 			tc.setup(t, tc.savedBoard)
 			defer deleteAllBoards(t)
 
-			room, err := BoardRepo.GetById(tc.id)
+			room, err := BoardRepo.GetById(context.Background(), tc.id)
 
 			assert.Equal(t, tc.expectedError, err)
 			assert.Equal(t, tc.expectedData, room)
@@ -234,11 +240,16 @@ func TestCreateBoard(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			defer deleteAllBoards(t)
 
-			err := BoardRepo.Create(tc.board)
+			err := BoardRepo.Create(context.Background(), tc.board)
 
 			afterCount := getBoardCount(t)
 			assert.Equal(t, tc.expectedError, err)
 			assert.Equal(t, tc.expectedRecordCount, afterCount)
+
+			stored, err := BoardRepo.GetById(context.Background(), tc.board.Id)
+			assert.NoError(t, err)
+			assertBoardHelper(t, stored, tc.board)
+			assert.Equal(t, stored.Position, tc.board.Position)
 		})
 	}
 }
@@ -315,7 +326,7 @@ func TestUpdateBoard(t *testing.T) {
 			tc.setup(t, tc.savedData)
 			defer deleteAllBoards(t)
 
-			err := BoardRepo.Update(tc.updateData)
+			err := BoardRepo.Update(context.Background(), tc.updateData)
 
 			assert.Equal(t, tc.expectedError, err)
 			if tc.expectedData != nil {
@@ -356,7 +367,7 @@ func TestDeleteBoard(t *testing.T) {
 		expectedRecordCount int
 	}{
 		{
-			name:     "Success to Delete board. the todo associated with it is also deleted",
+			name:     "Success to Delete board. the todo associated with it is also moved to the trash",
 			deleteId: savedBoard.Id,
 			setup: func(t *testing.T) {
 				insertDummyBoard(t, savedBoard)
@@ -382,7 +393,7 @@ func TestDeleteBoard(t *testing.T) {
 			tc.setup(t)
 			defer deleteAllBoards(t)
 
-			err := BoardRepo.Delete(tc.deleteId)
+			err := BoardRepo.Delete(context.Background(), tc.deleteId)
 
 			afterBoardCount := getBoardCount(t)
 			afterTodoCount := getTodoCount(t)
@@ -392,3 +403,64 @@ func TestDeleteBoard(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAllByRoomIdBoards(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	defer deleteAllBoards(t)
+
+	for i, position := range []int{2, 0, 1} {
+		insertDummyBoard(t, &entities.Board{
+			Id:        i + 1,
+			Name:      fmt.Sprintf("board %d", i+1),
+			Position:  position,
+			RoomId:    1,
+			CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		})
+	}
+
+	boards, err := BoardRepo.GetAllByRoomId(context.Background(), 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 1}, []int{boards[0].Id, boards[1].Id, boards[2].Id})
+
+	locked, err := BoardRepo.GetAllByRoomIdForUpdate(context.Background(), 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, boards, locked)
+
+	require.NoError(t, BoardRepo.Create(context.Background(), &entities.Board{Name: "appended", RoomId: 1}))
+
+	boards, err = BoardRepo.GetAllByRoomId(context.Background(), 1, false)
+	require.NoError(t, err)
+	require.Len(t, boards, 4)
+	assert.Equal(t, "appended", boards[3].Name)
+	assert.Equal(t, 3, boards[3].Position)
+
+	boards, err = BoardRepo.GetAllByRoomId(context.Background(), 999, false)
+	require.NoError(t, err)
+	assert.Nil(t, boards)
+}
+
+func TestReorderBoards(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	defer deleteAllBoards(t)
+
+	for i, name := range []string{"a", "b", "c"} {
+		insertDummyBoard(t, &entities.Board{
+			Id:        i + 1,
+			Name:      name,
+			Position:  i,
+			RoomId:    1,
+			CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		})
+	}
+
+	err := BoardRepo.Reorder(context.Background(), 1, []int{3, 1, 2})
+	require.NoError(t, err)
+
+	boards, err := BoardRepo.GetAllByRoomId(context.Background(), 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "a", "b"}, []string{boards[0].Name, boards[1].Name, boards[2].Name})
+}
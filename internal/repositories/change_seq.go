@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+)
+
+// Every write to rooms, boards and todos stores a number of the change sequence in
+// change_seq, so that clients can ask for what changed after the last one they saw. Outbox
+// events take their ids from the same sequence.
+//
+// Lock order: a transaction locks the rows it reads and writes in whatever order its service
+// needs, and the sequence row always last, in changeTx.commit. The row stays locked until the
+// commit, so transactions commit in the order of their numbers and a reader never sees a
+// number before the smaller ones; taking it anywhere earlier would also make it a lock two
+// transactions can wait on in opposite orders.
+
+// pendingChangeSeq returns the number to store in change_seq within tx. It is a placeholder
+// unique among open transactions, the negated connection id, which the commit of tx
+// replaces with the next number of the sequence in every row written with it.
+func pendingChangeSeq(ctx context.Context, tx dbtx) (int64, error) {
+	ct, ok := tx.(*changeTx)
+	if !ok {
+		return 0, errNoTx
+	}
+
+	if ct.pendingSeq == 0 {
+		var connectionId int64
+		if err := ct.QueryRowContext(ctx, "SELECT CONNECTION_ID()").Scan(&connectionId); err != nil {
+			return 0, err
+		}
+		ct.pendingSeq = -connectionId
+	}
+
+	return ct.pendingSeq, nil
+}
+
+// nextChangeSeq takes the next number of the change sequence. Only changeTx.commit and what
+// it runs may call it.
+func nextChangeSeq(ctx context.Context, tx dbtx) (int64, error) {
+	query := "UPDATE change_sequence SET value = LAST_INSERT_ID(value + 1) WHERE id = 1"
+
+	res, err := tx.ExecContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	seq, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	if seq == 0 {
+		return 0, errors.New("change sequence is missing")
+	}
+
+	return seq, nil
+}
@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ChecklistRepository struct {
+	db dbtx
+}
+
+func NewChecklistRepository(db *sql.DB) *ChecklistRepository {
+	return &ChecklistRepository{
+		db: db,
+	}
+}
+
+func (cr *ChecklistRepository) GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.ChecklistItem, error) {
+	query := `SELECT
+			id,
+			todo_id,
+			text,
+			checked,
+			position,
+			created_at,
+			updated_at
+		FROM
+			checklist_items
+		WHERE todo_id = ?
+		ORDER BY position, id`
+
+	stmt, err := cr.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var items []*entities.ChecklistItem
+	rows, err := stmt.QueryContext(ctx, todoId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c entities.ChecklistItem
+		if err := rows.Scan(
+			&c.Id,
+			&c.TodoId,
+			&c.Text,
+			&c.Checked,
+			&c.Position,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &c)
+	}
+
+	return items, nil
+}
+
+func (cr *ChecklistRepository) GetById(ctx context.Context, id int) (*entities.ChecklistItem, error) {
+	var item entities.ChecklistItem
+	query := `SELECT
+			id,
+			todo_id,
+			text,
+			checked,
+			position,
+			created_at,
+			updated_at
+		FROM
+			checklist_items
+		WHERE id = ?`
+
+	if err := cr.db.QueryRowContext(ctx, query, id).Scan(
+		&item.Id,
+		&item.TodoId,
+		&item.Text,
+		&item.Checked,
+		&item.Position,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// Create appends the item to the end of the todo's checklist and sets the id of item.
+func (cr *ChecklistRepository) Create(ctx context.Context, item *entities.ChecklistItem) error {
+	return inTx(ctx, cr.db, func(tx dbtx) error {
+		query := `INSERT INTO checklist_items (todo_id, text, checked, position)
+			SELECT ?, ?, ?, COALESCE(MAX(position) + 1, 0) FROM checklist_items WHERE todo_id = ?`
+
+		res, err := tx.ExecContext(ctx, query, item.TodoId, item.Text, item.Checked, item.TodoId)
+		if err != nil {
+			return err
+		}
+
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		item.Id = int(id)
+
+		return touchTodo(ctx, tx, item.TodoId)
+	})
+}
+
+func (cr *ChecklistRepository) Update(ctx context.Context, item *entities.ChecklistItem) error {
+	return inTx(ctx, cr.db, func(tx dbtx) error {
+		query := "UPDATE checklist_items SET text = ?, checked = ? WHERE id = ?"
+		if _, err := tx.ExecContext(ctx, query, item.Text, item.Checked, item.Id); err != nil {
+			return err
+		}
+
+		return touchTodo(ctx, tx, item.TodoId)
+	})
+}
+
+func (cr *ChecklistRepository) Delete(ctx context.Context, id int) error {
+	return inTx(ctx, cr.db, func(tx dbtx) error {
+		var todoId int
+		query := "SELECT todo_id FROM checklist_items WHERE id = ? FOR UPDATE"
+		if err := tx.QueryRowContext(ctx, query, id).Scan(&todoId); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM checklist_items WHERE id = ?", id); err != nil {
+			return err
+		}
+
+		return touchTodo(ctx, tx, todoId)
+	})
+}
+
+// Reorder assigns positions following the order of ids in a single transaction.
+func (cr *ChecklistRepository) Reorder(ctx context.Context, todoId int, ids []int) error {
+	return inTx(ctx, cr.db, func(tx dbtx) error {
+		query := "UPDATE checklist_items SET position = ? WHERE id = ? AND todo_id = ?"
+		stmt, err := tx.PrepareContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for position, id := range ids {
+			if _, err := stmt.ExecContext(ctx, position, id, todoId); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
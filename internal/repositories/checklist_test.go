@@ -0,0 +1,219 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var referencedTodoData = entities.Todo{
+	Id:        1,
+	Title:     "referencedTodo",
+	Done:      false,
+	Priority:  0,
+	BoardId:   1,
+	CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+	UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+}
+
+func getChecklistItemCount(t *testing.T) int {
+	var count int
+
+	query := "SELECT COUNT(*) FROM checklist_items"
+	err := ChecklistRepo.db.QueryRowContext(context.Background(), query).Scan(&count)
+	require.NoError(t, err)
+
+	return count
+}
+
+func insertDummyChecklistItem(t *testing.T, item *entities.ChecklistItem) {
+	query := `INSERT INTO checklist_items
+		(id, todo_id, text, checked, position, created_at, updated_at)
+	VALUES
+		(?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := ChecklistRepo.db.ExecContext(context.Background(),
+		query,
+		item.Id,
+		item.TodoId,
+		item.Text,
+		item.Checked,
+		item.Position,
+		item.CreatedAt,
+		item.UpdatedAt,
+	)
+	require.NoError(t, err)
+}
+
+func deleteAllChecklistItems(t *testing.T) {
+	query := "DELETE FROM checklist_items"
+	_, err := ChecklistRepo.db.ExecContext(context.Background(), query)
+	require.NoError(t, err)
+}
+
+func setupChecklistReferences(t *testing.T) func() {
+	insertDummyRoom(t, &referencedRoomData)
+	insertDummyBoard(t, &referencedBoardData)
+	insertDummyTodo(t, &referencedTodoData)
+
+	return func() {
+		deleteAllTodos(t)
+		deleteAllBoards(t)
+		deleteAllRooms(t)
+	}
+}
+
+func TestGetAllByTodoIdChecklistItems(t *testing.T) {
+	teardown := setupChecklistReferences(t)
+	defer teardown()
+
+	testCases := []struct {
+		name          string
+		savedItems    []*entities.ChecklistItem
+		expectedError error
+		expectedData  []*entities.ChecklistItem
+	}{
+		{
+			name: "Success to Get checklist ordered by position",
+			savedItems: []*entities.ChecklistItem{
+				{
+					Id:        1,
+					TodoId:    1,
+					Text:      "second",
+					Position:  1,
+					CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+				},
+				{
+					Id:        2,
+					TodoId:    1,
+					Text:      "first",
+					Checked:   true,
+					Position:  0,
+					CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+				},
+			},
+			expectedError: nil,
+			expectedData: []*entities.ChecklistItem{
+				{
+					Id:        2,
+					TodoId:    1,
+					Text:      "first",
+					Checked:   true,
+					Position:  0,
+					CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+				},
+				{
+					Id:        1,
+					TodoId:    1,
+					Text:      "second",
+					Position:  1,
+					CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name:          "Returns nil if there is no record",
+			savedItems:    nil,
+			expectedError: nil,
+			expectedData:  nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, item := range tc.savedItems {
+				insertDummyChecklistItem(t, item)
+			}
+			defer deleteAllChecklistItems(t)
+
+			items, err := ChecklistRepo.GetAllByTodoId(context.Background(), 1)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedData, items)
+		})
+	}
+}
+
+func TestGetByIdChecklistItem(t *testing.T) {
+	teardown := setupChecklistReferences(t)
+	defer teardown()
+
+	_, err := ChecklistRepo.GetById(context.Background(), 999)
+
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestCreateChecklistItem(t *testing.T) {
+	teardown := setupChecklistReferences(t)
+	defer teardown()
+	defer deleteAllChecklistItems(t)
+
+	beforeCount := getChecklistItemCount(t)
+
+	err := ChecklistRepo.Create(context.Background(), &entities.ChecklistItem{TodoId: 1, Text: "first"})
+	require.NoError(t, err)
+	err = ChecklistRepo.Create(context.Background(), &entities.ChecklistItem{TodoId: 1, Text: "second"})
+	require.NoError(t, err)
+
+	assert.Equal(t, beforeCount+2, getChecklistItemCount(t))
+
+	items, err := ChecklistRepo.GetAllByTodoId(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "first", items[0].Text)
+	assert.Equal(t, 0, items[0].Position)
+	assert.Equal(t, "second", items[1].Text)
+	assert.Equal(t, 1, items[1].Position)
+}
+
+func TestReorderChecklistItems(t *testing.T) {
+	teardown := setupChecklistReferences(t)
+	defer teardown()
+	defer deleteAllChecklistItems(t)
+
+	for i, text := range []string{"a", "b", "c"} {
+		insertDummyChecklistItem(t, &entities.ChecklistItem{
+			Id:        i + 1,
+			TodoId:    1,
+			Text:      text,
+			Position:  i,
+			CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		})
+	}
+
+	err := ChecklistRepo.Reorder(context.Background(), 1, []int{3, 1, 2})
+	require.NoError(t, err)
+
+	items, err := ChecklistRepo.GetAllByTodoId(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "a", "b"}, []string{items[0].Text, items[1].Text, items[2].Text})
+}
+
+func TestDeleteChecklistItem(t *testing.T) {
+	teardown := setupChecklistReferences(t)
+	defer teardown()
+	defer deleteAllChecklistItems(t)
+
+	insertDummyChecklistItem(t, &entities.ChecklistItem{
+		Id:        1,
+		TodoId:    1,
+		Text:      "a",
+		CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+	})
+
+	err := ChecklistRepo.Delete(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, getChecklistItemCount(t))
+}
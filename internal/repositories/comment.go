@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type CommentRepository struct {
+	db dbtx
+}
+
+func NewCommentRepository(db *sql.DB) *CommentRepository {
+	return &CommentRepository{
+		db: db,
+	}
+}
+
+// GetAllByTodoId returns the comments of the todo, oldest first.
+func (cr *CommentRepository) GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.Comment, error) {
+	query := `SELECT
+			id,
+			todo_id,
+			author,
+			body,
+			created_at
+		FROM
+			comments
+		WHERE todo_id = ?
+		ORDER BY id`
+
+	rows, err := cr.db.QueryContext(ctx, query, todoId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comments []*entities.Comment
+	for rows.Next() {
+		var c entities.Comment
+		if err := rows.Scan(&c.Id, &c.TodoId, &c.Author, &c.Body, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		comments = append(comments, &c)
+	}
+
+	return comments, rows.Err()
+}
+
+// Create sets the id and creation time of comment.
+func (cr *CommentRepository) Create(ctx context.Context, comment *entities.Comment) error {
+	query := "INSERT INTO comments (todo_id, author, body) VALUES (?, ?, ?)"
+
+	res, err := cr.db.ExecContext(ctx, query, comment.TodoId, comment.Author, comment.Body)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	comment.Id = int(id)
+	query = "SELECT created_at FROM comments WHERE id = ?"
+	return cr.db.QueryRowContext(ctx, query, id).Scan(&comment.CreatedAt)
+}
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComments(t *testing.T) {
+	teardown := setupChecklistReferences(t)
+	defer teardown()
+
+	ctx := context.Background()
+	first := entities.NewComment(referencedTodoData.Id, "alice", "first")
+	second := entities.NewComment(referencedTodoData.Id, "bob", "second")
+	for _, comment := range []*entities.Comment{first, second} {
+		require.NoError(t, CommentRepo.Create(ctx, comment))
+		assert.NotZero(t, comment.Id)
+		assert.False(t, comment.CreatedAt.IsZero())
+	}
+
+	got, err := CommentRepo.GetAllByTodoId(ctx, referencedTodoData.Id)
+	require.NoError(t, err)
+	assert.Equal(t, []*entities.Comment{first, second}, got)
+}
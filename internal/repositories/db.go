@@ -2,12 +2,19 @@ package repositories
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/go-sql-driver/mysql"
 	"github.com/rm-ryou/sample_todo_app/internal/config"
 )
 
+const (
+	mysqlErrDuplicateEntry  = 1062
+	mysqlErrDeadlock        = 1213
+	mysqlErrRowIsReferenced = 1451
+)
+
 func createDNS(cfg config.DB) string {
 	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true",
 		cfg.User,
@@ -30,3 +37,8 @@ func SetupConnection(cfg config.DB) (*sql.DB, error) {
 
 	return db, nil
 }
+
+func isMySQLError(err error, number uint16) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == number
+}
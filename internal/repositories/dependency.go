@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type DependencyRepository struct {
+	db dbtx
+}
+
+func NewDependencyRepository(db *sql.DB) *DependencyRepository {
+	return &DependencyRepository{
+		db: db,
+	}
+}
+
+func (dr *DependencyRepository) GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.TodoDependency, error) {
+	query := `SELECT
+			d.todo_id,
+			d.blocker_id,
+			d.created_at
+		FROM
+			todo_dependencies d
+			INNER JOIN todos b ON b.id = d.blocker_id
+		WHERE d.todo_id = ? AND b.deleted_at IS NULL
+		ORDER BY d.blocker_id`
+
+	return dr.query(ctx, query, todoId)
+}
+
+func (dr *DependencyRepository) GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.TodoDependency, error) {
+	query := `SELECT
+			d.todo_id,
+			d.blocker_id,
+			d.created_at
+		FROM
+			todo_dependencies d
+			INNER JOIN todos t ON t.id = d.todo_id
+			INNER JOIN boards b ON b.id = t.board_id
+		WHERE b.room_id = ?`
+
+	return dr.query(ctx, query, roomId)
+}
+
+// GetRoomIdByTodoId holds a shared lock on the todo in a transaction, so that the todo cannot
+// move to another room before it commits.
+func (dr *DependencyRepository) GetRoomIdByTodoId(ctx context.Context, todoId int) (int, error) {
+	var roomId int
+	query := `SELECT b.room_id
+		FROM
+			todos t
+			INNER JOIN boards b ON b.id = t.board_id
+		WHERE t.id = ? AND t.deleted_at IS NULL
+		FOR SHARE OF t`
+
+	if err := dr.db.QueryRowContext(ctx, query, todoId).Scan(&roomId); err != nil {
+		return 0, err
+	}
+
+	return roomId, nil
+}
+
+func (dr *DependencyRepository) LockRoom(ctx context.Context, roomId int) error {
+	var id int
+	query := "SELECT id FROM rooms WHERE id = ? FOR UPDATE"
+
+	return dr.db.QueryRowContext(ctx, query, roomId).Scan(&id)
+}
+
+// Create and Delete move the blocked todo on to a new change, since whether it is blocked is
+// read along with it.
+func (dr *DependencyRepository) Create(ctx context.Context, dependency *entities.TodoDependency) error {
+	return inTx(ctx, dr.db, func(tx dbtx) error {
+		query := "INSERT INTO todo_dependencies (todo_id, blocker_id) VALUES (?, ?)"
+		if _, err := tx.ExecContext(ctx, query, dependency.TodoId, dependency.BlockerId); err != nil {
+			return err
+		}
+
+		return touchTodo(ctx, tx, dependency.TodoId)
+	})
+}
+
+func (dr *DependencyRepository) Delete(ctx context.Context, todoId, blockerId int) error {
+	return inTx(ctx, dr.db, func(tx dbtx) error {
+		query := "DELETE FROM todo_dependencies WHERE todo_id = ? AND blocker_id = ?"
+		if _, err := tx.ExecContext(ctx, query, todoId, blockerId); err != nil {
+			return err
+		}
+
+		return touchTodo(ctx, tx, todoId)
+	})
+}
+
+func (dr *DependencyRepository) query(ctx context.Context, query string, args ...any) ([]*entities.TodoDependency, error) {
+	stmt, err := dr.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var dependencies []*entities.TodoDependency
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var d entities.TodoDependency
+		if err := rows.Scan(
+			&d.TodoId,
+			&d.BlockerId,
+			&d.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, &d)
+	}
+
+	return dependencies, nil
+}
@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deleteAllDependencies(t *testing.T) {
+	query := "DELETE FROM todo_dependencies"
+	_, err := DependencyRepo.db.ExecContext(context.Background(), query)
+	require.NoError(t, err)
+}
+
+func setupDependencyReferences(t *testing.T) func() {
+	insertDummyRoom(t, &referencedRoomData)
+	insertDummyBoard(t, &referencedBoardData)
+	for _, todo := range []*entities.Todo{
+		{Id: 1, Title: "blocked", Done: false, BoardId: 1},
+		{Id: 2, Title: "open blocker", Done: false, BoardId: 1},
+		{Id: 3, Title: "done blocker", Done: true, BoardId: 1},
+	} {
+		todo.CreatedAt = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		todo.UpdatedAt = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		insertDummyTodo(t, todo)
+	}
+
+	return func() {
+		deleteAllTodos(t)
+		deleteAllBoards(t)
+		deleteAllRooms(t)
+	}
+}
+
+func TestCreateAndGetDependencies(t *testing.T) {
+	teardown := setupDependencyReferences(t)
+	defer teardown()
+	defer deleteAllDependencies(t)
+
+	require.NoError(t, DependencyRepo.Create(context.Background(), &entities.TodoDependency{TodoId: 1, BlockerId: 2}))
+	require.NoError(t, DependencyRepo.Create(context.Background(), &entities.TodoDependency{TodoId: 1, BlockerId: 3}))
+
+	byTodo, err := DependencyRepo.GetAllByTodoId(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Len(t, byTodo, 2)
+	assert.Equal(t, 2, byTodo[0].BlockerId)
+	assert.Equal(t, 3, byTodo[1].BlockerId)
+
+	byRoom, err := DependencyRepo.GetAllByRoomId(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Len(t, byRoom, 2)
+
+	byOtherRoom, err := DependencyRepo.GetAllByRoomId(context.Background(), 999)
+	require.NoError(t, err)
+	assert.Nil(t, byOtherRoom)
+}
+
+func TestGetRoomIdByTodoId(t *testing.T) {
+	teardown := setupDependencyReferences(t)
+	defer teardown()
+
+	roomId, err := DependencyRepo.GetRoomIdByTodoId(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, roomId)
+
+	_, err = DependencyRepo.GetRoomIdByTodoId(context.Background(), 999)
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestLockRoom(t *testing.T) {
+	teardown := setupDependencyReferences(t)
+	defer teardown()
+
+	assert.NoError(t, DependencyRepo.LockRoom(context.Background(), 1))
+	assert.Equal(t, sql.ErrNoRows, DependencyRepo.LockRoom(context.Background(), 999))
+}
+
+func TestBlockedFlagOnTodo(t *testing.T) {
+	teardown := setupDependencyReferences(t)
+	defer teardown()
+	defer deleteAllDependencies(t)
+
+	require.NoError(t, DependencyRepo.Create(context.Background(), &entities.TodoDependency{TodoId: 1, BlockerId: 3}))
+	todo, err := TodoRepo.GetById(context.Background(), 1)
+	require.NoError(t, err)
+	assert.False(t, todo.Blocked)
+
+	require.NoError(t, DependencyRepo.Create(context.Background(), &entities.TodoDependency{TodoId: 1, BlockerId: 2}))
+	todo, err = TodoRepo.GetById(context.Background(), 1)
+	require.NoError(t, err)
+	assert.True(t, todo.Blocked)
+}
+
+func TestDeleteDependency(t *testing.T) {
+	teardown := setupDependencyReferences(t)
+	defer teardown()
+	defer deleteAllDependencies(t)
+
+	require.NoError(t, DependencyRepo.Create(context.Background(), &entities.TodoDependency{TodoId: 1, BlockerId: 2}))
+
+	err := DependencyRepo.Delete(context.Background(), 1, 2)
+	assert.NoError(t, err)
+
+	dependencies, err := DependencyRepo.GetAllByTodoId(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Nil(t, dependencies)
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type FeedTokenRepository struct {
+	db dbtx
+}
+
+func NewFeedTokenRepository(db *sql.DB) *FeedTokenRepository {
+	return &FeedTokenRepository{
+		db: db,
+	}
+}
+
+// Save stores the token of its actor, replacing the one issued before.
+func (fr *FeedTokenRepository) Save(ctx context.Context, token *entities.FeedToken) error {
+	query := `INSERT INTO feed_tokens (actor, token_hash)
+		VALUES (?, ?) AS new
+		ON DUPLICATE KEY UPDATE token_hash = new.token_hash, created_at = CURRENT_TIMESTAMP`
+
+	_, err := fr.db.ExecContext(ctx, query, token.Actor, token.TokenHash)
+	return err
+}
+
+func (fr *FeedTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*entities.FeedToken, error) {
+	query := "SELECT id, actor, token_hash, created_at FROM feed_tokens WHERE token_hash = ?"
+
+	var token entities.FeedToken
+	if err := fr.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.Id,
+		&token.Actor,
+		&token.TokenHash,
+		&token.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
@@ -0,0 +1,170 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type IdempotencyRepository struct {
+	db dbtx
+}
+
+func NewIdempotencyRepository(db *sql.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		db: db,
+	}
+}
+
+func (ir *IdempotencyRepository) Claim(ctx context.Context, record *entities.IdempotencyRecord, now time.Time) (*entities.IdempotencyRecord, error) {
+	query := "INSERT INTO idempotency_keys (user_id, idempotency_key, request_hash, locked_until, expires_at) VALUES (?, ?, ?, ?, ?)"
+	res, err := ir.db.ExecContext(ctx, query, record.UserId, record.Key, record.RequestHash, record.LockedUntil, record.ExpiresAt)
+	if isMySQLError(err, mysqlErrDuplicateEntry) {
+		return ir.reclaim(ctx, record, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	record.Id = int(id)
+
+	return nil, nil
+}
+
+func (ir *IdempotencyRepository) Complete(ctx context.Context, record *entities.IdempotencyRecord) error {
+	header, err := json.Marshal(record.Header)
+	if err != nil {
+		return err
+	}
+
+	// A request that outlived its lock may find the key taken over and already completed.
+	query := `UPDATE idempotency_keys SET status_code = ?, response_headers = ?, response_body = ?
+		WHERE id = ? AND request_hash = ? AND status_code IS NULL`
+
+	_, err = ir.db.ExecContext(ctx, query, record.StatusCode, header, record.Body, record.Id, record.RequestHash)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ir *IdempotencyRepository) Release(ctx context.Context, id int) error {
+	query := "DELETE FROM idempotency_keys WHERE id = ? AND status_code IS NULL"
+
+	_, err := ir.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes the records expired at now and returns how many there were.
+func (ir *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	query := "DELETE FROM idempotency_keys WHERE expires_at <= ?"
+
+	res, err := ir.db.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
+
+// reclaim takes the key over for record when its record expired or its request stopped
+// holding it without completing, keeping the id. Otherwise it returns the stored record.
+func (ir *IdempotencyRepository) reclaim(ctx context.Context, record *entities.IdempotencyRecord, now time.Time) (*entities.IdempotencyRecord, error) {
+	query := `UPDATE idempotency_keys SET
+			id = LAST_INSERT_ID(id),
+			request_hash = ?,
+			status_code = NULL,
+			response_headers = NULL,
+			response_body = NULL,
+			locked_until = ?,
+			expires_at = ?,
+			created_at = CURRENT_TIMESTAMP
+		WHERE user_id = ? AND idempotency_key = ?
+			AND (expires_at <= ? OR (status_code IS NULL AND locked_until <= ?))`
+
+	res, err := ir.db.ExecContext(ctx, query,
+		record.RequestHash, record.LockedUntil, record.ExpiresAt,
+		record.UserId, record.Key,
+		now, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return ir.getByKey(ctx, record.UserId, record.Key)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	record.Id = int(id)
+
+	return nil, nil
+}
+
+func (ir *IdempotencyRepository) getByKey(ctx context.Context, userId, key string) (*entities.IdempotencyRecord, error) {
+	query := `SELECT
+			id,
+			user_id,
+			idempotency_key,
+			request_hash,
+			status_code,
+			response_headers,
+			response_body,
+			locked_until,
+			expires_at,
+			created_at
+		FROM
+			idempotency_keys
+		WHERE user_id = ? AND idempotency_key = ?`
+
+	var record entities.IdempotencyRecord
+	var statusCode sql.NullInt64
+	var header []byte
+	if err := ir.db.QueryRowContext(ctx, query, userId, key).Scan(
+		&record.Id,
+		&record.UserId,
+		&record.Key,
+		&record.RequestHash,
+		&statusCode,
+		&header,
+		&record.Body,
+		&record.LockedUntil,
+		&record.ExpiresAt,
+		&record.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	record.StatusCode = int(statusCode.Int64)
+	if header != nil {
+		if err := json.Unmarshal(header, &record.Header); err != nil {
+			return nil, err
+		}
+	}
+
+	return &record, nil
+}
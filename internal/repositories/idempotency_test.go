@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deleteAllIdempotencyKeys(t *testing.T) {
+	query := "DELETE FROM idempotency_keys"
+	_, err := IdempotencyRepo.db.ExecContext(context.Background(), query)
+	require.NoError(t, err)
+}
+
+func TestClaimIdempotencyKey(t *testing.T) {
+	defer deleteAllIdempotencyKeys(t)
+
+	ctx := context.Background()
+	now := time.Date(2025, 7, 19, 10, 0, 0, 0, time.UTC)
+	lockedUntil := now.Add(time.Minute)
+	expiresAt := now.Add(24 * time.Hour)
+
+	record := entities.NewIdempotencyRecord("", "key", "hash", lockedUntil, expiresAt)
+	existing, err := IdempotencyRepo.Claim(ctx, record, now)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+	assert.NotZero(t, record.Id)
+
+	existing, err = IdempotencyRepo.Claim(ctx, entities.NewIdempotencyRecord("", "key", "other", lockedUntil, expiresAt), now)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, record.Id, existing.Id)
+	assert.Equal(t, "hash", existing.RequestHash)
+	assert.False(t, existing.Completed())
+
+	record.Complete(201, map[string][]string{"Location": {"/v1/rooms/1"}}, []byte(`{"id":1}`))
+	require.NoError(t, IdempotencyRepo.Complete(ctx, record))
+
+	// A completed record is replayed even once its lock ran out.
+	existing, err = IdempotencyRepo.Claim(ctx, entities.NewIdempotencyRecord("", "key", "hash", lockedUntil, expiresAt), lockedUntil)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, 201, existing.StatusCode)
+	assert.Equal(t, map[string][]string{"Location": {"/v1/rooms/1"}}, existing.Header)
+	assert.Equal(t, []byte(`{"id":1}`), existing.Body)
+
+	other := entities.NewIdempotencyRecord("another user", "key", "hash", lockedUntil, expiresAt)
+	existing, err = IdempotencyRepo.Claim(ctx, other, now)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	reclaimed := entities.NewIdempotencyRecord("", "key", "hash", expiresAt.Add(time.Minute), expiresAt.Add(24*time.Hour))
+	existing, err = IdempotencyRepo.Claim(ctx, reclaimed, expiresAt)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+	assert.Equal(t, record.Id, reclaimed.Id)
+
+	existing, err = IdempotencyRepo.Claim(ctx, entities.NewIdempotencyRecord("", "key", "hash", lockedUntil, expiresAt), expiresAt)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.False(t, existing.Completed())
+}
+
+func TestClaimAbandonedIdempotencyKey(t *testing.T) {
+	defer deleteAllIdempotencyKeys(t)
+
+	ctx := context.Background()
+	now := time.Date(2025, 7, 19, 10, 0, 0, 0, time.UTC)
+	lockedUntil := now.Add(time.Minute)
+	expiresAt := now.Add(24 * time.Hour)
+
+	record := entities.NewIdempotencyRecord("", "key", "hash", lockedUntil, expiresAt)
+	_, err := IdempotencyRepo.Claim(ctx, record, now)
+	require.NoError(t, err)
+
+	retried := entities.NewIdempotencyRecord("", "key", "hash", lockedUntil.Add(time.Minute), expiresAt)
+	existing, err := IdempotencyRepo.Claim(ctx, retried, lockedUntil)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+	assert.Equal(t, record.Id, retried.Id)
+}
+
+func TestReleaseIdempotencyKey(t *testing.T) {
+	defer deleteAllIdempotencyKeys(t)
+
+	ctx := context.Background()
+	now := time.Date(2025, 7, 19, 10, 0, 0, 0, time.UTC)
+	lockedUntil := now.Add(time.Minute)
+	expiresAt := now.Add(24 * time.Hour)
+
+	record := entities.NewIdempotencyRecord("", "key", "hash", lockedUntil, expiresAt)
+	_, err := IdempotencyRepo.Claim(ctx, record, now)
+	require.NoError(t, err)
+
+	require.NoError(t, IdempotencyRepo.Release(ctx, record.Id))
+
+	retried := entities.NewIdempotencyRecord("", "key", "hash", lockedUntil, expiresAt)
+	existing, err := IdempotencyRepo.Claim(ctx, retried, now)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+	assert.NotEqual(t, record.Id, retried.Id)
+}
+
+func TestDeleteExpiredIdempotencyKeys(t *testing.T) {
+	defer deleteAllIdempotencyKeys(t)
+
+	ctx := context.Background()
+	now := time.Date(2025, 7, 19, 10, 0, 0, 0, time.UTC)
+
+	_, err := IdempotencyRepo.Claim(ctx, entities.NewIdempotencyRecord("", "old", "hash", now, now.Add(time.Hour)), now)
+	require.NoError(t, err)
+	_, err = IdempotencyRepo.Claim(ctx, entities.NewIdempotencyRecord("", "new", "hash", now, now.Add(2*time.Hour)), now)
+	require.NoError(t, err)
+
+	deleted, err := IdempotencyRepo.DeleteExpired(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+}
@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type IncomingWebhookRepository struct {
+	db dbtx
+}
+
+func NewIncomingWebhookRepository(db *sql.DB) *IncomingWebhookRepository {
+	return &IncomingWebhookRepository{
+		db: db,
+	}
+}
+
+func (ir *IncomingWebhookRepository) GetAllByBoardId(ctx context.Context, boardId int) ([]*entities.IncomingWebhook, error) {
+	query := "SELECT id, board_id, token_hash, created_at FROM incoming_webhooks WHERE board_id = ? ORDER BY id"
+
+	rows, err := ir.db.QueryContext(ctx, query, boardId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []*entities.IncomingWebhook
+	for rows.Next() {
+		var webhook entities.IncomingWebhook
+		if err := rows.Scan(
+			&webhook.Id,
+			&webhook.BoardId,
+			&webhook.TokenHash,
+			&webhook.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, &webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+func (ir *IncomingWebhookRepository) GetById(ctx context.Context, id int) (*entities.IncomingWebhook, error) {
+	query := "SELECT id, board_id, token_hash, created_at FROM incoming_webhooks WHERE id = ?"
+
+	return ir.get(ctx, query, id)
+}
+
+func (ir *IncomingWebhookRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.IncomingWebhook, error) {
+	query := "SELECT id, board_id, token_hash, created_at FROM incoming_webhooks WHERE token_hash = ?"
+
+	return ir.get(ctx, query, tokenHash)
+}
+
+// Create sets the id and the creation time of webhook.
+func (ir *IncomingWebhookRepository) Create(ctx context.Context, webhook *entities.IncomingWebhook) error {
+	query := "INSERT INTO incoming_webhooks (board_id, token_hash) VALUES (?, ?)"
+
+	res, err := ir.db.ExecContext(ctx, query, webhook.BoardId, webhook.TokenHash)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	webhook.Id = int(id)
+
+	query = "SELECT created_at FROM incoming_webhooks WHERE id = ?"
+	return ir.db.QueryRowContext(ctx, query, id).Scan(&webhook.CreatedAt)
+}
+
+func (ir *IncomingWebhookRepository) Delete(ctx context.Context, id int) error {
+	query := "DELETE FROM incoming_webhooks WHERE id = ?"
+
+	_, err := ir.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ir *IncomingWebhookRepository) GetTodoId(ctx context.Context, webhookId int, externalKey string) (int, error) {
+	query := "SELECT todo_id FROM incoming_webhook_keys WHERE incoming_webhook_id = ? AND external_key = ?"
+
+	var todoId int
+	if err := ir.db.QueryRowContext(ctx, query, webhookId, externalKey).Scan(&todoId); err != nil {
+		return 0, err
+	}
+
+	return todoId, nil
+}
+
+// ClaimKey takes the lock on the key through its primary key, so alerts with the same new key
+// wait for each other instead of each creating a todo.
+func (ir *IncomingWebhookRepository) ClaimKey(ctx context.Context, webhookId int, externalKey string) (int, error) {
+	query := `INSERT INTO incoming_webhook_keys (incoming_webhook_id, external_key)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE todo_id = todo_id`
+	if _, err := ir.db.ExecContext(ctx, query, webhookId, externalKey); err != nil {
+		return 0, err
+	}
+
+	query = "SELECT todo_id FROM incoming_webhook_keys WHERE incoming_webhook_id = ? AND external_key = ? FOR UPDATE"
+
+	var todoId sql.NullInt64
+	if err := ir.db.QueryRowContext(ctx, query, webhookId, externalKey).Scan(&todoId); err != nil {
+		return 0, err
+	}
+
+	return int(todoId.Int64), nil
+}
+
+func (ir *IncomingWebhookRepository) SetTodoId(ctx context.Context, webhookId int, externalKey string, todoId int) error {
+	query := `INSERT INTO incoming_webhook_keys (incoming_webhook_id, external_key, todo_id)
+		VALUES (?, ?, ?) AS new
+		ON DUPLICATE KEY UPDATE todo_id = new.todo_id`
+
+	_, err := ir.db.ExecContext(ctx, query, webhookId, externalKey, todoId)
+	return err
+}
+
+func (ir *IncomingWebhookRepository) get(ctx context.Context, query string, arg any) (*entities.IncomingWebhook, error) {
+	var webhook entities.IncomingWebhook
+	if err := ir.db.QueryRowContext(ctx, query, arg).Scan(
+		&webhook.Id,
+		&webhook.BoardId,
+		&webhook.TokenHash,
+		&webhook.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deleteAllIncomingWebhooks(t *testing.T) {
+	for _, query := range []string{"DELETE FROM incoming_webhook_keys", "DELETE FROM incoming_webhooks"} {
+		_, err := IncomingWebhookRepo.db.ExecContext(context.Background(), query)
+		require.NoError(t, err)
+	}
+}
+
+func TestCreateAndDeleteIncomingWebhook(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+	defer deleteAllIncomingWebhooks(t)
+
+	ctx := context.Background()
+	token, webhook, err := entities.NewIncomingWebhook(referencedBoardData.Id)
+	require.NoError(t, err)
+	require.NoError(t, IncomingWebhookRepo.Create(ctx, webhook))
+	assert.NotZero(t, webhook.Id)
+	assert.False(t, webhook.CreatedAt.IsZero())
+
+	got, err := IncomingWebhookRepo.GetByTokenHash(ctx, entities.HashIncomingWebhookToken(token))
+	require.NoError(t, err)
+	assert.Equal(t, webhook.Id, got.Id)
+	assert.Equal(t, referencedBoardData.Id, got.BoardId)
+
+	webhooks, err := IncomingWebhookRepo.GetAllByBoardId(ctx, referencedBoardData.Id)
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 1)
+
+	require.NoError(t, IncomingWebhookRepo.Delete(ctx, webhook.Id))
+	_, err = IncomingWebhookRepo.GetById(ctx, webhook.Id)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestIncomingWebhookKeys(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+	insertDummyTodo(t, &referencedTodoData)
+	defer deleteAllTodos(t)
+	defer deleteAllIncomingWebhooks(t)
+
+	ctx := context.Background()
+	_, webhook, err := entities.NewIncomingWebhook(referencedBoardData.Id)
+	require.NoError(t, err)
+	require.NoError(t, IncomingWebhookRepo.Create(ctx, webhook))
+
+	_, err = IncomingWebhookRepo.GetTodoId(ctx, webhook.Id, "alert-1")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, IncomingWebhookRepo.SetTodoId(ctx, webhook.Id, "alert-1", referencedTodoData.Id))
+	todoId, err := IncomingWebhookRepo.GetTodoId(ctx, webhook.Id, "alert-1")
+	require.NoError(t, err)
+	assert.Equal(t, referencedTodoData.Id, todoId)
+
+	// Setting the key again points it at the other todo.
+	other := entities.Todo{Id: 2, Title: "other", BoardId: referencedBoardData.Id, CreatedAt: referencedTodoData.CreatedAt, UpdatedAt: referencedTodoData.UpdatedAt}
+	insertDummyTodo(t, &other)
+	require.NoError(t, IncomingWebhookRepo.SetTodoId(ctx, webhook.Id, "alert-1", other.Id))
+	todoId, err = IncomingWebhookRepo.GetTodoId(ctx, webhook.Id, "alert-1")
+	require.NoError(t, err)
+	assert.Equal(t, other.Id, todoId)
+
+	todoId, err = IncomingWebhookRepo.ClaimKey(ctx, webhook.Id, "alert-1")
+	require.NoError(t, err)
+	assert.Equal(t, other.Id, todoId)
+
+	todoId, err = IncomingWebhookRepo.ClaimKey(ctx, webhook.Id, "alert-2")
+	require.NoError(t, err)
+	assert.Zero(t, todoId)
+}
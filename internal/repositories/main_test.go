@@ -20,11 +20,28 @@ const (
 )
 
 var (
-	RoomRepo   *RoomRepository
-	BoardRepo  *BoardRepository
-	TodoRepo   *TodoRepository
-	MYSQL_HOST string
-	MYSQL_PORT string
+	RoomRepo            *RoomRepository
+	BoardRepo           *BoardRepository
+	TodoRepo            *TodoRepository
+	ChecklistRepo       *ChecklistRepository
+	DependencyRepo      *DependencyRepository
+	ReminderRepo        *ReminderRepository
+	StatusRepo          *StatusRepository
+	UnitOfWorkRepo      *UnitOfWork
+	IdempotencyRepo     *IdempotencyRepository
+	TrashRepo           *TrashRepository
+	AuditRepo           *AuditRepository
+	RevisionRepo        *TodoRevisionRepository
+	ActivityRepo        *ActivityRepository
+	FeedTokenRepo       *FeedTokenRepository
+	OutboxRepo          *OutboxRepository
+	WebhookRepo         *WebhookRepository
+	IncomingWebhookRepo *IncomingWebhookRepository
+	SyncRepo            *SyncRepository
+	CommentRepo         *CommentRepository
+	RoomMemberRepo      *RoomMemberRepository
+	MYSQL_HOST          string
+	MYSQL_PORT          string
 )
 
 func TestMain(m *testing.M) {
@@ -73,6 +90,23 @@ func TestMain(m *testing.M) {
 	RoomRepo = NewRoomRepository(db)
 	BoardRepo = NewBoardRepository(db)
 	TodoRepo = NewTodoRepository(db)
+	ChecklistRepo = NewChecklistRepository(db)
+	DependencyRepo = NewDependencyRepository(db)
+	ReminderRepo = NewReminderRepository(db)
+	StatusRepo = NewStatusRepository(db)
+	UnitOfWorkRepo = NewUnitOfWork(db)
+	IdempotencyRepo = NewIdempotencyRepository(db)
+	TrashRepo = NewTrashRepository(db)
+	AuditRepo = NewAuditRepository(db)
+	RevisionRepo = NewTodoRevisionRepository(db)
+	ActivityRepo = NewActivityRepository(db)
+	FeedTokenRepo = NewFeedTokenRepository(db)
+	OutboxRepo = NewOutboxRepository(db)
+	WebhookRepo = NewWebhookRepository(db)
+	IncomingWebhookRepo = NewIncomingWebhookRepository(db)
+	SyncRepo = NewSyncRepository(db)
+	CommentRepo = NewCommentRepository(db)
+	RoomMemberRepo = NewRoomMemberRepository(db)
 
 	statusCode := m.Run()
 	os.Exit(statusCode)
@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type OutboxRepository struct {
+	db dbtx
+}
+
+func NewOutboxRepository(db *sql.DB) *OutboxRepository {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+// Create stores the event and sets its id when the transaction commits, so that the relays
+// only see it along with the change it belongs to. The id is taken from the change sequence,
+// so events become visible in id order and a relay never passes one that commits later.
+func (ob *OutboxRepository) Create(ctx context.Context, event *entities.OutboxEvent) error {
+	return inTx(ctx, ob.db, func(tx dbtx) error {
+		return onCommit(tx, func(ctx context.Context, tx dbtx) error {
+			id, err := nextChangeSeq(ctx, tx)
+			if err != nil {
+				return err
+			}
+
+			query := "INSERT INTO event_outbox (id, room_id, type, payload) VALUES (?, ?, ?, ?)"
+			if _, err := tx.ExecContext(ctx, query, id, event.RoomId, event.Type, event.Payload); err != nil {
+				return err
+			}
+
+			event.Id = id
+			return nil
+		})
+	})
+}
+
+// GetAfter returns at most limit events with ids above afterId, oldest first.
+func (ob *OutboxRepository) GetAfter(ctx context.Context, afterId int64, limit int) ([]*entities.OutboxEvent, error) {
+	query := `SELECT
+			id,
+			room_id,
+			type,
+			payload,
+			created_at
+		FROM
+			event_outbox
+		WHERE id > ?
+		ORDER BY id
+		LIMIT ?`
+
+	rows, err := ob.db.QueryContext(ctx, query, afterId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*entities.OutboxEvent
+	for rows.Next() {
+		var event entities.OutboxEvent
+		if err := rows.Scan(
+			&event.Id,
+			&event.RoomId,
+			&event.Type,
+			&event.Payload,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
+
+// Register records a relay that starts after the newest event and returns the id of that event.
+func (ob *OutboxRepository) Register(ctx context.Context, relayId string, now time.Time) (int64, error) {
+	query := `INSERT INTO event_relays (id, last_event_id, seen_at)
+		SELECT ?, COALESCE(MAX(id), 0), ?
+		FROM event_outbox`
+
+	if _, err := ob.db.ExecContext(ctx, query, relayId, now); err != nil {
+		return 0, err
+	}
+
+	var lastId int64
+	query = "SELECT last_event_id FROM event_relays WHERE id = ?"
+	err := ob.db.QueryRowContext(ctx, query, relayId).Scan(&lastId)
+
+	return lastId, err
+}
+
+// MarkDelivered records that the relay has delivered every event up to lastId. A relay that
+// was purged for being stale is registered again.
+func (ob *OutboxRepository) MarkDelivered(ctx context.Context, relayId string, lastId int64, now time.Time) error {
+	query := `INSERT INTO event_relays (id, last_event_id, seen_at)
+		VALUES (?, ?, ?) AS new
+		ON DUPLICATE KEY UPDATE last_event_id = new.last_event_id, seen_at = new.seen_at`
+
+	_, err := ob.db.ExecContext(ctx, query, relayId, lastId, now)
+	return err
+}
+
+// Purge forgets relays not seen since staleBefore and deletes the events every remaining
+// relay has delivered. It returns the number of deleted events.
+func (ob *OutboxRepository) Purge(ctx context.Context, staleBefore time.Time) (int, error) {
+	var purged int64
+	err := inTx(ctx, ob.db, func(tx dbtx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM event_relays WHERE seen_at < ?", staleBefore); err != nil {
+			return err
+		}
+
+		query := `DELETE FROM event_outbox
+			WHERE id <= (SELECT MIN(last_event_id) FROM event_relays)`
+
+		res, err := tx.ExecContext(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		purged, err = res.RowsAffected()
+		return err
+	})
+
+	return int(purged), err
+}
@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deleteAllOutbox(t *testing.T) {
+	for _, query := range []string{"DELETE FROM event_outbox", "DELETE FROM event_relays"} {
+		_, err := OutboxRepo.db.ExecContext(context.Background(), query)
+		require.NoError(t, err)
+	}
+}
+
+func TestOutbox(t *testing.T) {
+	defer deleteAllOutbox(t)
+
+	ctx := context.Background()
+	now := time.Date(2025, 8, 30, 9, 0, 0, 0, time.UTC)
+
+	first, err := entities.NewOutboxEvent(1, entities.EventTodoCreated, &entities.Todo{Id: 1, Title: "first"})
+	require.NoError(t, err)
+	require.NoError(t, OutboxRepo.Create(ctx, first))
+
+	lastId, err := OutboxRepo.Register(ctx, "relay-a", now)
+	require.NoError(t, err)
+	assert.Equal(t, first.Id, lastId)
+
+	var events []*entities.OutboxEvent
+	for _, title := range []string{"second", "third"} {
+		event, err := entities.NewOutboxEvent(1, entities.EventTodoUpdated, &entities.Todo{Id: 1, Title: title})
+		require.NoError(t, err)
+		require.NoError(t, OutboxRepo.Create(ctx, event))
+		events = append(events, event)
+	}
+
+	got, err := OutboxRepo.GetAfter(ctx, lastId, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, events[0].Id, got[0].Id)
+	assert.Equal(t, entities.EventTodoUpdated, got[0].Type)
+	restored, err := got[1].Event()
+	require.NoError(t, err)
+	assert.Equal(t, "third", restored.Data.(*entities.Todo).Title)
+
+	got, err = OutboxRepo.GetAfter(ctx, lastId, 1)
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	// Events stay until every live relay has delivered them.
+	require.NoError(t, OutboxRepo.MarkDelivered(ctx, "relay-a", events[1].Id, now))
+	require.NoError(t, OutboxRepo.MarkDelivered(ctx, "relay-b", events[0].Id, now))
+	purged, err := OutboxRepo.Purge(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 2, purged)
+
+	// A relay not seen since before the stale time no longer holds events back.
+	require.NoError(t, OutboxRepo.MarkDelivered(ctx, "relay-b", events[0].Id, now.Add(-time.Hour)))
+	purged, err = OutboxRepo.Purge(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	got, err = OutboxRepo.GetAfter(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
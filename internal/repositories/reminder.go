@@ -0,0 +1,234 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type ReminderRepository struct {
+	db dbtx
+}
+
+func NewReminderRepository(db *sql.DB) *ReminderRepository {
+	return &ReminderRepository{
+		db: db,
+	}
+}
+
+func (rr *ReminderRepository) GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.Reminder, error) {
+	query := `SELECT
+			id,
+			todo_id,
+			remind_at,
+			offset_minutes,
+			channel,
+			sent_at,
+			created_at,
+			updated_at
+		FROM
+			reminders
+		WHERE todo_id = ?
+		ORDER BY id`
+
+	stmt, err := rr.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var reminders []*entities.Reminder
+	rows, err := stmt.QueryContext(ctx, todoId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r entities.Reminder
+		if err := rows.Scan(
+			&r.Id,
+			&r.TodoId,
+			&r.RemindAt,
+			&r.OffsetMinutes,
+			&r.Channel,
+			&r.SentAt,
+			&r.CreatedAt,
+			&r.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, &r)
+	}
+
+	return reminders, nil
+}
+
+func (rr *ReminderRepository) GetById(ctx context.Context, id int) (*entities.Reminder, error) {
+	var reminder entities.Reminder
+	query := `SELECT
+			id,
+			todo_id,
+			remind_at,
+			offset_minutes,
+			channel,
+			sent_at,
+			created_at,
+			updated_at
+		FROM
+			reminders
+		WHERE id = ?`
+
+	if err := rr.db.QueryRowContext(ctx, query, id).Scan(
+		&reminder.Id,
+		&reminder.TodoId,
+		&reminder.RemindAt,
+		&reminder.OffsetMinutes,
+		&reminder.Channel,
+		&reminder.SentAt,
+		&reminder.CreatedAt,
+		&reminder.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &reminder, nil
+}
+
+func (rr *ReminderRepository) Create(ctx context.Context, reminder *entities.Reminder) error {
+	query := "INSERT INTO reminders (todo_id, remind_at, offset_minutes, channel) VALUES (?, ?, ?, ?)"
+
+	stmt, err := rr.db.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, reminder.TodoId, reminder.RemindAt, reminder.OffsetMinutes, reminder.Channel)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	reminder.Id = int(id)
+
+	return nil
+}
+
+func (rr *ReminderRepository) Delete(ctx context.Context, id int) error {
+	query := "DELETE FROM reminders WHERE id = ?"
+
+	_, err := rr.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ClaimDue uses SKIP LOCKED so that concurrent replicas claim disjoint reminders.
+func (rr *ReminderRepository) ClaimDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]*entities.DueReminder, error) {
+	query := `SELECT
+			r.id,
+			r.todo_id,
+			r.remind_at,
+			r.offset_minutes,
+			r.channel,
+			r.sent_at,
+			r.created_at,
+			r.updated_at,
+			t.title,
+			COALESCE(r.remind_at, t.due_date - INTERVAL r.offset_minutes MINUTE) AS fire_at
+		FROM
+			reminders r
+			INNER JOIN todos t ON t.id = r.todo_id
+			INNER JOIN statuses s ON s.id = t.status_id
+		WHERE r.sent_at IS NULL
+			AND t.deleted_at IS NULL
+			AND (r.claimed_at IS NULL OR r.claimed_at < ?)
+			AND s.category <> 'done'
+			AND COALESCE(r.remind_at, t.due_date - INTERVAL r.offset_minutes MINUTE) <= ?
+		ORDER BY fire_at
+		LIMIT ?
+		FOR UPDATE OF r SKIP LOCKED`
+
+	var reminders []*entities.DueReminder
+	err := inTx(ctx, rr.db, func(tx dbtx) error {
+		rows, err := tx.QueryContext(ctx, query, staleBefore, now, limit)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var r entities.DueReminder
+			if err := rows.Scan(
+				&r.Id,
+				&r.TodoId,
+				&r.RemindAt,
+				&r.OffsetMinutes,
+				&r.Channel,
+				&r.SentAt,
+				&r.CreatedAt,
+				&r.UpdatedAt,
+				&r.TodoTitle,
+				&r.FireAt,
+			); err != nil {
+				return err
+			}
+			reminders = append(reminders, &r)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		rows.Close()
+
+		if len(reminders) == 0 {
+			return nil
+		}
+
+		placeholders := make([]string, 0, len(reminders))
+		args := []any{now}
+		for _, r := range reminders {
+			placeholders = append(placeholders, "?")
+			args = append(args, r.Id)
+		}
+
+		update := "UPDATE reminders SET claimed_at = ? WHERE id IN (" + strings.Join(placeholders, ", ") + ")"
+		_, err = tx.ExecContext(ctx, update, args...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reminders, nil
+}
+
+func (rr *ReminderRepository) MarkSent(ctx context.Context, id int, sentAt time.Time) error {
+	query := "UPDATE reminders SET sent_at = ?, claimed_at = NULL WHERE id = ?"
+
+	_, err := rr.db.ExecContext(ctx, query, sentAt, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rr *ReminderRepository) Release(ctx context.Context, id int) error {
+	query := "UPDATE reminders SET claimed_at = NULL WHERE id = ?"
+
+	_, err := rr.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deleteAllReminders(t *testing.T) {
+	query := "DELETE FROM reminders"
+	_, err := ReminderRepo.db.ExecContext(context.Background(), query)
+	require.NoError(t, err)
+}
+
+func setupReminderReferences(t *testing.T) func() {
+	dueDate := time.Date(2025, 6, 20, 9, 0, 0, 0, time.UTC)
+
+	insertDummyRoom(t, &referencedRoomData)
+	insertDummyBoard(t, &referencedBoardData)
+	for _, todo := range []*entities.Todo{
+		{Id: 1, Title: "open", Done: false, BoardId: 1, DueDate: &dueDate},
+		{Id: 2, Title: "done", Done: true, BoardId: 1, DueDate: &dueDate},
+	} {
+		todo.CreatedAt = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		todo.UpdatedAt = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		insertDummyTodo(t, todo)
+	}
+
+	return func() {
+		deleteAllReminders(t)
+		deleteAllTodos(t)
+		deleteAllBoards(t)
+		deleteAllRooms(t)
+	}
+}
+
+func TestCreateAndGetReminders(t *testing.T) {
+	teardown := setupReminderReferences(t)
+	defer teardown()
+
+	remindAt := time.Date(2025, 6, 19, 9, 0, 0, 0, time.UTC)
+	offset := 30
+	require.NoError(t, ReminderRepo.Create(context.Background(), entities.NewReminder(1, &remindAt, nil, "")))
+	require.NoError(t, ReminderRepo.Create(context.Background(), entities.NewReminder(1, nil, &offset, entities.ReminderChannelWebhook)))
+
+	reminders, err := ReminderRepo.GetAllByTodoId(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, reminders, 2)
+	assert.True(t, remindAt.Equal(*reminders[0].RemindAt))
+	assert.Nil(t, reminders[0].OffsetMinutes)
+	assert.Equal(t, entities.ReminderChannelLog, reminders[0].Channel)
+	assert.Nil(t, reminders[1].RemindAt)
+	assert.Equal(t, offset, *reminders[1].OffsetMinutes)
+
+	reminder, err := ReminderRepo.GetById(context.Background(), reminders[1].Id)
+	require.NoError(t, err)
+	assert.Equal(t, entities.ReminderChannelWebhook, reminder.Channel)
+
+	require.NoError(t, ReminderRepo.Delete(context.Background(), reminder.Id))
+	_, err = ReminderRepo.GetById(context.Background(), reminder.Id)
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestClaimDueReminders(t *testing.T) {
+	teardown := setupReminderReferences(t)
+	defer teardown()
+
+	now := time.Date(2025, 6, 20, 8, 45, 0, 0, time.UTC)
+	past := time.Date(2025, 6, 20, 8, 0, 0, 0, time.UTC)
+	future := time.Date(2025, 6, 20, 12, 0, 0, 0, time.UTC)
+	dueOffset := 30
+	laterOffset := 10
+
+	require.NoError(t, ReminderRepo.Create(context.Background(), entities.NewReminder(1, &past, nil, "")))
+	require.NoError(t, ReminderRepo.Create(context.Background(), entities.NewReminder(1, nil, &dueOffset, "")))
+	require.NoError(t, ReminderRepo.Create(context.Background(), entities.NewReminder(1, &future, nil, "")))
+	require.NoError(t, ReminderRepo.Create(context.Background(), entities.NewReminder(1, nil, &laterOffset, "")))
+	require.NoError(t, ReminderRepo.Create(context.Background(), entities.NewReminder(2, &past, nil, "")))
+
+	claimed, err := ReminderRepo.ClaimDue(context.Background(), now, now.Add(-5*time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	assert.True(t, past.Equal(claimed[0].FireAt))
+	assert.True(t, time.Date(2025, 6, 20, 8, 30, 0, 0, time.UTC).Equal(claimed[1].FireAt))
+	assert.Equal(t, "open", claimed[0].TodoTitle)
+
+	again, err := ReminderRepo.ClaimDue(context.Background(), now, now.Add(-5*time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, again, "claimed reminders are not handed out twice")
+
+	require.NoError(t, ReminderRepo.Release(context.Background(), claimed[0].Id))
+	require.NoError(t, ReminderRepo.MarkSent(context.Background(), claimed[1].Id, now))
+
+	released, err := ReminderRepo.ClaimDue(context.Background(), now, now.Add(-5*time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, released, 1)
+	assert.Equal(t, claimed[0].Id, released[0].Id)
+
+	stale, err := ReminderRepo.ClaimDue(context.Background(), now.Add(4*time.Minute), now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, stale, 1, "stale claims are taken over")
+	assert.Equal(t, claimed[0].Id, stale[0].Id)
+}
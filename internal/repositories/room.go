@@ -1,13 +1,15 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
+	"time"
 
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
 )
 
 type RoomRepository struct {
-	db *sql.DB
+	db dbtx
 }
 
 func NewRoomRepository(db *sql.DB) *RoomRepository {
@@ -16,17 +18,20 @@ func NewRoomRepository(db *sql.DB) *RoomRepository {
 	}
 }
 
-func (rr *RoomRepository) GetAll() ([]*entities.Room, error) {
-	query := "SELECT id, name, created_at, updated_at FROM rooms"
+// GetAll leaves out archived rooms unless includeArchived is set.
+func (rr *RoomRepository) GetAll(ctx context.Context, includeArchived bool) ([]*entities.Room, error) {
+	query := `SELECT id, name, archived_at, created_at, updated_at
+		FROM rooms
+		WHERE deleted_at IS NULL AND (? OR archived_at IS NULL)`
 
-	stmt, err := rr.db.Prepare(query)
+	stmt, err := rr.db.PrepareContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 	defer stmt.Close()
 
 	var rooms []*entities.Room
-	rows, err := stmt.Query()
+	rows, err := stmt.QueryContext(ctx, includeArchived)
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +41,7 @@ func (rr *RoomRepository) GetAll() ([]*entities.Room, error) {
 		if err := rows.Scan(
 			&r.Id,
 			&r.Name,
+			&r.ArchivedAt,
 			&r.CreatedAt,
 			&r.UpdatedAt,
 		); err != nil {
@@ -47,13 +53,14 @@ func (rr *RoomRepository) GetAll() ([]*entities.Room, error) {
 	return rooms, nil
 }
 
-func (rr *RoomRepository) GetById(id int) (*entities.Room, error) {
+func (rr *RoomRepository) GetById(ctx context.Context, id int) (*entities.Room, error) {
 	var room entities.Room
-	query := "SELECT id, name, created_at, updated_at FROM rooms WHERE id = ?"
+	query := "SELECT id, name, archived_at, created_at, updated_at FROM rooms WHERE id = ? AND deleted_at IS NULL"
 
-	if err := rr.db.QueryRow(query, id).Scan(
+	if err := rr.db.QueryRowContext(ctx, query, id).Scan(
 		&room.Id,
 		&room.Name,
+		&room.ArchivedAt,
 		&room.CreatedAt,
 		&room.UpdatedAt,
 	); err != nil {
@@ -63,47 +70,97 @@ func (rr *RoomRepository) GetById(id int) (*entities.Room, error) {
 	return &room, nil
 }
 
-func (rr *RoomRepository) Create(room *entities.Room) error {
-	query := "INSERT INTO rooms (name) VALUES (?)"
+// Create inserts the room together with its default workflow statuses, and sets the id
+// and timestamps of room.
+func (rr *RoomRepository) Create(ctx context.Context, room *entities.Room) error {
+	return inTx(ctx, rr.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
 
-	stmt, err := rr.db.Prepare(query)
-	if err != nil {
-		return err
-	}
-	defer stmt.Close()
+		res, err := tx.ExecContext(ctx, "INSERT INTO rooms (name, change_seq) VALUES (?, ?)", room.Name, seq)
+		if err != nil {
+			return err
+		}
 
-	_, err = stmt.Exec(room.Name)
-	if err != nil {
-		return err
-	}
+		roomId, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		if err := insertStatuses(ctx, tx, entities.DefaultStatuses(int(roomId))); err != nil {
+			return err
+		}
 
-	return nil
+		room.Id = int(roomId)
+		query := "SELECT created_at, updated_at FROM rooms WHERE id = ?"
+		return tx.QueryRowContext(ctx, query, roomId).Scan(&room.CreatedAt, &room.UpdatedAt)
+	})
 }
 
-func (rr *RoomRepository) Update(room *entities.Room) error {
-	query := "UPDATE rooms SET name = ? WHERE id = ?"
+func (rr *RoomRepository) Update(ctx context.Context, room *entities.Room) error {
+	return inTx(ctx, rr.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
 
-	stmt, err := rr.db.Prepare(query)
-	if err != nil {
+		query := "UPDATE rooms SET name = ?, change_seq = ? WHERE id = ?"
+		_, err = tx.ExecContext(ctx, query, room.Name, seq, room.Id)
 		return err
-	}
-	defer stmt.Close()
+	})
+}
 
-	_, err = stmt.Exec(room.Name, room.Id)
-	if err != nil {
-		return err
-	}
+// UpdateArchivedAt archives the room, or unarchives it when archivedAt is nil. Its boards
+// move on to the same change, since they are read along with whether their room is archived.
+func (rr *RoomRepository) UpdateArchivedAt(ctx context.Context, id int, archivedAt *time.Time) error {
+	return inTx(ctx, rr.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := "UPDATE rooms SET archived_at = ?, change_seq = ? WHERE id = ?"
+		if _, err := tx.ExecContext(ctx, query, archivedAt, seq, id); err != nil {
+			return err
+		}
 
-	return nil
+		query = "UPDATE boards SET change_seq = ? WHERE room_id = ? AND deleted_at IS NULL"
+		_, err = tx.ExecContext(ctx, query, seq, id)
+		return err
+	})
 }
 
-func (rr *RoomRepository) Delete(id int) error {
-	query := "DELETE FROM rooms WHERE id = ?"
+// Delete moves the room to the trash together with its boards and todos. They share the
+// room's deleted_at and change_seq, and are flagged deleted_by_cascade, which is how RestoreRoom
+// tells them from ones trashed on their own.
+func (rr *RoomRepository) Delete(ctx context.Context, id int) error {
+	return inTx(ctx, rr.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
 
-	_, err := rr.db.Exec(query, id)
-	if err != nil {
-		return err
-	}
+		query := "UPDATE rooms SET deleted_at = CURRENT_TIMESTAMP, change_seq = ? WHERE id = ? AND deleted_at IS NULL"
+		if _, err := tx.ExecContext(ctx, query, seq, id); err != nil {
+			return err
+		}
+
+		query = `UPDATE boards b
+				INNER JOIN rooms r ON r.id = b.room_id
+			SET b.deleted_at = r.deleted_at, b.deleted_by_cascade = TRUE, b.change_seq = ?
+			WHERE r.id = ? AND b.deleted_at IS NULL`
+		if _, err := tx.ExecContext(ctx, query, seq, id); err != nil {
+			return err
+		}
 
-	return nil
+		query = `UPDATE todos t
+				INNER JOIN boards b ON b.id = t.board_id
+				INNER JOIN rooms r ON r.id = b.room_id
+			SET t.deleted_at = r.deleted_at, t.deleted_by_cascade = TRUE, t.change_seq = ?
+			WHERE r.id = ? AND t.deleted_at IS NULL`
+		_, err = tx.ExecContext(ctx, query, seq, id)
+		return err
+	})
 }
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type RoomMemberRepository struct {
+	db dbtx
+}
+
+func NewRoomMemberRepository(db *sql.DB) *RoomMemberRepository {
+	return &RoomMemberRepository{
+		db: db,
+	}
+}
+
+func (mr *RoomMemberRepository) GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.RoomMember, error) {
+	query := `SELECT
+			id,
+			room_id,
+			actor,
+			joined_at
+		FROM
+			room_members
+		WHERE room_id = ?
+		ORDER BY id`
+
+	rows, err := mr.db.QueryContext(ctx, query, roomId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*entities.RoomMember
+	for rows.Next() {
+		var m entities.RoomMember
+		if err := rows.Scan(&m.Id, &m.RoomId, &m.Actor, &m.JoinedAt); err != nil {
+			return nil, err
+		}
+		members = append(members, &m)
+	}
+
+	return members, rows.Err()
+}
+
+// Create adds the member unless the actor already joined the room, and sets the id and
+// joining time of member either way. It reports whether the member was added.
+func (mr *RoomMemberRepository) Create(ctx context.Context, member *entities.RoomMember) (bool, error) {
+	query := "INSERT INTO room_members (room_id, actor) VALUES (?, ?) ON DUPLICATE KEY UPDATE id = id"
+
+	res, err := mr.db.ExecContext(ctx, query, member.RoomId, member.Actor)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	query = "SELECT id, joined_at FROM room_members WHERE room_id = ? AND actor = ?"
+	if err := mr.db.QueryRowContext(ctx, query, member.RoomId, member.Actor).Scan(&member.Id, &member.JoinedAt); err != nil {
+		return false, err
+	}
+
+	return n == 1, nil
+}
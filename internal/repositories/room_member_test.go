@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoomMembers(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+
+	ctx := context.Background()
+	member := &entities.RoomMember{RoomId: referencedRoomData.Id, Actor: "alice"}
+	joined, err := RoomMemberRepo.Create(ctx, member)
+	require.NoError(t, err)
+	assert.True(t, joined)
+	assert.NotZero(t, member.Id)
+
+	// Joining again keeps the first membership.
+	again := &entities.RoomMember{RoomId: referencedRoomData.Id, Actor: "alice"}
+	joined, err = RoomMemberRepo.Create(ctx, again)
+	require.NoError(t, err)
+	assert.False(t, joined)
+	assert.Equal(t, member.Id, again.Id)
+	assert.Equal(t, member.JoinedAt, again.JoinedAt)
+
+	got, err := RoomMemberRepo.GetAllByRoomId(ctx, referencedRoomData.Id)
+	require.NoError(t, err)
+	assert.Equal(t, []*entities.RoomMember{member}, got)
+}
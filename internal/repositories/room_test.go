@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -13,8 +14,8 @@ import (
 func getRoomCount(t *testing.T) int {
 	var count int
 
-	query := "SELECT COUNT(*) FROM rooms"
-	err := RoomRepo.db.QueryRow(query).Scan(&count)
+	query := "SELECT COUNT(*) FROM rooms WHERE deleted_at IS NULL"
+	err := RoomRepo.db.QueryRowContext(context.Background(), query).Scan(&count)
 	require.NoError(t, err)
 
 	return count
@@ -24,11 +25,13 @@ func getRoomById(t *testing.T, id int) *entities.Room {
 	var room entities.Room
 	query := "SELECT * FROM rooms WHERE id = ?"
 
-	err := RoomRepo.db.QueryRow(query, id).Scan(
+	err := RoomRepo.db.QueryRowContext(context.Background(), query, id).Scan(
 		&room.Id,
 		&room.Name,
+		&room.ArchivedAt,
 		&room.CreatedAt,
 		&room.UpdatedAt,
+		&room.DeletedAt,
 	)
 	require.NoError(t, err)
 
@@ -42,15 +45,26 @@ func insertDummyRoom(t *testing.T, room *entities.Room) {
 		(?, ?, ?, ?)
 	`
 
-	res, err := RoomRepo.db.Exec(query, room.Id, room.Name, room.CreatedAt, room.UpdatedAt)
+	res, err := RoomRepo.db.ExecContext(context.Background(), query, room.Id, room.Name, room.CreatedAt, room.UpdatedAt)
 	require.NoError(t, err)
 	_, err = res.LastInsertId()
 	require.NoError(t, err)
+
+	for _, status := range entities.DefaultStatuses(room.Id) {
+		_, err := RoomRepo.db.ExecContext(context.Background(),
+			"INSERT INTO statuses (room_id, name, category, position) VALUES (?, ?, ?, ?)",
+			status.RoomId,
+			status.Name,
+			status.Category,
+			status.Position,
+		)
+		require.NoError(t, err)
+	}
 }
 
 func deleteAllRooms(t *testing.T) {
 	query := "DELETE FROM rooms"
-	_, err := RoomRepo.db.Exec(query)
+	_, err := RoomRepo.db.ExecContext(context.Background(), query)
 	require.NoError(t, err)
 }
 
@@ -113,7 +127,7 @@ func TestGetAllRooms(t *testing.T) {
 			tc.setup(t, tc.savedRooms)
 			defer deleteAllRooms(t)
 
-			rooms, err := RoomRepo.GetAll()
+			rooms, err := RoomRepo.GetAll(context.Background(), false)
 
 			assert.Equal(t, tc.expectedError, err)
 			assert.Equal(t, tc.expectedData, rooms)
@@ -165,7 +179,7 @@ func TestGetByIdRoom(t *testing.T) {
 			tc.setup(t, tc.savedRoom)
 			defer deleteAllRooms(t)
 
-			room, err := RoomRepo.GetById(tc.id)
+			room, err := RoomRepo.GetById(context.Background(), tc.id)
 
 			assert.Equal(t, tc.expectedError, err)
 			assert.Equal(t, tc.expectedData, room)
@@ -194,11 +208,17 @@ func TestCreateRoom(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			defer deleteAllRooms(t)
 
-			err := RoomRepo.Create(tc.room)
+			err := RoomRepo.Create(context.Background(), tc.room)
 
 			afterCount := getRoomCount(t)
 			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, len(entities.DefaultStatuses(0))*tc.expectedRecordCount, getStatusCount(t))
 			assert.Equal(t, tc.expectedRecordCount, afterCount)
+
+			stored, err := RoomRepo.GetById(context.Background(), tc.room.Id)
+			assert.NoError(t, err)
+			assertRoomHelper(t, stored, tc.room)
+			assert.Equal(t, stored.UpdatedAt, tc.room.UpdatedAt)
 		})
 	}
 }
@@ -262,7 +282,7 @@ func TestUpdateRoom(t *testing.T) {
 			tc.setup(t, tc.savedData)
 			defer deleteAllRooms(t)
 
-			err := RoomRepo.Update(tc.updateData)
+			err := RoomRepo.Update(context.Background(), tc.updateData)
 
 			assert.Equal(t, tc.expectedError, err)
 			if tc.expectedData != nil {
@@ -297,7 +317,7 @@ func TestDeleteRoom(t *testing.T) {
 		expectedRecordCount int
 	}{
 		{
-			name:     "Success to Delete room. the board associated with it is also deleted",
+			name:     "Success to Delete room. the board associated with it is also moved to the trash",
 			deleteId: savedRoom.Id,
 			setup: func(t *testing.T) {
 				insertDummyRoom(t, savedRoom)
@@ -324,7 +344,7 @@ func TestDeleteRoom(t *testing.T) {
 			defer deleteAllRooms(t)
 			defer deleteAllBoards(t)
 
-			err := RoomRepo.Delete(tc.deleteId)
+			err := RoomRepo.Delete(context.Background(), tc.deleteId)
 
 			afterRoomCount := getRoomCount(t)
 			afterBoardCount := getBoardCount(t)
@@ -334,3 +354,42 @@ func TestDeleteRoom(t *testing.T) {
 		})
 	}
 }
+
+func TestArchiveRoom(t *testing.T) {
+	ctx := context.Background()
+	insertDummyRoom(t, &entities.Room{
+		Id:        1,
+		Name:      "test room",
+		CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+	})
+	insertDummyBoard(t, &entities.Board{
+		Id:        1,
+		Name:      "test board",
+		RoomId:    1,
+		CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+	})
+	defer deleteAllRooms(t)
+	defer deleteAllBoards(t)
+
+	archivedAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, RoomRepo.UpdateArchivedAt(ctx, 1, &archivedAt))
+	assert.Equal(t, &archivedAt, getRoomById(t, 1).ArchivedAt)
+
+	rooms, err := RoomRepo.GetAll(ctx, false)
+	require.NoError(t, err)
+	assert.Empty(t, rooms)
+
+	rooms, err = RoomRepo.GetAll(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, rooms, 1)
+	assert.Equal(t, &archivedAt, rooms[0].ArchivedAt)
+
+	board, err := BoardRepo.GetById(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, board.RoomArchived)
+
+	require.NoError(t, RoomRepo.UpdateArchivedAt(ctx, 1, nil))
+	assert.Nil(t, getRoomById(t, 1).ArchivedAt)
+}
@@ -0,0 +1,309 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type StatusRepository struct {
+	db dbtx
+}
+
+func NewStatusRepository(db *sql.DB) *StatusRepository {
+	return &StatusRepository{
+		db: db,
+	}
+}
+
+func (sr *StatusRepository) GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.Status, error) {
+	query := `SELECT
+			id,
+			room_id,
+			name,
+			category,
+			position,
+			created_at,
+			updated_at
+		FROM
+			statuses
+		WHERE room_id = ?
+		ORDER BY position, id`
+
+	return sr.query(ctx, query, roomId)
+}
+
+func (sr *StatusRepository) GetAllByBoardId(ctx context.Context, boardId int) ([]*entities.Status, error) {
+	query := `SELECT
+			s.id,
+			s.room_id,
+			s.name,
+			s.category,
+			s.position,
+			s.created_at,
+			s.updated_at
+		FROM
+			statuses s
+			INNER JOIN boards b ON b.room_id = s.room_id
+		WHERE b.id = ? AND b.deleted_at IS NULL
+		ORDER BY s.position, s.id`
+
+	return sr.query(ctx, query, boardId)
+}
+
+func (sr *StatusRepository) GetById(ctx context.Context, id int) (*entities.Status, error) {
+	var status entities.Status
+	query := `SELECT
+			id,
+			room_id,
+			name,
+			category,
+			position,
+			created_at,
+			updated_at
+		FROM
+			statuses
+		WHERE id = ?`
+
+	if err := sr.db.QueryRowContext(ctx, query, id).Scan(
+		&status.Id,
+		&status.RoomId,
+		&status.Name,
+		&status.Category,
+		&status.Position,
+		&status.CreatedAt,
+		&status.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := sr.loadTransitions(ctx, []*entities.Status{&status}); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// GetByIdForUpdate is GetById locking the status until the transaction ends, which keeps todos
+// from moving into it meanwhile.
+func (sr *StatusRepository) GetByIdForUpdate(ctx context.Context, id int) (*entities.Status, error) {
+	query := `SELECT
+			id,
+			room_id,
+			name,
+			category,
+			position,
+			created_at,
+			updated_at
+		FROM
+			statuses
+		WHERE id = ?
+		FOR UPDATE`
+
+	statuses, err := sr.query(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return statuses[0], nil
+}
+
+// LockWorkflow locks the room until the transaction ends. Changes that may leave the room
+// without a status of a category take it first, so that two of them cannot both count on the
+// other status.
+func (sr *StatusRepository) LockWorkflow(ctx context.Context, roomId int) error {
+	var id int
+	query := "SELECT id FROM rooms WHERE id = ? FOR UPDATE"
+
+	return sr.db.QueryRowContext(ctx, query, roomId).Scan(&id)
+}
+
+func (sr *StatusRepository) CountTodos(ctx context.Context, id int) (int, error) {
+	var count int
+	query := "SELECT COUNT(*) FROM todos WHERE status_id = ?"
+
+	if err := sr.db.QueryRowContext(ctx, query, id).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Create appends the status to the end of the room's workflow and sets the id of status.
+func (sr *StatusRepository) Create(ctx context.Context, status *entities.Status) error {
+	query := `INSERT INTO statuses (room_id, name, category, position)
+		SELECT ?, ?, ?, COALESCE(MAX(position) + 1, 0) FROM statuses WHERE room_id = ?`
+
+	stmt, err := sr.db.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, status.RoomId, status.Name, status.Category, status.RoomId)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	status.Id = int(id)
+
+	return nil
+}
+
+func (sr *StatusRepository) Update(ctx context.Context, status *entities.Status) error {
+	query := "UPDATE statuses SET name = ?, category = ? WHERE id = ?"
+
+	stmt, err := sr.db.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, status.Name, status.Category, status.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Delete returns entities.ErrStatusInUse while todos are in the status, trashed ones included.
+func (sr *StatusRepository) Delete(ctx context.Context, id int) error {
+	query := "DELETE FROM statuses WHERE id = ?"
+
+	_, err := sr.db.ExecContext(ctx, query, id)
+	if isMySQLError(err, mysqlErrRowIsReferenced) {
+		return entities.ErrStatusInUse
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (sr *StatusRepository) query(ctx context.Context, query string, args ...any) ([]*entities.Status, error) {
+	stmt, err := sr.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var statuses []*entities.Status
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var s entities.Status
+		if err := rows.Scan(
+			&s.Id,
+			&s.RoomId,
+			&s.Name,
+			&s.Category,
+			&s.Position,
+			&s.CreatedAt,
+			&s.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := sr.loadTransitions(ctx, statuses); err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// SetTransitions replaces the statuses todos may move to from the status.
+func (sr *StatusRepository) SetTransitions(ctx context.Context, id int, toIds []int) error {
+	return inTx(ctx, sr.db, func(tx dbtx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM status_transitions WHERE from_status_id = ?", id); err != nil {
+			return err
+		}
+
+		stmt, err := tx.PrepareContext(ctx, "INSERT INTO status_transitions (from_status_id, to_status_id) VALUES (?, ?)")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, toId := range toIds {
+			if _, err := stmt.ExecContext(ctx, id, toId); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// loadTransitions sets the Transitions of statuses.
+func (sr *StatusRepository) loadTransitions(ctx context.Context, statuses []*entities.Status) error {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	byId := make(map[int]*entities.Status, len(statuses))
+	placeholders := make([]string, 0, len(statuses))
+	args := make([]any, 0, len(statuses))
+	for _, s := range statuses {
+		byId[s.Id] = s
+		placeholders = append(placeholders, "?")
+		args = append(args, s.Id)
+	}
+
+	query := `SELECT from_status_id, to_status_id FROM status_transitions
+		WHERE from_status_id IN (` + strings.Join(placeholders, ", ") + `)
+		ORDER BY from_status_id, to_status_id`
+
+	rows, err := sr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var fromId, toId int
+		if err := rows.Scan(&fromId, &toId); err != nil {
+			return err
+		}
+		byId[fromId].Transitions = append(byId[fromId].Transitions, toId)
+	}
+
+	return rows.Err()
+}
+
+func insertStatuses(ctx context.Context, tx dbtx, statuses []*entities.Status) error {
+	query := "INSERT INTO statuses (room_id, name, category, position) VALUES (?, ?, ?, ?)"
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, s := range statuses {
+		if _, err := stmt.ExecContext(ctx, s.RoomId, s.Name, s.Category, s.Position); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
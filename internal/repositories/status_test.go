@@ -0,0 +1,182 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getStatusCount(t *testing.T) int {
+	var count int
+
+	query := "SELECT COUNT(*) FROM statuses"
+	err := StatusRepo.db.QueryRowContext(context.Background(), query).Scan(&count)
+	require.NoError(t, err)
+
+	return count
+}
+
+func getStatusIdByBoardId(t *testing.T, boardId int, category string) int {
+	var id int
+	query := `SELECT s.id
+		FROM statuses s INNER JOIN boards b ON b.room_id = s.room_id
+		WHERE b.id = ? AND s.category = ?
+		ORDER BY s.position, s.id
+		LIMIT 1`
+
+	err := StatusRepo.db.QueryRowContext(context.Background(), query, boardId, category).Scan(&id)
+	require.NoError(t, err)
+
+	return id
+}
+
+func TestGetAllStatuses(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+
+	byRoom, err := StatusRepo.GetAllByRoomId(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, byRoom, 3)
+	assert.Equal(t, "To Do", byRoom[0].Name)
+	assert.Equal(t, entities.StatusCategoryTodo, byRoom[0].Category)
+	assert.Equal(t, entities.StatusCategoryDoing, byRoom[1].Category)
+	assert.Equal(t, entities.StatusCategoryDone, byRoom[2].Category)
+
+	byBoard, err := StatusRepo.GetAllByBoardId(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, byRoom, byBoard)
+
+	byOtherRoom, err := StatusRepo.GetAllByRoomId(context.Background(), 999)
+	require.NoError(t, err)
+	assert.Nil(t, byOtherRoom)
+}
+
+func TestCreateUpdateDeleteStatus(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+
+	require.NoError(t, StatusRepo.Create(context.Background(), entities.NewStatus(1, "In Review", entities.StatusCategoryDoing)))
+
+	statuses, err := StatusRepo.GetAllByRoomId(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, statuses, 4)
+	created := statuses[3]
+	assert.Equal(t, "In Review", created.Name)
+	assert.Equal(t, 3, created.Position)
+
+	created.UpdateAttributes("Review", entities.StatusCategoryDoing)
+	require.NoError(t, StatusRepo.Update(context.Background(), created))
+
+	updated, err := StatusRepo.GetById(context.Background(), created.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "Review", updated.Name)
+
+	require.NoError(t, StatusRepo.Delete(context.Background(), created.Id))
+	_, err = StatusRepo.GetById(context.Background(), created.Id)
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestGetByIdForUpdateStatus(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+
+	status, err := StatusRepo.GetByIdForUpdate(context.Background(), getStatusIdByBoardId(t, 1, entities.StatusCategoryDone))
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusCategoryDone, status.Category)
+
+	_, err = StatusRepo.GetByIdForUpdate(context.Background(), 999)
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestLockWorkflow(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+
+	assert.NoError(t, StatusRepo.LockWorkflow(context.Background(), 1))
+	assert.Equal(t, sql.ErrNoRows, StatusRepo.LockWorkflow(context.Background(), 999))
+}
+
+func TestCountTodosStatus(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+	defer deleteAllTodos(t)
+
+	todo := &entities.Todo{
+		Id:        1,
+		Title:     "open",
+		BoardId:   1,
+		CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+	}
+	insertDummyTodo(t, todo)
+
+	count, err := StatusRepo.CountTodos(context.Background(), todo.StatusId)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = StatusRepo.CountTodos(context.Background(), getStatusIdByBoardId(t, 1, entities.StatusCategoryDone))
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestDeleteStatusInUse(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+	defer deleteAllTodos(t)
+
+	todo := &entities.Todo{
+		Id:        1,
+		Title:     "open",
+		BoardId:   1,
+		CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+	}
+	insertDummyTodo(t, todo)
+	require.NoError(t, TodoRepo.Delete(context.Background(), 1))
+
+	err := StatusRepo.Delete(context.Background(), todo.StatusId)
+	assert.Equal(t, entities.ErrStatusInUse, err)
+
+	_, err = TrashRepo.GetTodoById(context.Background(), 1)
+	assert.NoError(t, err)
+}
+
+func TestSetTransitionsStatus(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+
+	statuses, err := StatusRepo.GetAllByRoomId(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	todo, doing, done := statuses[0], statuses[1], statuses[2]
+
+	require.NoError(t, StatusRepo.SetTransitions(context.Background(), todo.Id, []int{doing.Id}))
+	require.NoError(t, StatusRepo.SetTransitions(context.Background(), todo.Id, []int{done.Id, doing.Id}))
+
+	got, err := StatusRepo.GetById(context.Background(), todo.Id)
+	require.NoError(t, err)
+	assert.Equal(t, []int{doing.Id, done.Id}, got.Transitions)
+
+	statuses, err = StatusRepo.GetAllByRoomId(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int{doing.Id, done.Id}, statuses[0].Transitions)
+	assert.Nil(t, statuses[1].Transitions)
+
+	require.NoError(t, StatusRepo.SetTransitions(context.Background(), todo.Id, nil))
+	got, err = StatusRepo.GetById(context.Background(), todo.Id)
+	require.NoError(t, err)
+	assert.Nil(t, got.Transitions)
+}
@@ -0,0 +1,264 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+// SyncRepository reads the changes to rooms, boards and todos from their change_seq, and
+// those to purged ones from their tombstones.
+type SyncRepository struct {
+	db dbtx
+}
+
+func NewSyncRepository(db *sql.DB) *SyncRepository {
+	return &SyncRepository{
+		db: db,
+	}
+}
+
+// syncTables maps the synced entity types to their tables.
+var syncTables = map[string]string{
+	entities.SyncEntityRoom:  "rooms",
+	entities.SyncEntityBoard: "boards",
+	entities.SyncEntityTodo:  "todos",
+}
+
+// GetLastSeq returns the last change_seq handed out.
+func (sr *SyncRepository) GetLastSeq(ctx context.Context) (int64, error) {
+	query := "SELECT value FROM change_sequence WHERE id = 1"
+
+	var seq int64
+	if err := sr.db.QueryRowContext(ctx, query).Scan(&seq); err != nil {
+		return 0, err
+	}
+
+	return seq, nil
+}
+
+// GetChanges reads limit changes, then the rest of those sharing the change_seq of the last
+// one, which a room or board deleted or restored with its contents leaves behind.
+func (sr *SyncRepository) GetChanges(ctx context.Context, afterSeq int64, limit int) ([]*entities.SyncChange, error) {
+	changes, err := sr.getChanges(ctx, "change_seq > ?", afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) < limit {
+		return changes, sr.loadEntities(ctx, changes)
+	}
+
+	last := changes[len(changes)-1].Version
+	for len(changes) > 0 && changes[len(changes)-1].Version == last {
+		changes = changes[:len(changes)-1]
+	}
+
+	rest, err := sr.getChanges(ctx, "change_seq = ?", last, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, rest...)
+	return changes, sr.loadEntities(ctx, changes)
+}
+
+func (sr *SyncRepository) GetVersions(ctx context.Context, entityType string, ids []int) (map[int]int64, error) {
+	versions := make(map[int]int64, len(ids))
+	if len(ids) == 0 {
+		return versions, nil
+	}
+
+	table, ok := syncTables[entityType]
+	if !ok {
+		return nil, fmt.Errorf("unknown entity type %q", entityType)
+	}
+
+	in, args := inList(ids)
+	args = append(args, entityType)
+	args = append(args, args[:len(ids)]...)
+
+	query := `SELECT id, change_seq FROM ` + table + ` WHERE id IN (` + in + `)
+		UNION ALL
+		SELECT entity_id, change_seq FROM tombstones WHERE entity_type = ? AND entity_id IN (` + in + `)`
+
+	rows, err := sr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var version int64
+		if err := rows.Scan(&id, &version); err != nil {
+			return nil, err
+		}
+		versions[id] = version
+	}
+
+	return versions, rows.Err()
+}
+
+// ClaimVersion is the check of a sync push made part of its write: the row lock it takes
+// keeps others off the entity until the push commits.
+func (sr *SyncRepository) ClaimVersion(ctx context.Context, entityType string, id int, version int64) error {
+	table, ok := syncTables[entityType]
+	if !ok {
+		return fmt.Errorf("unknown entity type %q", entityType)
+	}
+
+	return inTx(ctx, sr.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := "UPDATE " + table + " SET change_seq = ? WHERE id = ? AND change_seq = ? AND deleted_at IS NULL"
+		res, err := tx.ExecContext(ctx, query, seq, id, version)
+		if err != nil {
+			return err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return entities.ErrSyncConflict
+		}
+
+		return nil
+	})
+}
+
+// getChanges reads the changes whose change_seq meets cond, all of them when limit is zero.
+func (sr *SyncRepository) getChanges(ctx context.Context, cond string, seq int64, limit int) ([]*entities.SyncChange, error) {
+	query := `SELECT entity_type, entity_id, change_seq FROM (
+			SELECT ? AS entity_type, id AS entity_id, change_seq FROM rooms WHERE ` + cond + `
+			UNION ALL
+			SELECT ?, id, change_seq FROM boards WHERE ` + cond + `
+			UNION ALL
+			SELECT ?, id, change_seq FROM todos WHERE ` + cond + `
+			UNION ALL
+			SELECT entity_type, entity_id, change_seq FROM tombstones WHERE ` + cond + `
+		) c
+		ORDER BY change_seq, entity_type, entity_id`
+	args := []any{
+		entities.SyncEntityRoom, seq,
+		entities.SyncEntityBoard, seq,
+		entities.SyncEntityTodo, seq,
+		seq,
+	}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := sr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []*entities.SyncChange
+	for rows.Next() {
+		var change entities.SyncChange
+		if err := rows.Scan(&change.EntityType, &change.EntityId, &change.Version); err != nil {
+			return nil, err
+		}
+		changes = append(changes, &change)
+	}
+
+	return changes, rows.Err()
+}
+
+// loadEntities sets the Entity of the changes to the live rooms, boards and todos, with one
+// query per entity type. Trashed and purged entities are left nil.
+func (sr *SyncRepository) loadEntities(ctx context.Context, changes []*entities.SyncChange) error {
+	ids := map[string][]int{}
+	for _, change := range changes {
+		ids[change.EntityType] = append(ids[change.EntityType], change.EntityId)
+	}
+
+	loaded := map[string]map[int]any{}
+	for entityType, entityIds := range ids {
+		var err error
+		if loaded[entityType], err = sr.getLive(ctx, entityType, entityIds); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range changes {
+		if entity, ok := loaded[change.EntityType][change.EntityId]; ok {
+			change.Entity = entity
+		}
+	}
+
+	return nil
+}
+
+// getLive maps the ids of the live entities of entityType among ids to them.
+func (sr *SyncRepository) getLive(ctx context.Context, entityType string, ids []int) (map[int]any, error) {
+	in, args := inList(ids)
+
+	var query string
+	switch entityType {
+	case entities.SyncEntityRoom:
+		query = "SELECT id, name, archived_at, created_at, updated_at FROM rooms WHERE id IN (" + in + ") AND deleted_at IS NULL"
+	case entities.SyncEntityBoard:
+		query = boardColumns + `
+		WHERE b.id IN (` + in + `) AND b.deleted_at IS NULL`
+	case entities.SyncEntityTodo:
+		query = todoColumns + `
+		WHERE t.id IN (` + in + `) AND t.deleted_at IS NULL`
+	default:
+		return nil, fmt.Errorf("unknown entity type %q", entityType)
+	}
+
+	rows, err := sr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	live := make(map[int]any, len(ids))
+	for rows.Next() {
+		switch entityType {
+		case entities.SyncEntityRoom:
+			var room entities.Room
+			if err := rows.Scan(&room.Id, &room.Name, &room.ArchivedAt, &room.CreatedAt, &room.UpdatedAt); err != nil {
+				return nil, err
+			}
+			live[room.Id] = &room
+		case entities.SyncEntityBoard:
+			board, err := scanBoard(rows)
+			if err != nil {
+				return nil, err
+			}
+			live[board.Id] = board
+		default:
+			todo, err := scanTodo(rows)
+			if err != nil {
+				return nil, err
+			}
+			live[todo.Id] = todo
+		}
+	}
+
+	return live, rows.Err()
+}
+
+// inList returns the placeholders of an IN list of ids and the ids as arguments.
+func inList(ids []int) (string, []any) {
+	placeholders := make([]string, 0, len(ids))
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+
+	return strings.Join(placeholders, ", "), args
+}
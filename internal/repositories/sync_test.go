@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deleteAllTombstones(t *testing.T) {
+	query := "DELETE FROM tombstones"
+	_, err := SyncRepo.db.ExecContext(context.Background(), query)
+	require.NoError(t, err)
+}
+
+func TestSyncChanges(t *testing.T) {
+	teardown := setupTrashReferences(t)
+	defer teardown()
+	defer deleteAllTombstones(t)
+
+	ctx := context.Background()
+	seq, err := SyncRepo.GetLastSeq(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, TodoRepo.UpdateRank(ctx, 1, "b"))
+	todo, err := TodoRepo.GetById(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "b", todo.Rank)
+
+	changes, err := SyncRepo.GetChanges(ctx, seq, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*entities.SyncChange{
+		{EntityType: entities.SyncEntityTodo, EntityId: 1, Version: seq + 1, Entity: todo},
+	}, changes)
+
+	// The room's boards and todos are deleted with its change_seq, and come on the same page
+	// even past the limit.
+	require.NoError(t, RoomRepo.Delete(ctx, 1))
+
+	changes, err = SyncRepo.GetChanges(ctx, seq, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []*entities.SyncChange{
+		{EntityType: entities.SyncEntityBoard, EntityId: 1, Version: seq + 2},
+		{EntityType: entities.SyncEntityBoard, EntityId: 2, Version: seq + 2},
+		{EntityType: entities.SyncEntityRoom, EntityId: 1, Version: seq + 2},
+		{EntityType: entities.SyncEntityTodo, EntityId: 1, Version: seq + 2},
+		{EntityType: entities.SyncEntityTodo, EntityId: 2, Version: seq + 2},
+	}, changes)
+
+	// Purging leaves tombstones behind.
+	_, err = TrashRepo.Purge(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	last, err := SyncRepo.GetLastSeq(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, seq+3, last)
+
+	changes, err = SyncRepo.GetChanges(ctx, seq+2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*entities.SyncChange{
+		{EntityType: entities.SyncEntityBoard, EntityId: 1, Version: seq + 3},
+		{EntityType: entities.SyncEntityBoard, EntityId: 2, Version: seq + 3},
+		{EntityType: entities.SyncEntityRoom, EntityId: 1, Version: seq + 3},
+		{EntityType: entities.SyncEntityTodo, EntityId: 1, Version: seq + 3},
+		{EntityType: entities.SyncEntityTodo, EntityId: 2, Version: seq + 3},
+	}, changes)
+
+	versions, err := SyncRepo.GetVersions(ctx, entities.SyncEntityTodo, []int{1, 5})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]int64{1: seq + 3}, versions)
+}
+
+func TestSyncClaimVersion(t *testing.T) {
+	teardown := setupTrashReferences(t)
+	defer teardown()
+
+	ctx := context.Background()
+	versions, err := SyncRepo.GetVersions(ctx, entities.SyncEntityTodo, []int{1})
+	require.NoError(t, err)
+
+	require.NoError(t, SyncRepo.ClaimVersion(ctx, entities.SyncEntityTodo, 1, versions[1]))
+
+	// The claim moved the todo on, so a second push from the same base conflicts.
+	err = SyncRepo.ClaimVersion(ctx, entities.SyncEntityTodo, 1, versions[1])
+	assert.ErrorIs(t, err, entities.ErrSyncConflict)
+
+	last, err := SyncRepo.GetLastSeq(ctx)
+	require.NoError(t, err)
+	versions, err = SyncRepo.GetVersions(ctx, entities.SyncEntityTodo, []int{1})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]int64{1: last}, versions)
+
+	require.NoError(t, TodoRepo.Delete(ctx, 1))
+	err = SyncRepo.ClaimVersion(ctx, entities.SyncEntityTodo, 1, last+1)
+	assert.ErrorIs(t, err, entities.ErrSyncConflict)
+}
+
+func TestSyncDerivedChanges(t *testing.T) {
+	teardown := setupTrashReferences(t)
+	defer teardown()
+
+	ctx := context.Background()
+	lastVersion := func(t *testing.T, entityType string, id int) int64 {
+		t.Helper()
+		versions, err := SyncRepo.GetVersions(ctx, entityType, []int{id})
+		require.NoError(t, err)
+		last, err := SyncRepo.GetLastSeq(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, last, versions[id])
+		return versions[id]
+	}
+
+	// The checklist progress of a todo is part of it.
+	item := &entities.ChecklistItem{TodoId: 1, Text: "step"}
+	require.NoError(t, ChecklistRepo.Create(ctx, item))
+	lastVersion(t, entities.SyncEntityTodo, 1)
+	item.Checked = true
+	require.NoError(t, ChecklistRepo.Update(ctx, item))
+	lastVersion(t, entities.SyncEntityTodo, 1)
+	require.NoError(t, ChecklistRepo.Delete(ctx, item.Id))
+	lastVersion(t, entities.SyncEntityTodo, 1)
+
+	// So is whether it is blocked, which also follows the blocker.
+	require.NoError(t, DependencyRepo.Create(ctx, &entities.TodoDependency{TodoId: 1, BlockerId: 2}))
+	lastVersion(t, entities.SyncEntityTodo, 1)
+	require.NoError(t, TodoRepo.Delete(ctx, 2))
+	lastVersion(t, entities.SyncEntityTodo, 1)
+	require.NoError(t, TrashRepo.RestoreTodo(ctx, 2))
+	lastVersion(t, entities.SyncEntityTodo, 1)
+	require.NoError(t, DependencyRepo.Delete(ctx, 1, 2))
+	lastVersion(t, entities.SyncEntityTodo, 1)
+
+	// And whether the room of a board is archived is part of the board.
+	archivedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, RoomRepo.UpdateArchivedAt(ctx, 1, &archivedAt))
+	assert.Equal(t, lastVersion(t, entities.SyncEntityBoard, 1), lastVersion(t, entities.SyncEntityBoard, 2))
+}
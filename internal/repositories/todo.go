@@ -1,13 +1,14 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
 )
 
 type TodoRepository struct {
-	db *sql.DB
+	db dbtx
 }
 
 func NewTodoRepository(db *sql.DB) *TodoRepository {
@@ -16,78 +17,415 @@ func NewTodoRepository(db *sql.DB) *TodoRepository {
 	}
 }
 
-func (tr *TodoRepository) GetById(id int) (*entities.Todo, error) {
-	var todo entities.Todo
-	query := `SELECT
-			id,
-			title,
-			done,
-			priority,
-			due_date,
-			board_id,
-			created_at,
-			updated_at
+// todoColumns selects a todo with its status category, checklist progress and blocked flag
+// from todos t joined to statuses s. rank is reserved in MySQL 8, so it is always quoted or qualified.
+const todoColumns = `SELECT
+			t.id,
+			t.title,
+			t.status_id,
+			s.category = 'done',
+			t.priority,
+			t.rank,
+			t.due_date,
+			t.completed_at,
+			t.recurrence_rule,
+			t.recurrence_timezone,
+			t.board_id,
+			t.created_at,
+			t.updated_at,
+			t.deleted_at,
+			(SELECT COUNT(*) FROM checklist_items WHERE todo_id = t.id AND checked = true),
+			(SELECT COUNT(*) FROM checklist_items WHERE todo_id = t.id),
+			EXISTS (
+				SELECT 1 FROM todo_dependencies d
+					INNER JOIN todos b ON b.id = d.blocker_id
+					INNER JOIN statuses bs ON bs.id = b.status_id
+				WHERE d.todo_id = t.id AND b.deleted_at IS NULL AND bs.category <> 'done'
+			)
 		FROM
-			todos
-		WHERE id = ?`
+			todos t
+			INNER JOIN statuses s ON s.id = t.status_id`
 
-	if err := tr.db.QueryRow(query, id).Scan(
-		&todo.Id,
-		&todo.Title,
-		&todo.Done,
-		&todo.Priority,
-		&todo.DueDate,
-		&todo.BoardId,
-		&todo.CreatedAt,
-		&todo.UpdatedAt,
-	); err != nil {
+func (tr *TodoRepository) GetAllByBoardId(ctx context.Context, boardId int) ([]*entities.Todo, error) {
+	query := todoColumns + `
+		WHERE t.board_id = ? AND t.deleted_at IS NULL
+		ORDER BY t.rank, t.id`
+
+	stmt, err := tr.db.PrepareContext(ctx, query)
+	if err != nil {
 		return nil, err
 	}
+	defer stmt.Close()
 
-	return &todo, nil
+	var todos []*entities.Todo
+	rows, err := stmt.QueryContext(ctx, boardId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		todo, err := scanTodo(rows)
+		if err != nil {
+			return nil, err
+		}
+		todos = append(todos, todo)
+	}
+
+	return todos, nil
 }
 
-func (tr *TodoRepository) Create(todo *entities.Todo) error {
-	query := "INSERT INTO todos (title, done, priority, due_date, board_id) VALUES (?, ?, ?, ?, ?)"
+func (tr *TodoRepository) GetById(ctx context.Context, id int) (*entities.Todo, error) {
+	query := todoColumns + `
+		WHERE t.id = ? AND t.deleted_at IS NULL`
 
-	stmt, err := tr.db.Prepare(query)
-	if err != nil {
-		return err
+	return scanTodo(tr.db.QueryRowContext(ctx, query, id))
+}
+
+// GetByIdForUpdate is GetById locking the todo until the transaction ends.
+func (tr *TodoRepository) GetByIdForUpdate(ctx context.Context, id int) (*entities.Todo, error) {
+	query := todoColumns + `
+		WHERE t.id = ? AND t.deleted_at IS NULL
+		FOR UPDATE OF t`
+
+	return scanTodo(tr.db.QueryRowContext(ctx, query, id))
+}
+
+// LockBoard locks the board until the transaction ends. Every change to the ranks of a board
+// takes it first, so that ranks computed from the current ones are not handed out twice.
+func (tr *TodoRepository) LockBoard(ctx context.Context, boardId int) error {
+	var id int
+	query := "SELECT id FROM boards WHERE id = ? FOR UPDATE"
+
+	return tr.db.QueryRowContext(ctx, query, boardId).Scan(&id)
+}
+
+// GetLastRank returns the highest rank in the board, or "" when the board is empty.
+func (tr *TodoRepository) GetLastRank(ctx context.Context, boardId int) (string, error) {
+	var rank sql.NullString
+	query := "SELECT MAX(`rank`) FROM todos WHERE board_id = ?"
+
+	if err := tr.db.QueryRowContext(ctx, query, boardId).Scan(&rank); err != nil {
+		return "", err
 	}
-	defer stmt.Close()
 
-	_, err = stmt.Exec(todo.Title, todo.Done, todo.Priority, todo.DueDate, todo.BoardId)
+	return rank.String, nil
+}
+
+// GetNextRank returns the lowest rank above rank in the board, ignoring excludeId,
+// or "" when there is none.
+func (tr *TodoRepository) GetNextRank(ctx context.Context, boardId int, rank string, excludeId int) (string, error) {
+	var next sql.NullString
+	query := "SELECT MIN(`rank`) FROM todos WHERE board_id = ? AND `rank` > ? AND id <> ?"
+
+	if err := tr.db.QueryRowContext(ctx, query, boardId, rank, excludeId).Scan(&next); err != nil {
+		return "", err
+	}
+
+	return next.String, nil
+}
+
+// GetPrevRank returns the highest rank below rank in the board, ignoring excludeId,
+// or "" when there is none.
+func (tr *TodoRepository) GetPrevRank(ctx context.Context, boardId int, rank string, excludeId int) (string, error) {
+	var prev sql.NullString
+	query := "SELECT MAX(`rank`) FROM todos WHERE board_id = ? AND `rank` < ? AND id <> ?"
+
+	if err := tr.db.QueryRowContext(ctx, query, boardId, rank, excludeId).Scan(&prev); err != nil {
+		return "", err
+	}
+
+	return prev.String, nil
+}
+
+// Create sets the id and timestamps of todo.
+func (tr *TodoRepository) Create(ctx context.Context, todo *entities.Todo) error {
+	return inTx(ctx, tr.db, func(tx dbtx) error {
+		res, err := insertTodo(ctx, tx, todo)
+		if err != nil {
+			return err
+		}
+
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		todo.Id = int(id)
+		query := "SELECT created_at, updated_at FROM todos WHERE id = ?"
+		return tx.QueryRowContext(ctx, query, id).Scan(&todo.CreatedAt, &todo.UpdatedAt)
+	})
+}
+
+func (tr *TodoRepository) Update(ctx context.Context, todo *entities.Todo) error {
+	return inTx(ctx, tr.db, func(tx dbtx) error {
+		return updateTodo(ctx, tx, todo)
+	})
+}
+
+// MoveToBoard writes the todo's new board, status and rank. Dependencies never cross rooms,
+// so the ones left spanning two rooms by the move are dropped.
+func (tr *TodoRepository) MoveToBoard(ctx context.Context, todo *entities.Todo) error {
+	return inTx(ctx, tr.db, func(tx dbtx) error {
+		return moveTodo(ctx, tx, todo)
+	})
+}
+
+// Copy inserts todo as a copy of sourceId together with the source's checklist,
+// and sets todo.Id to the id of the new row.
+func (tr *TodoRepository) Copy(ctx context.Context, sourceId int, todo *entities.Todo) error {
+	var id int64
+	err := inTx(ctx, tr.db, func(tx dbtx) error {
+		res, err := insertTodo(ctx, tx, todo)
+		if err != nil {
+			return err
+		}
+
+		id, err = res.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		query := `INSERT INTO checklist_items (todo_id, text, checked, position)
+			SELECT ?, text, checked, position FROM checklist_items WHERE todo_id = ? ORDER BY position, id`
+		_, err = tx.ExecContext(ctx, query, id, sourceId)
+		return err
+	})
 	if err != nil {
 		return err
 	}
 
+	todo.Id = int(id)
 	return nil
 }
 
-func (tr *TodoRepository) Update(todo *entities.Todo) error {
-	query := "UPDATE todos SET title = ?, done = ?, priority = ?, due_date = ? WHERE id = ?"
+// Delete moves the todo to the trash.
+func (tr *TodoRepository) Delete(ctx context.Context, id int) error {
+	return inTx(ctx, tr.db, func(tx dbtx) error {
+		return deleteTodo(ctx, tx, id)
+	})
+}
+
+func (tr *TodoRepository) UpdateRank(ctx context.Context, id int, rank string) error {
+	return inTx(ctx, tr.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := "UPDATE todos SET `rank` = ?, change_seq = ? WHERE id = ?"
+		_, err = tx.ExecContext(ctx, query, rank, seq, id)
+		return err
+	})
+}
+
+// Rebalance rewrites every rank in the board to evenly spaced short keys, keeping the order.
+func (tr *TodoRepository) Rebalance(ctx context.Context, boardId int) error {
+	return inTx(ctx, tr.db, func(tx dbtx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT id FROM todos WHERE board_id = ? ORDER BY `rank`, id FOR UPDATE", boardId)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var ids []int
+		for rows.Next() {
+			var id int
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		rows.Close()
+
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		stmt, err := tx.PrepareContext(ctx, "UPDATE todos SET `rank` = ?, change_seq = ? WHERE id = ?")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for i, rank := range entities.EvenRanks(len(ids)) {
+			if _, err := stmt.ExecContext(ctx, rank, seq, ids[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// insertTodo, updateTodo, moveTodo and deleteTodo take the change_seq of the write, so they
+// run in a transaction.
+func insertTodo(ctx context.Context, tx dbtx, todo *entities.Todo) (sql.Result, error) {
+	seq, err := pendingChangeSeq(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
 
-	stmt, err := tr.db.Prepare(query)
+	query := `INSERT INTO todos
+		(title, status_id, priority, todos.rank, due_date, completed_at, recurrence_rule, recurrence_timezone, board_id, change_seq)
+	VALUES
+		(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	rule, timezone := recurrenceColumns(todo.Recurrence)
+	return tx.ExecContext(ctx, query, todo.Title, todo.StatusId, todo.Priority, todo.Rank, todo.DueDate, todo.CompletedAt, rule, timezone, todo.BoardId, seq)
+}
+
+func updateTodo(ctx context.Context, tx dbtx, todo *entities.Todo) error {
+	seq, err := pendingChangeSeq(ctx, tx)
 	if err != nil {
 		return err
 	}
-	defer stmt.Close()
 
-	_, err = stmt.Exec(todo.Title, todo.Done, todo.Priority, todo.DueDate, todo.Id)
+	query := `UPDATE todos SET
+			title = ?,
+			status_id = ?,
+			priority = ?,
+			todos.rank = ?,
+			due_date = ?,
+			completed_at = ?,
+			recurrence_rule = ?,
+			recurrence_timezone = ?,
+			change_seq = ?
+		WHERE id = ?`
+
+	rule, timezone := recurrenceColumns(todo.Recurrence)
+	if _, err := tx.ExecContext(ctx, query, todo.Title, todo.StatusId, todo.Priority, todo.Rank, todo.DueDate, todo.CompletedAt, rule, timezone, seq, todo.Id); err != nil {
+		return err
+	}
+
+	return touchDependents(ctx, tx, "b.id = ?", todo.Id)
+}
+
+func moveTodo(ctx context.Context, tx dbtx, todo *entities.Todo) error {
+	seq, err := pendingChangeSeq(ctx, tx)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	query := `UPDATE todos SET
+			board_id = ?,
+			status_id = ?,
+			todos.rank = ?,
+			completed_at = ?,
+			change_seq = ?
+		WHERE id = ?`
+	if _, err := tx.ExecContext(ctx, query, todo.BoardId, todo.StatusId, todo.Rank, todo.CompletedAt, seq, todo.Id); err != nil {
+		return err
+	}
+	if err := touchDependents(ctx, tx, "b.id = ?", todo.Id); err != nil {
+		return err
+	}
+
+	query = `DELETE d FROM
+			todo_dependencies d
+			INNER JOIN todos t ON t.id = d.todo_id
+			INNER JOIN boards tb ON tb.id = t.board_id
+			INNER JOIN todos b ON b.id = d.blocker_id
+			INNER JOIN boards bb ON bb.id = b.board_id
+		WHERE (d.todo_id = ? OR d.blocker_id = ?) AND tb.room_id <> bb.room_id`
+	_, err = tx.ExecContext(ctx, query, todo.Id, todo.Id)
+	return err
 }
 
-func (tr *TodoRepository) Delete(id int) error {
-	query := "DELETE FROM todos WHERE id = ?"
+func deleteTodo(ctx context.Context, tx dbtx, id int) error {
+	seq, err := pendingChangeSeq(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE todos SET deleted_at = CURRENT_TIMESTAMP, change_seq = ? WHERE id = ? AND deleted_at IS NULL"
+	if _, err := tx.ExecContext(ctx, query, seq, id); err != nil {
+		return err
+	}
+
+	return touchDependents(ctx, tx, "b.id = ?", id)
+}
 
-	_, err := tr.db.Exec(query, id)
+// touchTodo moves the todo on to the change of tx, for writes that change what is read along
+// with it, its checklist progress and whether it is blocked, without writing the todo itself.
+func touchTodo(ctx context.Context, tx dbtx, id int) error {
+	seq, err := pendingChangeSeq(ctx, tx)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	_, err = tx.ExecContext(ctx, "UPDATE todos SET change_seq = ? WHERE id = ? AND deleted_at IS NULL", seq, id)
+	return err
+}
+
+// touchDependents moves the live todos blocked by the todos b that cond selects on to the
+// change of tx, since whether they are blocked follows the status of b and whether b is
+// trashed.
+func touchDependents(ctx context.Context, tx dbtx, cond string, args ...any) error {
+	seq, err := pendingChangeSeq(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE todos t
+			INNER JOIN todo_dependencies d ON d.todo_id = t.id
+			INNER JOIN todos b ON b.id = d.blocker_id
+		SET t.change_seq = ?
+		WHERE t.deleted_at IS NULL AND ` + cond
+	_, err = tx.ExecContext(ctx, query, append([]any{seq}, args...)...)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTodo(row rowScanner) (*entities.Todo, error) {
+	var todo entities.Todo
+	var recurrenceRule, recurrenceTimezone sql.NullString
+
+	if err := row.Scan(
+		&todo.Id,
+		&todo.Title,
+		&todo.StatusId,
+		&todo.Done,
+		&todo.Priority,
+		&todo.Rank,
+		&todo.DueDate,
+		&todo.CompletedAt,
+		&recurrenceRule,
+		&recurrenceTimezone,
+		&todo.BoardId,
+		&todo.CreatedAt,
+		&todo.UpdatedAt,
+		&todo.DeletedAt,
+		&todo.ChecklistProgress.Checked,
+		&todo.ChecklistProgress.Total,
+		&todo.Blocked,
+	); err != nil {
+		return nil, err
+	}
+
+	if recurrenceRule.Valid {
+		todo.Recurrence = &entities.Recurrence{
+			Rule:     recurrenceRule.String,
+			Timezone: recurrenceTimezone.String,
+		}
+	}
+
+	return &todo, nil
+}
+
+func recurrenceColumns(recurrence *entities.Recurrence) (rule, timezone sql.NullString) {
+	if recurrence == nil {
+		return rule, timezone
+	}
+
+	rule = sql.NullString{String: recurrence.Rule, Valid: true}
+	timezone = sql.NullString{String: recurrence.Timezone, Valid: recurrence.Timezone != ""}
+	return rule, timezone
 }
@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+const todoRevisionColumns = `SELECT
+			id,
+			todo_id,
+			version,
+			title,
+			status_id,
+			priority,
+			due_date,
+			recurrence_rule,
+			recurrence_timezone,
+			actor,
+			created_at
+		FROM
+			todo_revisions`
+
+type TodoRevisionRepository struct {
+	db dbtx
+}
+
+func NewTodoRevisionRepository(db *sql.DB) *TodoRevisionRepository {
+	return &TodoRevisionRepository{
+		db: db,
+	}
+}
+
+// GetAllByTodoId returns the revisions of the todo, oldest first.
+func (rr *TodoRevisionRepository) GetAllByTodoId(ctx context.Context, todoId int) ([]*entities.TodoRevision, error) {
+	query := todoRevisionColumns + `
+		WHERE todo_id = ?
+		ORDER BY version`
+
+	rows, err := rr.db.QueryContext(ctx, query, todoId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*entities.TodoRevision
+	for rows.Next() {
+		revision, err := scanTodoRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (rr *TodoRevisionRepository) GetByVersion(ctx context.Context, todoId, version int) (*entities.TodoRevision, error) {
+	query := todoRevisionColumns + `
+		WHERE todo_id = ? AND version = ?`
+
+	return scanTodoRevision(rr.db.QueryRowContext(ctx, query, todoId, version))
+}
+
+// Create stores the revision as the next version of its todo and sets its id and version.
+// Revisions of a todo are written in the transaction that changes the todo, whose row lock
+// keeps two writers from taking the same version.
+func (rr *TodoRevisionRepository) Create(ctx context.Context, revision *entities.TodoRevision) error {
+	query := `INSERT INTO todo_revisions
+			(todo_id, version, title, status_id, priority, due_date, recurrence_rule, recurrence_timezone, actor)
+		SELECT ?, COALESCE(MAX(version), 0) + 1, ?, ?, ?, ?, ?, ?, ?
+		FROM todo_revisions
+		WHERE todo_id = ?`
+
+	rule, timezone := recurrenceColumns(revision.Recurrence)
+	res, err := rr.db.ExecContext(ctx, query,
+		revision.TodoId,
+		revision.Title,
+		revision.StatusId,
+		revision.Priority,
+		revision.DueDate,
+		rule,
+		timezone,
+		revision.Actor,
+		revision.TodoId,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	revision.Id = int(id)
+	query = "SELECT version, created_at FROM todo_revisions WHERE id = ?"
+	return rr.db.QueryRowContext(ctx, query, id).Scan(&revision.Version, &revision.CreatedAt)
+}
+
+func scanTodoRevision(row rowScanner) (*entities.TodoRevision, error) {
+	var revision entities.TodoRevision
+	var recurrenceRule, recurrenceTimezone sql.NullString
+
+	if err := row.Scan(
+		&revision.Id,
+		&revision.TodoId,
+		&revision.Version,
+		&revision.Title,
+		&revision.StatusId,
+		&revision.Priority,
+		&revision.DueDate,
+		&recurrenceRule,
+		&recurrenceTimezone,
+		&revision.Actor,
+		&revision.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if recurrenceRule.Valid {
+		revision.Recurrence = &entities.Recurrence{
+			Rule:     recurrenceRule.String,
+			Timezone: recurrenceTimezone.String,
+		}
+	}
+
+	return &revision, nil
+}
@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deleteAllTodoRevisions(t *testing.T) {
+	query := "DELETE FROM todo_revisions"
+	_, err := RevisionRepo.db.ExecContext(context.Background(), query)
+	require.NoError(t, err)
+}
+
+func TestTodoRevisions(t *testing.T) {
+	teardown := setupChecklistReferences(t)
+	defer teardown()
+	defer deleteAllTodoRevisions(t)
+
+	ctx := context.Background()
+	dueDate := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+	revisions := []*entities.TodoRevision{
+		{TodoId: 1, Title: "first", StatusId: 1, Actor: "alice"},
+		{TodoId: 1, Title: "second", StatusId: 2, Priority: 1, DueDate: &dueDate,
+			Recurrence: &entities.Recurrence{Rule: "FREQ=WEEKLY", Timezone: "Asia/Tokyo"}},
+	}
+	for i, revision := range revisions {
+		require.NoError(t, RevisionRepo.Create(ctx, revision))
+		assert.NotZero(t, revision.Id)
+		assert.Equal(t, i+1, revision.Version)
+	}
+
+	got, err := RevisionRepo.GetAllByTodoId(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "first", got[0].Title)
+	assert.Equal(t, "alice", got[0].Actor)
+	assert.Nil(t, got[0].Recurrence)
+	assert.Equal(t, 2, got[1].Version)
+	assert.Equal(t, &dueDate, got[1].DueDate)
+	assert.Equal(t, &entities.Recurrence{Rule: "FREQ=WEEKLY", Timezone: "Asia/Tokyo"}, got[1].Recurrence)
+
+	revision, err := RevisionRepo.GetByVersion(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "second", revision.Title)
+
+	_, err = RevisionRepo.GetByVersion(ctx, 1, 3)
+	assert.Equal(t, sql.ErrNoRows, err)
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -22,8 +23,8 @@ var referencedBoardData = entities.Board{
 func getTodoCount(t *testing.T) int {
 	var count int
 
-	query := "SELECT COUNT(*) FROM todos"
-	err := TodoRepo.db.QueryRow(query).Scan(&count)
+	query := "SELECT COUNT(*) FROM todos WHERE deleted_at IS NULL"
+	err := TodoRepo.db.QueryRowContext(context.Background(), query).Scan(&count)
 	require.NoError(t, err)
 
 	return count
@@ -32,24 +33,31 @@ func getTodoCount(t *testing.T) int {
 func getTodoById(t *testing.T, id int) *entities.Todo {
 	var todo entities.Todo
 	query := `SELECT
-		id,
-		title,
-		done,
-		priority,
-		due_date,
-		board_id,
-		created_at,
-		updated_at
+		t.id,
+		t.title,
+		t.status_id,
+		s.category = 'done',
+		t.priority,
+		t.rank,
+		t.due_date,
+		t.completed_at,
+		t.board_id,
+		t.created_at,
+		t.updated_at
 	FROM
-		todos
-	WHERE id = ?`
+		todos t
+		INNER JOIN statuses s ON s.id = t.status_id
+	WHERE t.id = ?`
 
-	err := TodoRepo.db.QueryRow(query, id).Scan(
+	err := TodoRepo.db.QueryRowContext(context.Background(), query, id).Scan(
 		&todo.Id,
 		&todo.Title,
+		&todo.StatusId,
 		&todo.Done,
 		&todo.Priority,
+		&todo.Rank,
 		&todo.DueDate,
+		&todo.CompletedAt,
 		&todo.BoardId,
 		&todo.CreatedAt,
 		&todo.UpdatedAt,
@@ -59,20 +67,32 @@ func getTodoById(t *testing.T, id int) *entities.Todo {
 	return &todo
 }
 
+// insertDummyTodo places the todo in the room's first status matching Done unless StatusId is set,
+// and writes the chosen status back to todo.StatusId.
 func insertDummyTodo(t *testing.T, todo *entities.Todo) {
+	if todo.StatusId == 0 {
+		category := entities.StatusCategoryTodo
+		if todo.Done {
+			category = entities.StatusCategoryDone
+		}
+		todo.StatusId = getStatusIdByBoardId(t, todo.BoardId, category)
+	}
+
 	query := `INSERT INTO todos
-		(id, title, done, priority, due_date, board_id, created_at, updated_at)
+		(id, title, status_id, priority, todos.rank, due_date, completed_at, board_id, created_at, updated_at)
 	VALUES
-		(?, ?, ?, ?, ?, ?, ?, ?)
+		(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	res, err := TodoRepo.db.Exec(
+	res, err := TodoRepo.db.ExecContext(context.Background(),
 		query,
 		todo.Id,
 		todo.Title,
-		todo.Done,
+		todo.StatusId,
 		todo.Priority,
+		todo.Rank,
 		todo.DueDate,
+		todo.CompletedAt,
 		todo.BoardId,
 		todo.CreatedAt,
 		todo.UpdatedAt,
@@ -84,7 +104,7 @@ func insertDummyTodo(t *testing.T, todo *entities.Todo) {
 
 func deleteAllTodos(t *testing.T) {
 	query := "DELETE FROM todos"
-	_, err := TodoRepo.db.Exec(query)
+	_, err := TodoRepo.db.ExecContext(context.Background(), query)
 	require.NoError(t, err)
 }
 
@@ -142,8 +162,11 @@ func TestGetByIdTodo(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setup(t, tc.savedTodo)
 			defer deleteAllTodos(t)
+			if tc.expectedData != nil {
+				tc.expectedData.StatusId = tc.savedTodo.StatusId
+			}
 
-			todo, err := TodoRepo.GetById(tc.id)
+			todo, err := TodoRepo.GetById(context.Background(), tc.id)
 
 			assert.Equal(t, tc.expectedError, err)
 			assert.Equal(t, tc.expectedData, todo)
@@ -151,6 +174,39 @@ func TestGetByIdTodo(t *testing.T) {
 	}
 }
 
+func TestGetByIdForUpdateTodo(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+	defer deleteAllTodos(t)
+
+	insertDummyTodo(t, &entities.Todo{
+		Id:        1,
+		BoardId:   1,
+		Title:     "task",
+		CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+	})
+
+	todo, err := TodoRepo.GetByIdForUpdate(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "task", todo.Title)
+
+	_, err = TodoRepo.GetByIdForUpdate(context.Background(), 999)
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestLockBoard(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+
+	assert.NoError(t, TodoRepo.LockBoard(context.Background(), 1))
+	assert.Equal(t, sql.ErrNoRows, TodoRepo.LockBoard(context.Background(), 999))
+}
+
 func TestCreateTodo(t *testing.T) {
 	insertDummyRoom(t, &referencedRoomData)
 	defer deleteAllRooms(t)
@@ -181,12 +237,17 @@ func TestCreateTodo(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			defer deleteAllTodos(t)
+			tc.todo.StatusId = getStatusIdByBoardId(t, tc.todo.BoardId, entities.StatusCategoryTodo)
 
-			err := TodoRepo.Create(tc.todo)
+			err := TodoRepo.Create(context.Background(), tc.todo)
 
 			afterCount := getTodoCount(t)
 			assert.Equal(t, tc.expectedError, err)
 			assert.Equal(t, tc.expectedRecordCount, afterCount)
+
+			stored := getTodoById(t, tc.todo.Id)
+			assert.Equal(t, stored.CreatedAt, tc.todo.CreatedAt)
+			assert.Equal(t, stored.UpdatedAt, tc.todo.UpdatedAt)
 		})
 	}
 }
@@ -197,7 +258,9 @@ func assertTodoHelper(t *testing.T, expected, actual *entities.Todo) {
 	assert.Equal(t, expected.Id, actual.Id)
 	assert.Equal(t, expected.Title, actual.Title)
 	assert.Equal(t, expected.Done, actual.Done)
+	assert.Equal(t, expected.CompletedAt, actual.CompletedAt)
 	assert.Equal(t, expected.Priority, actual.Priority)
+	assert.Equal(t, expected.Rank, actual.Rank)
 	assert.Equal(t, expected.DueDate, actual.DueDate)
 	assert.Equal(t, expected.BoardId, actual.BoardId)
 	assert.Equal(t, expected.CreatedAt, actual.CreatedAt)
@@ -233,6 +296,7 @@ func TestUpdateTodo(t *testing.T) {
 				Title:     "updated!!",
 				Done:      true,
 				Priority:  0,
+				Rank:      "V",
 				BoardId:   1,
 				CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
 			},
@@ -245,6 +309,7 @@ func TestUpdateTodo(t *testing.T) {
 				Title:     "updated!!",
 				Done:      true,
 				Priority:  0,
+				Rank:      "V",
 				BoardId:   1,
 				CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
 			},
@@ -270,8 +335,9 @@ func TestUpdateTodo(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setup(t, tc.savedData)
 			defer deleteAllTodos(t)
+			tc.updateData.StatusId = tc.savedData.StatusId
 
-			err := TodoRepo.Update(tc.updateData)
+			err := TodoRepo.Update(context.Background(), tc.updateData)
 
 			assert.Equal(t, tc.expectedError, err)
 			if tc.expectedData != nil {
@@ -330,7 +396,7 @@ func TestDeleteTodo(t *testing.T) {
 			tc.setup(t)
 			defer deleteAllTodos(t)
 
-			err := TodoRepo.Delete(tc.deleteId)
+			err := TodoRepo.Delete(context.Background(), tc.deleteId)
 
 			afterCount := getTodoCount(t)
 			assert.Equal(t, tc.expectedError, err)
@@ -338,3 +404,294 @@ func TestDeleteTodo(t *testing.T) {
 		})
 	}
 }
+
+func TestRecurrenceRoundTripTodo(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+	defer deleteAllTodos(t)
+
+	dueDate := time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC)
+	todo := &entities.Todo{
+		Title:      "weekly chore",
+		BoardId:    1,
+		StatusId:   getStatusIdByBoardId(t, 1, entities.StatusCategoryTodo),
+		DueDate:    &dueDate,
+		Recurrence: &entities.Recurrence{Rule: "FREQ=WEEKLY;COUNT=3", Timezone: "Asia/Tokyo"},
+	}
+	require.NoError(t, TodoRepo.Create(context.Background(), todo))
+
+	var id int
+	require.NoError(t, TodoRepo.db.QueryRowContext(context.Background(), "SELECT id FROM todos WHERE title = ?", todo.Title).Scan(&id))
+
+	saved, err := TodoRepo.GetById(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, todo.Recurrence, saved.Recurrence)
+
+	saved.Recurrence = nil
+	require.NoError(t, TodoRepo.Update(context.Background(), saved))
+
+	updated, err := TodoRepo.GetById(context.Background(), id)
+	require.NoError(t, err)
+	assert.Nil(t, updated.Recurrence)
+}
+
+func TestCompletedAtRoundTripTodo(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+	defer deleteAllTodos(t)
+
+	saved := &entities.Todo{
+		Id:        1,
+		Title:     "in progress",
+		BoardId:   1,
+		CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+	}
+	insertDummyTodo(t, saved)
+
+	todo, err := TodoRepo.GetById(context.Background(), 1)
+	require.NoError(t, err)
+	assert.False(t, todo.Done)
+	assert.Nil(t, todo.CompletedAt)
+
+	completedAt := time.Date(2025, 6, 28, 9, 0, 0, 0, time.UTC)
+	todo.Transition(&entities.Status{
+		Id:       getStatusIdByBoardId(t, 1, entities.StatusCategoryDone),
+		Category: entities.StatusCategoryDone,
+	}, completedAt)
+	require.NoError(t, TodoRepo.Update(context.Background(), todo))
+
+	updated, err := TodoRepo.GetById(context.Background(), 1)
+	require.NoError(t, err)
+	assert.True(t, updated.Done)
+	assert.Equal(t, &completedAt, updated.CompletedAt)
+}
+
+func insertRankedTodos(t *testing.T, ranks ...string) {
+	t.Helper()
+
+	for i, rank := range ranks {
+		insertDummyTodo(t, &entities.Todo{
+			Id:        i + 1,
+			Title:     "ranked " + rank,
+			Rank:      rank,
+			BoardId:   1,
+			CreatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		})
+	}
+}
+
+func TestGetAllByBoardIdTodo(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+	defer deleteAllTodos(t)
+
+	// Binary collation sorts upper case before lower case.
+	insertRankedTodos(t, "a", "V", "Vb", "1")
+
+	todos, err := TodoRepo.GetAllByBoardId(context.Background(), 1)
+	require.NoError(t, err)
+
+	var ids []int
+	for _, todo := range todos {
+		ids = append(ids, todo.Id)
+	}
+	assert.Equal(t, []int{4, 2, 3, 1}, ids)
+
+	todos, err = TodoRepo.GetAllByBoardId(context.Background(), 999)
+	require.NoError(t, err)
+	assert.Empty(t, todos)
+}
+
+func TestRankNeighboursTodo(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+	defer deleteAllTodos(t)
+
+	last, err := TodoRepo.GetLastRank(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "", last)
+
+	insertRankedTodos(t, "A", "B", "C")
+
+	last, err = TodoRepo.GetLastRank(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "C", last)
+
+	next, err := TodoRepo.GetNextRank(context.Background(), 1, "A", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "B", next)
+
+	next, err = TodoRepo.GetNextRank(context.Background(), 1, "A", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "C", next)
+
+	next, err = TodoRepo.GetNextRank(context.Background(), 1, "C", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "", next)
+
+	prev, err := TodoRepo.GetPrevRank(context.Background(), 1, "C", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "A", prev)
+
+	prev, err = TodoRepo.GetPrevRank(context.Background(), 1, "A", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "", prev)
+}
+
+func TestRebalanceTodo(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	insertDummyBoard(t, &referencedBoardData)
+	defer deleteAllBoards(t)
+	defer deleteAllTodos(t)
+
+	insertRankedTodos(t, "A", "A00001", "A000011", "B")
+	require.NoError(t, TodoRepo.UpdateRank(context.Background(), 4, "A000001"))
+
+	require.NoError(t, TodoRepo.Rebalance(context.Background(), 1))
+
+	todos, err := TodoRepo.GetAllByBoardId(context.Background(), 1)
+	require.NoError(t, err)
+
+	var ranks []string
+	for _, todo := range todos {
+		ranks = append(ranks, todo.Rank)
+	}
+	assert.Equal(t, entities.EvenRanks(4), ranks)
+
+	var ids []int
+	for _, todo := range todos {
+		ids = append(ids, todo.Id)
+	}
+	assert.Equal(t, []int{1, 4, 2, 3}, ids)
+}
+
+func setupTransferReferences(t *testing.T) func() {
+	otherRoom := entities.Room{Id: 2, Name: "otherRoom", CreatedAt: referencedRoomData.CreatedAt, UpdatedAt: referencedRoomData.UpdatedAt}
+	sameRoomBoard := referencedBoardData
+	sameRoomBoard.Id = 2
+	otherRoomBoard := referencedBoardData
+	otherRoomBoard.Id = 3
+	otherRoomBoard.RoomId = 2
+
+	insertDummyRoom(t, &referencedRoomData)
+	insertDummyRoom(t, &otherRoom)
+	for _, board := range []*entities.Board{&referencedBoardData, &sameRoomBoard, &otherRoomBoard} {
+		insertDummyBoard(t, board)
+	}
+	for _, todo := range []*entities.Todo{
+		{Id: 1, Title: "moved", BoardId: 1, Rank: "A"},
+		{Id: 2, Title: "blocker", BoardId: 1, Rank: "B"},
+	} {
+		todo.CreatedAt = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		todo.UpdatedAt = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		insertDummyTodo(t, todo)
+	}
+
+	return func() {
+		deleteAllTodos(t)
+		deleteAllBoards(t)
+		deleteAllRooms(t)
+	}
+}
+
+func TestMoveToBoardTodo(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		targetBoardId        int
+		expectedDependencies int
+	}{
+		{
+			name:                 "Keeps dependencies when moving within the room",
+			targetBoardId:        2,
+			expectedDependencies: 1,
+		},
+		{
+			name:                 "Drops dependencies when moving to another room",
+			targetBoardId:        3,
+			expectedDependencies: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			teardown := setupTransferReferences(t)
+			defer teardown()
+			defer deleteAllDependencies(t)
+			require.NoError(t, DependencyRepo.Create(context.Background(), &entities.TodoDependency{TodoId: 1, BlockerId: 2}))
+
+			todo, err := TodoRepo.GetById(context.Background(), 1)
+			require.NoError(t, err)
+			todo.BoardId = tc.targetBoardId
+			todo.StatusId = getStatusIdByBoardId(t, tc.targetBoardId, entities.StatusCategoryTodo)
+			todo.Rank = "V"
+
+			err = TodoRepo.MoveToBoard(context.Background(), todo)
+
+			assert.NoError(t, err)
+			actual := getTodoById(t, 1)
+			assert.Equal(t, tc.targetBoardId, actual.BoardId)
+			assert.Equal(t, todo.StatusId, actual.StatusId)
+			assert.Equal(t, "V", actual.Rank)
+
+			dependencies, err := DependencyRepo.GetAllByTodoId(context.Background(), 1)
+			require.NoError(t, err)
+			assert.Len(t, dependencies, tc.expectedDependencies)
+		})
+	}
+}
+
+func TestCopyTodo(t *testing.T) {
+	teardown := setupTransferReferences(t)
+	defer teardown()
+	defer deleteAllChecklistItems(t)
+
+	for i, text := range []string{"first", "second"} {
+		insertDummyChecklistItem(t, &entities.ChecklistItem{
+			Id:        i + 1,
+			TodoId:    1,
+			Text:      text,
+			Checked:   i == 0,
+			Position:  i,
+			CreatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		})
+	}
+
+	source, err := TodoRepo.GetById(context.Background(), 1)
+	require.NoError(t, err)
+	copied := source.CopyTo(3)
+	copied.StatusId = getStatusIdByBoardId(t, 3, entities.StatusCategoryTodo)
+	copied.Rank = "V"
+
+	err = TodoRepo.Copy(context.Background(), source.Id, copied)
+
+	require.NoError(t, err)
+	assert.NotEqual(t, source.Id, copied.Id)
+
+	actual, err := TodoRepo.GetById(context.Background(), copied.Id)
+	require.NoError(t, err)
+	assert.Equal(t, 3, actual.BoardId)
+	assert.Equal(t, "moved", actual.Title)
+	assert.Equal(t, entities.ChecklistProgress{Checked: 1, Total: 2}, actual.ChecklistProgress)
+
+	items, err := ChecklistRepo.GetAllByTodoId(context.Background(), copied.Id)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "first", items[0].Text)
+	assert.Equal(t, "second", items[1].Text)
+
+	original, err := ChecklistRepo.GetAllByTodoId(context.Background(), source.Id)
+	require.NoError(t, err)
+	assert.Len(t, original, 2)
+}
@@ -0,0 +1,323 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type TrashRepository struct {
+	db dbtx
+}
+
+func NewTrashRepository(db *sql.DB) *TrashRepository {
+	return &TrashRepository{
+		db: db,
+	}
+}
+
+// GetAll lists the trashed rooms, boards and todos that were deleted directly, most recent first.
+func (tr *TrashRepository) GetAll(ctx context.Context) (*entities.Trash, error) {
+	var trash entities.Trash
+
+	query := `SELECT id, name, archived_at, created_at, updated_at, deleted_at
+		FROM rooms
+		WHERE deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id`
+	rows, err := tr.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		room, err := scanTrashedRoom(rows)
+		if err != nil {
+			return nil, err
+		}
+		trash.Rooms = append(trash.Rooms, room)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	query = `SELECT b.id, b.name, b.priority, b.position, b.room_id, b.archived_at, b.created_at, b.updated_at, b.deleted_at
+		FROM boards b
+		WHERE b.deleted_at IS NOT NULL AND NOT b.deleted_by_cascade
+		ORDER BY b.deleted_at DESC, b.id`
+	rows, err = tr.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		board, err := scanTrashedBoard(rows)
+		if err != nil {
+			return nil, err
+		}
+		trash.Boards = append(trash.Boards, board)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	query = todoColumns + `
+		WHERE t.deleted_at IS NOT NULL AND NOT t.deleted_by_cascade
+		ORDER BY t.deleted_at DESC, t.id`
+	rows, err = tr.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		todo, err := scanTodo(rows)
+		if err != nil {
+			return nil, err
+		}
+		trash.Todos = append(trash.Todos, todo)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &trash, nil
+}
+
+func (tr *TrashRepository) GetRoomById(ctx context.Context, id int) (*entities.Room, error) {
+	query := "SELECT id, name, archived_at, created_at, updated_at, deleted_at FROM rooms WHERE id = ? AND deleted_at IS NOT NULL"
+
+	return scanTrashedRoom(tr.db.QueryRowContext(ctx, query, id))
+}
+
+func (tr *TrashRepository) GetBoardById(ctx context.Context, id int) (*entities.Board, error) {
+	query := `SELECT id, name, priority, position, room_id, archived_at, created_at, updated_at, deleted_at
+		FROM boards
+		WHERE id = ? AND deleted_at IS NOT NULL`
+
+	return scanTrashedBoard(tr.db.QueryRowContext(ctx, query, id))
+}
+
+func (tr *TrashRepository) GetTodoById(ctx context.Context, id int) (*entities.Todo, error) {
+	query := todoColumns + `
+		WHERE t.id = ? AND t.deleted_at IS NOT NULL`
+
+	return scanTodo(tr.db.QueryRowContext(ctx, query, id))
+}
+
+// RestoreRoom takes the room out of the trash with the boards and todos deleted along with it.
+// Todos go with a board only when the board went with the room, since those of a board trashed
+// earlier were deleted along with that board instead.
+func (tr *TrashRepository) RestoreRoom(ctx context.Context, id int) error {
+	return inTx(ctx, tr.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := `UPDATE todos t
+				INNER JOIN boards b ON b.id = t.board_id
+			SET t.deleted_at = NULL, t.deleted_by_cascade = FALSE, t.change_seq = ?
+			WHERE b.room_id = ? AND b.deleted_by_cascade AND t.deleted_by_cascade`
+		if _, err := tx.ExecContext(ctx, query, seq, id); err != nil {
+			return err
+		}
+
+		query = `UPDATE boards
+			SET deleted_at = NULL, deleted_by_cascade = FALSE, change_seq = ?
+			WHERE room_id = ? AND deleted_by_cascade`
+		if _, err := tx.ExecContext(ctx, query, seq, id); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE rooms SET deleted_at = NULL, change_seq = ? WHERE id = ?", seq, id)
+		return err
+	})
+}
+
+// RestoreBoard takes the board out of the trash with the todos deleted along with it.
+func (tr *TrashRepository) RestoreBoard(ctx context.Context, id int) error {
+	return inTx(ctx, tr.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := `UPDATE todos
+			SET deleted_at = NULL, deleted_by_cascade = FALSE, change_seq = ?
+			WHERE board_id = ? AND deleted_by_cascade`
+		if _, err := tx.ExecContext(ctx, query, seq, id); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE boards SET deleted_at = NULL, change_seq = ? WHERE id = ?", seq, id); err != nil {
+			return err
+		}
+
+		return touchDependents(ctx, tx, "b.board_id = ?", id)
+	})
+}
+
+func (tr *TrashRepository) RestoreTodo(ctx context.Context, id int) error {
+	return inTx(ctx, tr.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		query := "UPDATE todos SET deleted_at = NULL, deleted_by_cascade = FALSE, change_seq = ? WHERE id = ?"
+		if _, err := tx.ExecContext(ctx, query, seq, id); err != nil {
+			return err
+		}
+
+		return touchDependents(ctx, tx, "b.id = ?", id)
+	})
+}
+
+// Purge permanently deletes what was trashed before the given time and returns the number of
+// rooms, boards and todos removed directly, leaving out the children that go with them.
+// Each of them, children included, leaves a tombstone with a new change_seq, so that clients
+// syncing changes learn about the removal.
+func (tr *TrashRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	var purged int64
+	err := inTx(ctx, tr.db, func(tx dbtx) error {
+		seq, err := pendingChangeSeq(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		tombstones := []struct {
+			query string
+			args  []any
+		}{
+			{
+				query: `INSERT INTO tombstones (entity_type, entity_id, change_seq)
+					SELECT ?, id, ? FROM rooms WHERE deleted_at < ?`,
+				args: []any{entities.SyncEntityRoom, seq, before},
+			},
+			{
+				query: `INSERT INTO tombstones (entity_type, entity_id, change_seq)
+					SELECT ?, b.id, ?
+					FROM
+						boards b
+						INNER JOIN rooms r ON r.id = b.room_id
+					WHERE b.deleted_at < ? OR r.deleted_at < ?`,
+				args: []any{entities.SyncEntityBoard, seq, before, before},
+			},
+			{
+				query: `INSERT INTO tombstones (entity_type, entity_id, change_seq)
+					SELECT ?, t.id, ?
+					FROM
+						todos t
+						INNER JOIN boards b ON b.id = t.board_id
+						INNER JOIN rooms r ON r.id = b.room_id
+					WHERE t.deleted_at < ? OR b.deleted_at < ? OR r.deleted_at < ?`,
+				args: []any{entities.SyncEntityTodo, seq, before, before, before},
+			},
+		}
+		for _, tombstone := range tombstones {
+			if _, err := tx.ExecContext(ctx, tombstone.query, tombstone.args...); err != nil {
+				return err
+			}
+		}
+
+		// Todos restrict the deletion of their status, which goes with the room, so children
+		// are deleted before their parents rather than left to ON DELETE CASCADE.
+		deletions := []struct {
+			query  string
+			args   []any
+			direct bool
+		}{
+			{
+				query: `DELETE t FROM
+						todos t
+						INNER JOIN boards b ON b.id = t.board_id
+						INNER JOIN rooms r ON r.id = b.room_id
+					WHERE b.deleted_at < ? OR r.deleted_at < ?`,
+				args: []any{before, before},
+			},
+			{
+				query:  "DELETE FROM todos WHERE deleted_at < ?",
+				args:   []any{before},
+				direct: true,
+			},
+			{
+				query: `DELETE b FROM
+						boards b
+						INNER JOIN rooms r ON r.id = b.room_id
+					WHERE r.deleted_at < ?`,
+				args: []any{before},
+			},
+			{
+				query:  "DELETE FROM boards WHERE deleted_at < ?",
+				args:   []any{before},
+				direct: true,
+			},
+			{
+				query:  "DELETE FROM rooms WHERE deleted_at < ?",
+				args:   []any{before},
+				direct: true,
+			},
+		}
+		for _, deletion := range deletions {
+			res, err := tx.ExecContext(ctx, deletion.query, deletion.args...)
+			if err != nil {
+				return err
+			}
+			if !deletion.direct {
+				continue
+			}
+
+			n, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			purged += n
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(purged), nil
+}
+
+func scanTrashedRoom(row rowScanner) (*entities.Room, error) {
+	var room entities.Room
+	if err := row.Scan(
+		&room.Id,
+		&room.Name,
+		&room.ArchivedAt,
+		&room.CreatedAt,
+		&room.UpdatedAt,
+		&room.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &room, nil
+}
+
+func scanTrashedBoard(row rowScanner) (*entities.Board, error) {
+	var board entities.Board
+	if err := row.Scan(
+		&board.Id,
+		&board.Name,
+		&board.Priority,
+		&board.Position,
+		&board.RoomId,
+		&board.ArchivedAt,
+		&board.CreatedAt,
+		&board.UpdatedAt,
+		&board.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &board, nil
+}
@@ -0,0 +1,194 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTrashReferences(t *testing.T) func() {
+	insertDummyRoom(t, &referencedRoomData)
+	for _, board := range []*entities.Board{
+		{Id: 1, Name: "board 1", RoomId: 1},
+		{Id: 2, Name: "board 2", RoomId: 1},
+	} {
+		board.CreatedAt = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		board.UpdatedAt = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		insertDummyBoard(t, board)
+	}
+	for _, todo := range []*entities.Todo{
+		{Id: 1, Title: "todo 1", BoardId: 1, Rank: "a"},
+		{Id: 2, Title: "todo 2", BoardId: 2, Rank: "a"},
+	} {
+		todo.CreatedAt = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		todo.UpdatedAt = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		insertDummyTodo(t, todo)
+	}
+
+	return func() {
+		deleteAllTodos(t)
+		deleteAllBoards(t)
+		deleteAllRooms(t)
+	}
+}
+
+// setDeletedAt backdates a trashed row so that it is told apart from later deletions.
+func setDeletedAt(t *testing.T, table string, id int, deletedAt time.Time) {
+	_, err := TrashRepo.db.ExecContext(context.Background(), "UPDATE "+table+" SET deleted_at = ? WHERE id = ?", deletedAt, id)
+	require.NoError(t, err)
+}
+
+func TestTrashAndRestoreRoom(t *testing.T) {
+	teardown := setupTrashReferences(t)
+	defer teardown()
+
+	ctx := context.Background()
+	require.NoError(t, TodoRepo.Delete(ctx, 2))
+	setDeletedAt(t, "todos", 2, time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, RoomRepo.Delete(ctx, 1))
+
+	_, err := RoomRepo.GetById(ctx, 1)
+	assert.Equal(t, sql.ErrNoRows, err)
+	boards, err := BoardRepo.GetAllByRoomId(ctx, 1, false)
+	require.NoError(t, err)
+	assert.Empty(t, boards)
+	_, err = TodoRepo.GetById(ctx, 1)
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	trash, err := TrashRepo.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, trash.Rooms, 1)
+	assert.Equal(t, 1, trash.Rooms[0].Id)
+	assert.NotNil(t, trash.Rooms[0].DeletedAt)
+	assert.Empty(t, trash.Boards)
+	require.Len(t, trash.Todos, 1)
+	assert.Equal(t, 2, trash.Todos[0].Id)
+
+	err = BoardRepo.Create(ctx, entities.NewBoard("new board", 0, 1))
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	require.NoError(t, TrashRepo.RestoreRoom(ctx, 1))
+
+	room, err := RoomRepo.GetById(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, room.DeletedAt)
+	boards, err = BoardRepo.GetAllByRoomId(ctx, 1, false)
+	require.NoError(t, err)
+	assert.Len(t, boards, 2)
+	todos, err := TodoRepo.GetAllByBoardId(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, todos, 1)
+	todos, err = TodoRepo.GetAllByBoardId(ctx, 2)
+	require.NoError(t, err)
+	assert.Empty(t, todos)
+
+	todo, err := TrashRepo.GetTodoById(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC), *todo.DeletedAt)
+}
+
+func TestTrashAndRestoreBoard(t *testing.T) {
+	teardown := setupTrashReferences(t)
+	defer teardown()
+
+	ctx := context.Background()
+	require.NoError(t, BoardRepo.Delete(ctx, 1))
+
+	board, err := TrashRepo.GetBoardById(ctx, 1)
+	require.NoError(t, err)
+	assert.NotNil(t, board.DeletedAt)
+	_, err = TrashRepo.GetBoardById(ctx, 2)
+	assert.Equal(t, sql.ErrNoRows, err)
+	todo, err := TrashRepo.GetTodoById(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, *board.DeletedAt, *todo.DeletedAt)
+
+	trash, err := TrashRepo.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, trash.Rooms)
+	require.Len(t, trash.Boards, 1)
+	assert.Equal(t, 1, trash.Boards[0].Id)
+	assert.Empty(t, trash.Todos)
+
+	require.NoError(t, TrashRepo.RestoreBoard(ctx, 1))
+
+	_, err = BoardRepo.GetById(ctx, 1)
+	require.NoError(t, err)
+	_, err = TodoRepo.GetById(ctx, 1)
+	require.NoError(t, err)
+}
+
+func TestRestoreBoardWithTodoTrashedInSameSecond(t *testing.T) {
+	teardown := setupTrashReferences(t)
+	defer teardown()
+
+	ctx := context.Background()
+	require.NoError(t, TodoRepo.Delete(ctx, 1))
+	require.NoError(t, BoardRepo.Delete(ctx, 1))
+
+	trash, err := TrashRepo.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, trash.Boards, 1)
+	require.Len(t, trash.Todos, 1)
+	assert.Equal(t, 1, trash.Todos[0].Id)
+
+	require.NoError(t, TrashRepo.RestoreBoard(ctx, 1))
+
+	_, err = BoardRepo.GetById(ctx, 1)
+	require.NoError(t, err)
+	_, err = TrashRepo.GetTodoById(ctx, 1)
+	require.NoError(t, err)
+}
+
+func TestTrashAndRestoreTodo(t *testing.T) {
+	teardown := setupTrashReferences(t)
+	defer teardown()
+
+	ctx := context.Background()
+	require.NoError(t, TodoRepo.Delete(ctx, 1))
+
+	_, err := TodoRepo.GetById(ctx, 1)
+	assert.Equal(t, sql.ErrNoRows, err)
+	todos, err := TodoRepo.GetAllByBoardId(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, todos)
+
+	require.NoError(t, TrashRepo.RestoreTodo(ctx, 1))
+
+	todo, err := TodoRepo.GetById(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, todo.DeletedAt)
+	_, err = TrashRepo.GetTodoById(ctx, 1)
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestPurgeTrash(t *testing.T) {
+	teardown := setupTrashReferences(t)
+	defer teardown()
+	defer deleteAllTombstones(t)
+
+	ctx := context.Background()
+	expired := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, BoardRepo.Delete(ctx, 1))
+	setDeletedAt(t, "boards", 1, expired)
+	setDeletedAt(t, "todos", 1, expired)
+	require.NoError(t, TodoRepo.Delete(ctx, 2))
+
+	purged, err := TrashRepo.Purge(ctx, time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	_, err = TrashRepo.GetBoardById(ctx, 1)
+	assert.Equal(t, sql.ErrNoRows, err)
+	_, err = TrashRepo.GetTodoById(ctx, 1)
+	assert.Equal(t, sql.ErrNoRows, err)
+	_, err = TrashRepo.GetTodoById(ctx, 2)
+	assert.NoError(t, err)
+	_, err = BoardRepo.GetById(ctx, 2)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// dbtx is implemented by both *sql.DB and *sql.Tx, so a repository works the same
+// whether it is used on its own or bound to a unit of work.
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+var errNoTx = errors.New("write outside of a transaction")
+
+// changeTx is the transaction of every write. It numbers the changes made in it from the
+// change sequence only in commit, see pendingChangeSeq.
+type changeTx struct {
+	*sql.Tx
+	pendingSeq int64
+	atCommit   []func(ctx context.Context, tx dbtx) error
+}
+
+func beginTx(ctx context.Context, db *sql.DB) (*changeTx, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &changeTx{Tx: tx}, nil
+}
+
+// commit takes the next number of the change sequence when anything was written with the
+// pending one, swaps it in, runs what was queued with onCommit and commits.
+func (ct *changeTx) commit(ctx context.Context) error {
+	if ct.pendingSeq != 0 {
+		seq, err := nextChangeSeq(ctx, ct.Tx)
+		if err != nil {
+			return err
+		}
+
+		for _, table := range []string{"rooms", "boards", "todos", "tombstones"} {
+			query := "UPDATE " + table + " SET change_seq = ? WHERE change_seq = ?"
+			if _, err := ct.ExecContext(ctx, query, seq, ct.pendingSeq); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, fn := range ct.atCommit {
+		if err := fn(ctx, ct); err != nil {
+			return err
+		}
+	}
+
+	return ct.Tx.Commit()
+}
+
+// inTx runs fn in a new transaction, or directly in db when it already is one.
+func inTx(ctx context.Context, db dbtx, fn func(tx dbtx) error) error {
+	conn, ok := db.(*sql.DB)
+	if !ok {
+		return fn(db)
+	}
+
+	tx, err := beginTx(ctx, conn)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	return tx.commit(ctx)
+}
+
+// onCommit queues fn to run in commit of tx, after its changes got their number.
+func onCommit(tx dbtx, fn func(ctx context.Context, tx dbtx) error) error {
+	ct, ok := tx.(*changeTx)
+	if !ok {
+		return errNoTx
+	}
+
+	ct.atCommit = append(ct.atCommit, fn)
+	return nil
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+const unitOfWorkMaxAttempts = 3
+
+type UnitOfWork struct {
+	db *sql.DB
+}
+
+func NewUnitOfWork(db *sql.DB) *UnitOfWork {
+	return &UnitOfWork{
+		db: db,
+	}
+}
+
+// Do retries fn from the start when MySQL rolls the transaction back to break a deadlock.
+func (uow *UnitOfWork) Do(ctx context.Context, fn func(repos *interfaces.Repositories) error) error {
+	var err error
+	for attempt := 0; attempt < unitOfWorkMaxAttempts; attempt++ {
+		if err = uow.run(ctx, fn); !isMySQLError(err, mysqlErrDeadlock) {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (uow *UnitOfWork) run(ctx context.Context, fn func(repos *interfaces.Repositories) error) error {
+	tx, err := beginTx(ctx, uow.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(newRepositories(tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.commit(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func newRepositories(db dbtx) *interfaces.Repositories {
+	return &interfaces.Repositories{
+		Rooms:            &RoomRepository{db: db},
+		Boards:           &BoardRepository{db: db},
+		Statuses:         &StatusRepository{db: db},
+		Todos:            &TodoRepository{db: db},
+		Revisions:        &TodoRevisionRepository{db: db},
+		Activities:       &ActivityRepository{db: db},
+		Checklists:       &ChecklistRepository{db: db},
+		Dependencies:     &DependencyRepository{db: db},
+		Reminders:        &ReminderRepository{db: db},
+		Trash:            &TrashRepository{db: db},
+		Audits:           &AuditRepository{db: db},
+		Outbox:           &OutboxRepository{db: db},
+		Webhooks:         &WebhookRepository{db: db},
+		IncomingWebhooks: &IncomingWebhookRepository{db: db},
+		Sync:             &SyncRepository{db: db},
+		Comments:         &CommentRepository{db: db},
+		Members:          &RoomMemberRepository{db: db},
+	}
+}
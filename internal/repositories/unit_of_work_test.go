@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitOfWork(t *testing.T) {
+	ctx := context.Background()
+	teardown := setupTransferReferences(t)
+	defer teardown()
+
+	todoStatusId := getStatusIdByBoardId(t, 1, entities.StatusCategoryTodo)
+	doneStatusId := getStatusIdByBoardId(t, 1, entities.StatusCategoryDone)
+
+	t.Run("Success to commit every change", func(t *testing.T) {
+		todo := getTodoById(t, 1)
+		todo.StatusId = doneStatusId
+		todo.Title = "closed"
+
+		err := UnitOfWorkRepo.Do(ctx, func(repos *interfaces.Repositories) error {
+			if err := repos.Todos.Update(ctx, todo); err != nil {
+				return err
+			}
+			if err := repos.Todos.Create(ctx, &entities.Todo{Title: "spawned", BoardId: 1, StatusId: todoStatusId, Rank: "C"}); err != nil {
+				return err
+			}
+			return repos.Todos.Delete(ctx, 2)
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "closed", getTodoById(t, 1).Title)
+		assert.Equal(t, 2, getTodoCount(t))
+		_, err = TodoRepo.GetById(context.Background(), 2)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("Failed to write anything - Due to an error", func(t *testing.T) {
+		todo := getTodoById(t, 1)
+		todo.Title = "rolled back"
+
+		err := UnitOfWorkRepo.Do(ctx, func(repos *interfaces.Repositories) error {
+			if err := repos.Todos.Update(ctx, todo); err != nil {
+				return err
+			}
+			return repos.Todos.Create(ctx, &entities.Todo{Title: "orphan", BoardId: 999, StatusId: todoStatusId})
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, "closed", getTodoById(t, 1).Title)
+		assert.Equal(t, 2, getTodoCount(t))
+	})
+
+	t.Run("Failed to write anything - Due to a panic", func(t *testing.T) {
+		todo := getTodoById(t, 1)
+		todo.Title = "rolled back"
+
+		assert.Panics(t, func() {
+			UnitOfWorkRepo.Do(ctx, func(repos *interfaces.Repositories) error {
+				if err := repos.Todos.Update(ctx, todo); err != nil {
+					return err
+				}
+				panic("boom")
+			})
+		})
+		assert.Equal(t, "closed", getTodoById(t, 1).Title)
+	})
+
+	t.Run("Success to number the changes at commit", func(t *testing.T) {
+		defer deleteAllOutbox(t)
+
+		seq, err := SyncRepo.GetLastSeq(ctx)
+		require.NoError(t, err)
+
+		todo := getTodoById(t, 1)
+		todo.Title = "numbered"
+		event, err := entities.NewOutboxEvent(1, entities.EventTodoUpdated, todo)
+		require.NoError(t, err)
+
+		err = UnitOfWorkRepo.Do(ctx, func(repos *interfaces.Repositories) error {
+			if err := repos.Todos.Update(ctx, todo); err != nil {
+				return err
+			}
+			if err := repos.Todos.UpdateRank(ctx, todo.Id, "B"); err != nil {
+				return err
+			}
+			if err := repos.Outbox.Create(ctx, event); err != nil {
+				return err
+			}
+			assert.Zero(t, event.Id)
+			return nil
+		})
+
+		require.NoError(t, err)
+		versions, err := SyncRepo.GetVersions(ctx, entities.SyncEntityTodo, []int{1})
+		require.NoError(t, err)
+		assert.Equal(t, map[int]int64{1: seq + 1}, versions)
+		assert.Equal(t, seq+2, event.Id)
+	})
+
+	t.Run("Success to retry after a deadlock", func(t *testing.T) {
+		attempts := 0
+		err := UnitOfWorkRepo.Do(ctx, func(repos *interfaces.Repositories) error {
+			attempts++
+			if attempts == 1 {
+				return &mysql.MySQLError{Number: mysqlErrDeadlock}
+			}
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("Failed to commit - Due to deadlocks on every attempt", func(t *testing.T) {
+		attempts := 0
+		err := UnitOfWorkRepo.Do(ctx, func(repos *interfaces.Repositories) error {
+			attempts++
+			return &mysql.MySQLError{Number: mysqlErrDeadlock}
+		})
+
+		assert.True(t, isMySQLError(err, mysqlErrDeadlock))
+		assert.Equal(t, unitOfWorkMaxAttempts, attempts)
+	})
+
+	t.Run("Failed to commit - Due to an error that is not retried", func(t *testing.T) {
+		attempts := 0
+		expected := errors.New("failed")
+		err := UnitOfWorkRepo.Do(ctx, func(repos *interfaces.Repositories) error {
+			attempts++
+			return expected
+		})
+
+		assert.Equal(t, expected, err)
+		assert.Equal(t, 1, attempts)
+	})
+}
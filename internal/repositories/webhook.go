@@ -0,0 +1,384 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+type WebhookRepository struct {
+	db dbtx
+}
+
+func NewWebhookRepository(db *sql.DB) *WebhookRepository {
+	return &WebhookRepository{
+		db: db,
+	}
+}
+
+func (wr *WebhookRepository) GetAllByRoomId(ctx context.Context, roomId int) ([]*entities.Webhook, error) {
+	query := `SELECT
+			id,
+			room_id,
+			url,
+			secret,
+			event_types,
+			failure_count,
+			disabled_at,
+			created_at,
+			updated_at
+		FROM
+			webhooks
+		WHERE room_id = ?
+		ORDER BY id`
+
+	rows, err := wr.db.QueryContext(ctx, query, roomId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []*entities.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+func (wr *WebhookRepository) GetById(ctx context.Context, id int) (*entities.Webhook, error) {
+	query := `SELECT
+			id,
+			room_id,
+			url,
+			secret,
+			event_types,
+			failure_count,
+			disabled_at,
+			created_at,
+			updated_at
+		FROM
+			webhooks
+		WHERE id = ?`
+
+	return scanWebhook(wr.db.QueryRowContext(ctx, query, id))
+}
+
+// Create sets the id and the timestamps of webhook.
+func (wr *WebhookRepository) Create(ctx context.Context, webhook *entities.Webhook) error {
+	eventTypes, err := json.Marshal(webhook.EventTypes)
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT INTO webhooks (room_id, url, secret, event_types) VALUES (?, ?, ?, ?)"
+
+	res, err := wr.db.ExecContext(ctx, query, webhook.RoomId, webhook.URL, webhook.Secret, eventTypes)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	webhook.Id = int(id)
+
+	query = "SELECT created_at, updated_at FROM webhooks WHERE id = ?"
+	return wr.db.QueryRowContext(ctx, query, id).Scan(&webhook.CreatedAt, &webhook.UpdatedAt)
+}
+
+func (wr *WebhookRepository) Update(ctx context.Context, webhook *entities.Webhook) error {
+	eventTypes, err := json.Marshal(webhook.EventTypes)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE webhooks
+		SET url = ?, secret = ?, event_types = ?, failure_count = ?, disabled_at = ?
+		WHERE id = ?`
+
+	_, err = wr.db.ExecContext(ctx, query, webhook.URL, webhook.Secret, eventTypes, webhook.FailureCount, webhook.DisabledAt, webhook.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (wr *WebhookRepository) Delete(ctx context.Context, id int) error {
+	query := "DELETE FROM webhooks WHERE id = ?"
+
+	_, err := wr.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Enqueue runs in the transaction of the change that raised event, so that a rolled back
+// change delivers nothing. It waits for the commit, where the event gets its id.
+func (wr *WebhookRepository) Enqueue(ctx context.Context, event *entities.OutboxEvent) error {
+	return inTx(ctx, wr.db, func(tx dbtx) error {
+		return onCommit(tx, func(ctx context.Context, tx dbtx) error {
+			query := `INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+				SELECT id, ?, ?, ?
+				FROM webhooks
+				WHERE room_id = ?
+					AND disabled_at IS NULL
+					AND JSON_CONTAINS(event_types, JSON_QUOTE(?))`
+
+			_, err := tx.ExecContext(ctx, query, event.Id, event.Type, event.Payload, event.RoomId, event.Type)
+			return err
+		})
+	})
+}
+
+// CreateDelivery sets the id and the creation time of delivery. The claim keeps the
+// dispatchers from sending it as well, until it goes stale.
+func (wr *WebhookRepository) CreateDelivery(ctx context.Context, delivery *entities.WebhookDelivery, claimedAt time.Time) error {
+	query := `INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, claimed_at)
+		VALUES (?, ?, ?, ?, ?)`
+
+	res, err := wr.db.ExecContext(ctx, query,
+		delivery.WebhookId,
+		delivery.EventId,
+		delivery.EventType,
+		delivery.Payload,
+		claimedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	delivery.Id = int(id)
+
+	query = "SELECT created_at FROM webhook_deliveries WHERE id = ?"
+	return wr.db.QueryRowContext(ctx, query, id).Scan(&delivery.CreatedAt)
+}
+
+// GetDeliveries returns the deliveries of filter.WebhookId, newest first and at most filter.Limit of them.
+func (wr *WebhookRepository) GetDeliveries(ctx context.Context, filter *entities.WebhookDeliveryFilter) ([]*entities.WebhookDelivery, error) {
+	query := `SELECT
+			id,
+			webhook_id,
+			event_id,
+			event_type,
+			payload,
+			attempts,
+			status_code,
+			last_error,
+			next_attempt_at,
+			delivered_at,
+			failed_at,
+			created_at
+		FROM
+			webhook_deliveries
+		WHERE webhook_id = ?`
+	args := []any{filter.WebhookId}
+	if filter.BeforeId != 0 {
+		query += " AND id < ?"
+		args = append(args, filter.BeforeId)
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, filter.Limit)
+
+	rows, err := wr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*entities.WebhookDelivery
+	for rows.Next() {
+		var d entities.WebhookDelivery
+		if err := rows.Scan(
+			&d.Id,
+			&d.WebhookId,
+			&d.EventId,
+			&d.EventType,
+			&d.Payload,
+			&d.Attempts,
+			&d.StatusCode,
+			&d.LastError,
+			&d.NextAttemptAt,
+			&d.DeliveredAt,
+			&d.FailedAt,
+			&d.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, &d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// ClaimDue uses SKIP LOCKED so that concurrent replicas claim disjoint deliveries. Deliveries
+// are claimed oldest first, so a webhook mostly receives its events in order.
+func (wr *WebhookRepository) ClaimDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]*entities.DueWebhookDelivery, error) {
+	query := `SELECT
+			d.id,
+			d.webhook_id,
+			d.event_id,
+			d.event_type,
+			d.payload,
+			d.attempts,
+			d.status_code,
+			d.last_error,
+			d.next_attempt_at,
+			d.delivered_at,
+			d.failed_at,
+			d.created_at,
+			w.room_id,
+			w.url,
+			w.secret
+		FROM
+			webhook_deliveries d
+			INNER JOIN webhooks w ON w.id = d.webhook_id
+		WHERE d.delivered_at IS NULL
+			AND d.failed_at IS NULL
+			AND w.disabled_at IS NULL
+			AND (d.claimed_at IS NULL OR d.claimed_at < ?)
+			AND (d.next_attempt_at IS NULL OR d.next_attempt_at <= ?)
+		ORDER BY d.id
+		LIMIT ?
+		FOR UPDATE OF d SKIP LOCKED`
+
+	var deliveries []*entities.DueWebhookDelivery
+	err := inTx(ctx, wr.db, func(tx dbtx) error {
+		rows, err := tx.QueryContext(ctx, query, staleBefore, now, limit)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var d entities.DueWebhookDelivery
+			if err := rows.Scan(
+				&d.Id,
+				&d.WebhookId,
+				&d.EventId,
+				&d.EventType,
+				&d.Payload,
+				&d.Attempts,
+				&d.StatusCode,
+				&d.LastError,
+				&d.NextAttemptAt,
+				&d.DeliveredAt,
+				&d.FailedAt,
+				&d.CreatedAt,
+				&d.RoomId,
+				&d.URL,
+				&d.Secret,
+			); err != nil {
+				return err
+			}
+			deliveries = append(deliveries, &d)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		rows.Close()
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		placeholders := make([]string, 0, len(deliveries))
+		args := []any{now}
+		for _, d := range deliveries {
+			placeholders = append(placeholders, "?")
+			args = append(args, d.Id)
+		}
+
+		update := "UPDATE webhook_deliveries SET claimed_at = ? WHERE id IN (" + strings.Join(placeholders, ", ") + ")"
+		_, err = tx.ExecContext(ctx, update, args...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (wr *WebhookRepository) RecordAttempt(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	query := `UPDATE webhook_deliveries
+		SET attempts = ?, status_code = ?, last_error = ?, next_attempt_at = ?, delivered_at = ?, failed_at = ?, claimed_at = NULL
+		WHERE id = ?`
+
+	_, err := wr.db.ExecContext(ctx, query,
+		delivery.Attempts,
+		delivery.StatusCode,
+		delivery.LastError,
+		delivery.NextAttemptAt,
+		delivery.DeliveredAt,
+		delivery.FailedAt,
+		delivery.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordResult counts in SQL, so that workers recording results of the same webhook at once
+// do not lose each other's failures.
+func (wr *WebhookRepository) RecordResult(ctx context.Context, webhookId int, succeeded bool, disableAfter int, now time.Time) error {
+	query := "UPDATE webhooks SET failure_count = 0 WHERE id = ? AND failure_count <> 0"
+	args := []any{webhookId}
+	if !succeeded {
+		// MySQL assigns from left to right, so disabled_at sees the incremented count.
+		query = `UPDATE webhooks
+			SET failure_count = failure_count + 1,
+				disabled_at = IF(disabled_at IS NULL AND failure_count >= ?, ?, disabled_at)
+			WHERE id = ?`
+		args = []any{disableAfter, now, webhookId}
+	}
+
+	_, err := wr.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanWebhook(row rowScanner) (*entities.Webhook, error) {
+	var webhook entities.Webhook
+	var eventTypes []byte
+	if err := row.Scan(
+		&webhook.Id,
+		&webhook.RoomId,
+		&webhook.URL,
+		&webhook.Secret,
+		&eventTypes,
+		&webhook.FailureCount,
+		&webhook.DisabledAt,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(eventTypes, &webhook.EventTypes); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deleteAllWebhooks(t *testing.T) {
+	for _, query := range []string{"DELETE FROM webhook_deliveries", "DELETE FROM webhooks"} {
+		_, err := WebhookRepo.db.ExecContext(context.Background(), query)
+		require.NoError(t, err)
+	}
+}
+
+func TestCreateAndUpdateWebhook(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	defer deleteAllWebhooks(t)
+
+	ctx := context.Background()
+	webhook := entities.NewWebhook(referencedRoomData.Id, "https://example.com/hook", "secret", []string{entities.EventTodoCreated})
+	require.NoError(t, WebhookRepo.Create(ctx, webhook))
+	assert.NotZero(t, webhook.Id)
+	assert.False(t, webhook.CreatedAt.IsZero())
+
+	now := time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC)
+	webhook.UpdateAttributes("https://example.com/new", "", []string{entities.EventTodoUpdated, entities.EventTodoDeleted}, false, now)
+	require.NoError(t, WebhookRepo.Update(ctx, webhook))
+
+	got, err := WebhookRepo.GetById(ctx, webhook.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/new", got.URL)
+	assert.Equal(t, "secret", got.Secret)
+	assert.Equal(t, []string{entities.EventTodoUpdated, entities.EventTodoDeleted}, got.EventTypes)
+	require.NotNil(t, got.DisabledAt)
+	assert.True(t, now.Equal(*got.DisabledAt))
+
+	webhooks, err := WebhookRepo.GetAllByRoomId(ctx, referencedRoomData.Id)
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 1)
+
+	require.NoError(t, WebhookRepo.Delete(ctx, webhook.Id))
+	_, err = WebhookRepo.GetById(ctx, webhook.Id)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	defer deleteAllWebhooks(t)
+
+	ctx := context.Background()
+	now := time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC)
+
+	subscribed := entities.NewWebhook(referencedRoomData.Id, "https://example.com/a", "secret", []string{entities.EventTodoCreated})
+	other := entities.NewWebhook(referencedRoomData.Id, "https://example.com/b", "secret", []string{entities.EventTodoDeleted})
+	for _, webhook := range []*entities.Webhook{subscribed, other} {
+		require.NoError(t, WebhookRepo.Create(ctx, webhook))
+	}
+
+	// Only webhooks subscribing to the event type get a delivery.
+	event, err := entities.NewOutboxEvent(referencedRoomData.Id, entities.EventTodoCreated, &entities.Todo{Id: 1, Title: "title"})
+	require.NoError(t, err)
+	event.Id = 7
+	require.NoError(t, WebhookRepo.Enqueue(ctx, event))
+
+	due, err := WebhookRepo.ClaimDue(ctx, now, now.Add(-5*time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, subscribed.Id, due[0].WebhookId)
+	assert.Equal(t, int64(7), due[0].EventId)
+	assert.Equal(t, "https://example.com/a", due[0].URL)
+	assert.Equal(t, "secret", due[0].Secret)
+
+	// A claimed delivery is not handed out again until the claim goes stale.
+	again, err := WebhookRepo.ClaimDue(ctx, now, now.Add(-5*time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+
+	retryAt := now.Add(time.Minute)
+	due[0].RecordAttempt(500, assert.AnError, now, &retryAt)
+	require.NoError(t, WebhookRepo.RecordAttempt(ctx, &due[0].WebhookDelivery))
+
+	again, err = WebhookRepo.ClaimDue(ctx, now, now.Add(-5*time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+	again, err = WebhookRepo.ClaimDue(ctx, retryAt, retryAt.Add(-5*time.Minute), 10)
+	require.NoError(t, err)
+	assert.Len(t, again, 1)
+
+	deliveries, err := WebhookRepo.GetDeliveries(ctx, &entities.WebhookDeliveryFilter{WebhookId: subscribed.Id, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Equal(t, 500, *deliveries[0].StatusCode)
+	assert.Equal(t, entities.WebhookDeliveryPending, deliveries[0].Status())
+
+	// Test deliveries are claimed by whoever creates them.
+	test := &entities.WebhookDelivery{WebhookId: other.Id, EventType: entities.WebhookEventTest, Payload: []byte("{}")}
+	require.NoError(t, WebhookRepo.CreateDelivery(ctx, test, now))
+	assert.NotZero(t, test.Id)
+	again, err = WebhookRepo.ClaimDue(ctx, now, now.Add(-5*time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+}
+
+func TestRecordWebhookResult(t *testing.T) {
+	insertDummyRoom(t, &referencedRoomData)
+	defer deleteAllRooms(t)
+	defer deleteAllWebhooks(t)
+
+	ctx := context.Background()
+	now := time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC)
+
+	webhook := entities.NewWebhook(referencedRoomData.Id, "https://example.com/hook", "secret", []string{entities.EventTodoCreated})
+	require.NoError(t, WebhookRepo.Create(ctx, webhook))
+
+	require.NoError(t, WebhookRepo.RecordResult(ctx, webhook.Id, false, 2, now))
+	got, err := WebhookRepo.GetById(ctx, webhook.Id)
+	require.NoError(t, err)
+	assert.Equal(t, 1, got.FailureCount)
+	assert.Nil(t, got.DisabledAt)
+
+	// A success resets the run of failures.
+	require.NoError(t, WebhookRepo.RecordResult(ctx, webhook.Id, true, 2, now))
+	for range 2 {
+		require.NoError(t, WebhookRepo.RecordResult(ctx, webhook.Id, false, 2, now))
+	}
+	got, err = WebhookRepo.GetById(ctx, webhook.Id)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.FailureCount)
+	require.NotNil(t, got.DisabledAt)
+	assert.True(t, now.Equal(*got.DisabledAt))
+}
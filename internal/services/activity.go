@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type ActivityService struct {
+	repo      interfaces.ActivityRepository
+	roomRepo  interfaces.RoomRepository
+	tokenRepo interfaces.FeedTokenRepository
+}
+
+func NewActivityService(repo interfaces.ActivityRepository, roomRepo interfaces.RoomRepository, tokenRepo interfaces.FeedTokenRepository) *ActivityService {
+	return &ActivityService{
+		repo:      repo,
+		roomRepo:  roomRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// GetAll returns the room together with a page of its activity, newest first.
+func (as *ActivityService) GetAll(ctx context.Context, filter *entities.ActivityFilter) (*entities.Room, []*entities.Activity, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	room, err := as.roomRepo.GetById(ctx, filter.RoomId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	activities, err := as.repo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return room, activities, nil
+}
+
+// CreateFeedToken issues a feed token for the actor of ctx, revoking the previous one.
+func (as *ActivityService) CreateFeedToken(ctx context.Context) (string, error) {
+	token, feedToken, err := entities.NewFeedToken(ActorFrom(ctx).Name)
+	if err != nil {
+		return "", err
+	}
+
+	if err := as.tokenRepo.Save(ctx, feedToken); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// Authenticate checks that the feed token is the current one of some actor. Rooms have no
+// members, so any actor may follow any room.
+func (as *ActivityService) Authenticate(ctx context.Context, token string) error {
+	if token == "" {
+		return entities.ErrInvalidFeedToken
+	}
+
+	if _, err := as.tokenRepo.GetByHash(ctx, entities.HashFeedToken(token)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.ErrInvalidFeedToken
+		}
+		return err
+	}
+
+	return nil
+}
+
+// recordActivities adds the room activity of a change to a todo: created when before is
+// nil, moved when the board changed and completed when the todo became done.
+func recordActivities(ctx context.Context, repo interfaces.ActivityRepository, before, todo *entities.Todo) error {
+	actor := ActorFrom(ctx).Name
+
+	var activities []*entities.Activity
+	switch {
+	case before == nil:
+		activities = append(activities, entities.NewActivity(entities.ActivityTodoCreated, todo, actor))
+	case before.BoardId != todo.BoardId:
+		moved := entities.NewActivity(entities.ActivityTodoMoved, todo, actor)
+		moved.FromBoardId = &before.BoardId
+		activities = append(activities, moved)
+	}
+	if before != nil && !before.Done && todo.Done {
+		activities = append(activities, entities.NewActivity(entities.ActivityTodoCompleted, todo, actor))
+	}
+
+	for _, activity := range activities {
+		if err := repo.Create(ctx, activity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAllActivities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockActivityRepository(ctrl)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewActivityService(mockRepository, mockRoomRepository, nil)
+
+	testCases := []struct {
+		name          string
+		filter        *entities.ActivityFilter
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:   "Success to get activities with the default limit",
+			filter: &entities.ActivityFilter{RoomId: 1},
+			mockSetup: func() {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().GetAll(gomock.Any(), &entities.ActivityFilter{RoomId: 1, Limit: 50}).
+					Return([]*entities.Activity{{Id: 1, RoomId: 1}}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Failed to get activities - Due to not exist room",
+			filter: &entities.ActivityFilter{RoomId: 999},
+			mockSetup: func() {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 999).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:          "Failed to get activities - Due to invalid filter",
+			filter:        &entities.ActivityFilter{RoomId: 1, Limit: -1},
+			mockSetup:     func() {},
+			expectedError: entities.ErrInvalidActivityFilter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			_, _, err := service.GetAll(context.Background(), tc.filter)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestFeedToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTokenRepository := mock_repository.NewMockFeedTokenRepository(ctrl)
+	service := NewActivityService(nil, nil, mockTokenRepository)
+
+	var saved *entities.FeedToken
+	mockTokenRepository.EXPECT().Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, token *entities.FeedToken) error {
+			saved = token
+			return nil
+		})
+
+	ctx := WithActor(context.Background(), entities.Actor{Name: "alice"})
+	token, err := service.CreateFeedToken(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", saved.Actor)
+
+	_, err = service.CreateFeedToken(context.Background())
+	assert.Equal(t, entities.ErrActorRequired, err)
+
+	mockTokenRepository.EXPECT().GetByHash(gomock.Any(), saved.TokenHash).Return(saved, nil)
+	err = service.Authenticate(context.Background(), token)
+	assert.NoError(t, err)
+
+	mockTokenRepository.EXPECT().GetByHash(gomock.Any(), entities.HashFeedToken("unknown")).Return(nil, sql.ErrNoRows)
+	err = service.Authenticate(context.Background(), "unknown")
+	assert.Equal(t, entities.ErrInvalidFeedToken, err)
+
+	err = service.Authenticate(context.Background(), "")
+	assert.Equal(t, entities.ErrInvalidFeedToken, err)
+}
+
+func TestRecordActivities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockActivityRepository(ctrl)
+	ctx := WithActor(context.Background(), entities.Actor{Name: "alice"})
+	fromBoardId := 1
+
+	testCases := []struct {
+		name      string
+		before    *entities.Todo
+		todo      *entities.Todo
+		mockSetup func()
+	}{
+		{
+			name:   "Success to record a created todo",
+			before: nil,
+			todo:   &entities.Todo{Id: 1, BoardId: 1, Title: "new"},
+			mockSetup: func() {
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.Activity{BoardId: 1, TodoId: 1, Kind: entities.ActivityTodoCreated, Actor: "alice", Title: "new"}).
+					Return(nil)
+			},
+		},
+		{
+			name:   "Success to record a todo moved to another board and completed",
+			before: &entities.Todo{Id: 1, BoardId: 1, Title: "new"},
+			todo:   &entities.Todo{Id: 1, BoardId: 2, Title: "new", Done: true},
+			mockSetup: func() {
+				gomock.InOrder(
+					mockRepository.EXPECT().Create(gomock.Any(), &entities.Activity{BoardId: 2, TodoId: 1, FromBoardId: &fromBoardId, Kind: entities.ActivityTodoMoved, Actor: "alice", Title: "new"}).
+						Return(nil),
+					mockRepository.EXPECT().Create(gomock.Any(), &entities.Activity{BoardId: 2, TodoId: 1, Kind: entities.ActivityTodoCompleted, Actor: "alice", Title: "new"}).
+						Return(nil),
+				)
+			},
+		},
+		{
+			name:      "No activity when a todo is renamed",
+			before:    &entities.Todo{Id: 1, BoardId: 1, Title: "new"},
+			todo:      &entities.Todo{Id: 1, BoardId: 1, Title: "renamed"},
+			mockSetup: func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := recordActivities(ctx, mockRepository, tc.before, tc.todo)
+
+			assert.NoError(t, err)
+		})
+	}
+}
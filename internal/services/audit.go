@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type actorKey struct{}
+
+// WithActor attaches who makes the request to ctx, for the audit events of its changes.
+func WithActor(ctx context.Context, actor entities.Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns the actor attached by WithActor, or the zero Actor.
+func ActorFrom(ctx context.Context) entities.Actor {
+	actor, _ := ctx.Value(actorKey{}).(entities.Actor)
+	return actor
+}
+
+type AuditService struct {
+	repo interfaces.AuditRepository
+}
+
+func NewAuditService(repo interfaces.AuditRepository) *AuditService {
+	return &AuditService{
+		repo: repo,
+	}
+}
+
+func (as *AuditService) GetAll(ctx context.Context, filter *entities.AuditFilter) ([]*entities.AuditEvent, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	return as.repo.GetAll(ctx, filter)
+}
+
+// audit records a change to one entity with the repository of the transaction making it.
+// before is nil for creations and after is nil for deletions.
+func audit(ctx context.Context, repo interfaces.AuditRepository, entityType string, entityId int, action string, before, after any) error {
+	beforeJSON, err := snapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := snapshot(after)
+	if err != nil {
+		return err
+	}
+
+	return repo.Create(ctx, entities.NewAuditEvent(ActorFrom(ctx), entityType, entityId, action, beforeJSON, afterJSON))
+}
+
+// snapshot leaves out the secret of a webhook, which signs its payloads and so must not be
+// readable from the audit log.
+func snapshot(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	if webhook, ok := v.(*entities.Webhook); ok {
+		redacted := *webhook
+		redacted.Secret = ""
+		v = &redacted
+	}
+
+	return json.Marshal(v)
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeUnitOfWork runs fn once with the mocked repositories.
+type fakeUnitOfWork struct {
+	repos *interfaces.Repositories
+}
+
+func (uow *fakeUnitOfWork) Do(ctx context.Context, fn func(repos *interfaces.Repositories) error) error {
+	return fn(uow.repos)
+}
+
+// newAuditedUnitOfWork hands out repos together with an audit repository accepting any event.
+func newAuditedUnitOfWork(ctrl *gomock.Controller, repos *interfaces.Repositories) *fakeUnitOfWork {
+	mockAuditRepository := mock_repository.NewMockAuditRepository(ctrl)
+	mockAuditRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	repos.Audits = mockAuditRepository
+
+	return &fakeUnitOfWork{repos: repos}
+}
+
+func TestGetAllAuditEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockAuditRepository(ctrl)
+	service := NewAuditService(mockRepository)
+
+	testCases := []struct {
+		name          string
+		filter        *entities.AuditFilter
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:   "Success to get audit events with the default limit",
+			filter: &entities.AuditFilter{EntityType: entities.AuditEntityBoard},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetAll(gomock.Any(), &entities.AuditFilter{EntityType: entities.AuditEntityBoard, Limit: 100}).
+					Return([]*entities.AuditEvent{{Id: 1}}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to get audit events - Due to invalid filter",
+			filter:        &entities.AuditFilter{Limit: -1},
+			mockSetup:     func() {},
+			expectedError: entities.ErrInvalidAuditFilter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			_, err := service.GetAll(context.Background(), tc.filter)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestAuditRecordsChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockBoardRepository(ctrl)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	mockAuditRepository := mock_repository.NewMockAuditRepository(ctrl)
+	uow := &fakeUnitOfWork{repos: &interfaces.Repositories{Boards: mockRepository, Audits: mockAuditRepository}}
+	service := NewBoardService(mockRepository, mockRoomRepository, uow)
+
+	actor := entities.Actor{Name: "alice", RequestId: "req-1", IP: "192.0.2.1"}
+	ctx := WithActor(context.Background(), actor)
+
+	t.Run("Success to record the update with both snapshots", func(t *testing.T) {
+		var recorded *entities.AuditEvent
+		mockRepository.EXPECT().GetById(gomock.Any(), 1).
+			Return(&entities.Board{Id: 1, RoomId: 1, Name: "backlog"}, nil)
+		mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockAuditRepository.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, event *entities.AuditEvent) error {
+				recorded = event
+				return nil
+			})
+
+		require.NoError(t, service.Update(ctx, 1, "doing", 0))
+
+		require.NotNil(t, recorded)
+		assert.Equal(t, "alice", recorded.Actor)
+		assert.Equal(t, "req-1", recorded.RequestId)
+		assert.Equal(t, "192.0.2.1", recorded.IP)
+		assert.Equal(t, entities.AuditEntityBoard, recorded.EntityType)
+		assert.Equal(t, 1, recorded.EntityId)
+		assert.Equal(t, entities.AuditActionUpdate, recorded.Action)
+		assert.Contains(t, string(recorded.Before), `"Name":"backlog"`)
+		assert.Contains(t, string(recorded.After), `"Name":"doing"`)
+	})
+
+	t.Run("Success to record the deletion without an after snapshot", func(t *testing.T) {
+		var recorded *entities.AuditEvent
+		mockRepository.EXPECT().GetById(gomock.Any(), 1).
+			Return(&entities.Board{Id: 1, RoomId: 1, Name: "backlog"}, nil)
+		mockRepository.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+		mockAuditRepository.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, event *entities.AuditEvent) error {
+				recorded = event
+				return nil
+			})
+
+		require.NoError(t, service.Delete(ctx, 1))
+
+		require.NotNil(t, recorded)
+		assert.Equal(t, entities.AuditActionDelete, recorded.Action)
+		assert.Contains(t, string(recorded.Before), `"Name":"backlog"`)
+		assert.Nil(t, recorded.After)
+	})
+
+	t.Run("Success to record a webhook without its secret", func(t *testing.T) {
+		var recorded *entities.AuditEvent
+		repo := mock_repository.NewMockAuditRepository(ctrl)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, event *entities.AuditEvent) error {
+				recorded = event
+				return nil
+			})
+		webhook := &entities.Webhook{Id: 1, RoomId: 1, URL: "https://example.com/hook", Secret: "s3cr3t"}
+
+		require.NoError(t, audit(ctx, repo, entities.AuditEntityWebhook, 1, entities.AuditActionCreate, nil, webhook))
+
+		require.NotNil(t, recorded)
+		assert.Contains(t, string(recorded.After), `"URL":"https://example.com/hook"`)
+		assert.NotContains(t, string(recorded.After), "s3cr3t")
+		assert.Equal(t, "s3cr3t", webhook.Secret)
+	})
+
+	t.Run("Failed to delete - Due to the audit event could not be written", func(t *testing.T) {
+		mockRepository.EXPECT().GetById(gomock.Any(), 1).
+			Return(&entities.Board{Id: 1, RoomId: 1}, nil)
+		mockRepository.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+		mockAuditRepository.EXPECT().Create(gomock.Any(), gomock.Any()).
+			Return(errors.New("unexpected error"))
+
+		err := service.Delete(ctx, 1)
+
+		assert.EqualError(t, err, "unexpected error")
+	})
+}
@@ -1,51 +1,221 @@
 package services
 
 import (
+	"context"
+	"time"
+
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
 	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
 )
 
 type BoardService struct {
-	repo interfaces.BoardRepository
+	repo     interfaces.BoardRepository
+	roomRepo interfaces.RoomRepository
+	uow      interfaces.UnitOfWork
+	now      func() time.Time
 }
 
-func NewBoardService(repo interfaces.BoardRepository) *BoardService {
+func NewBoardService(repo interfaces.BoardRepository, roomRepo interfaces.RoomRepository, uow interfaces.UnitOfWork) *BoardService {
 	return &BoardService{
-		repo: repo,
+		repo:     repo,
+		roomRepo: roomRepo,
+		uow:      uow,
+		now:      time.Now,
 	}
 }
 
-func (bs *BoardService) GetAll() ([]*entities.Board, error) {
-	return bs.repo.GetAll()
+func (bs *BoardService) GetAll(ctx context.Context, includeArchived bool) ([]*entities.Board, error) {
+	return bs.repo.GetAll(ctx, includeArchived)
+}
+
+func (bs *BoardService) GetAllByRoomId(ctx context.Context, roomId int, includeArchived bool) ([]*entities.Board, error) {
+	return bs.repo.GetAllByRoomId(ctx, roomId, includeArchived)
+}
+
+func (bs *BoardService) GetById(ctx context.Context, id int) (*entities.Board, error) {
+	return bs.repo.GetById(ctx, id)
 }
 
-func (bs *BoardService) Create(name string, priority, roomId int) error {
+func (bs *BoardService) Create(ctx context.Context, name string, priority, roomId int) (*entities.Board, error) {
 	board := entities.NewBoard(name, priority, roomId)
 	if err := board.Validate(); err != nil {
-		return err
+		return nil, err
 	}
 
-	return bs.repo.Create(board)
+	room, err := bs.roomRepo.GetById(ctx, roomId)
+	if err != nil {
+		return nil, err
+	}
+	if err := room.Writable(); err != nil {
+		return nil, err
+	}
+
+	err = bs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Boards.Create(ctx, board); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityBoard, board.Id, entities.AuditActionCreate, nil, board)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return board, nil
+}
+
+func (bs *BoardService) Update(ctx context.Context, id int, name string, priority int) error {
+	return bs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		return bs.UpdateIn(ctx, repos, id, name, priority)
+	})
 }
 
-func (bs *BoardService) Update(id int, name string, priority int) error {
-	board, err := bs.repo.GetById(id)
+func (bs *BoardService) UpdateIn(ctx context.Context, repos *interfaces.Repositories, id int, name string, priority int) error {
+	board, err := repos.Boards.GetById(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	if err := board.Writable(); err != nil {
+		return err
+	}
+
+	before := *board
 	board.UpdateAttributes(name, priority)
 	if err := board.Validate(); err != nil {
 		return err
 	}
 
-	return bs.repo.Update(board)
+	if err := repos.Boards.Update(ctx, board); err != nil {
+		return err
+	}
+
+	return audit(ctx, repos.Audits, entities.AuditEntityBoard, board.Id, entities.AuditActionUpdate, &before, board)
+}
+
+// Archive makes the board and its todos read-only. Archiving twice keeps the first time.
+func (bs *BoardService) Archive(ctx context.Context, id int) error {
+	board, err := getInWritableRoom(ctx, bs.repo, id)
+	if err != nil {
+		return err
+	}
+	if board.ArchivedAt != nil {
+		return nil
+	}
+
+	now := bs.now().UTC()
+	return bs.setArchivedAt(ctx, board, &now)
+}
+
+func (bs *BoardService) Unarchive(ctx context.Context, id int) error {
+	board, err := getInWritableRoom(ctx, bs.repo, id)
+	if err != nil {
+		return err
+	}
+
+	return bs.setArchivedAt(ctx, board, nil)
+}
+
+// Delete accepts archived boards, but not boards of an archived room.
+func (bs *BoardService) Delete(ctx context.Context, id int) error {
+	return bs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		return bs.DeleteIn(ctx, repos, id)
+	})
 }
 
-func (bs *BoardService) Delete(id int) error {
-	if _, err := bs.repo.GetById(id); err != nil {
+func (bs *BoardService) DeleteIn(ctx context.Context, repos *interfaces.Repositories, id int) error {
+	board, err := getInWritableRoom(ctx, repos.Boards, id)
+	if err != nil {
 		return err
 	}
 
-	return bs.repo.Delete(id)
+	if err := repos.Boards.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	return audit(ctx, repos.Audits, entities.AuditEntityBoard, id, entities.AuditActionDelete, board, nil)
+}
+
+// Reorder requires ids to list every unarchived board of the room exactly once. The room's
+// boards stay locked from the check to the write, so a board added or archived meanwhile
+// cannot be left out of the order.
+func (bs *BoardService) Reorder(ctx context.Context, roomId int, ids []int) error {
+	return bs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		boards, err := repos.Boards.GetAllByRoomIdForUpdate(ctx, roomId, false)
+		if err != nil {
+			return err
+		}
+
+		room, err := repos.Rooms.GetById(ctx, roomId)
+		if err != nil {
+			return err
+		}
+		if err := room.Writable(); err != nil {
+			return err
+		}
+
+		if len(ids) != len(boards) {
+			return entities.ErrInvalidBoardOrder
+		}
+
+		remaining := make(map[int]*entities.Board, len(boards))
+		for _, board := range boards {
+			remaining[board.Id] = board
+		}
+		moved := make(map[int]int)
+		for position, id := range ids {
+			board, ok := remaining[id]
+			if !ok {
+				return entities.ErrInvalidBoardOrder
+			}
+			if board.Position != position {
+				moved[id] = position
+			}
+			delete(remaining, id)
+		}
+
+		if err := repos.Boards.Reorder(ctx, roomId, ids); err != nil {
+			return err
+		}
+
+		for _, board := range boards {
+			position, ok := moved[board.Id]
+			if !ok {
+				continue
+			}
+
+			after := *board
+			after.Position = position
+			if err := audit(ctx, repos.Audits, entities.AuditEntityBoard, board.Id, entities.AuditActionUpdate, board, &after); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (bs *BoardService) setArchivedAt(ctx context.Context, board *entities.Board, archivedAt *time.Time) error {
+	before := *board
+	board.ArchivedAt = archivedAt
+
+	return bs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Boards.UpdateArchivedAt(ctx, board.Id, archivedAt); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityBoard, board.Id, entities.AuditActionUpdate, &before, board)
+	})
+}
+
+func getInWritableRoom(ctx context.Context, repo interfaces.BoardRepository, id int) (*entities.Board, error) {
+	board, err := repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if board.RoomArchived {
+		return nil, entities.ErrArchived
+	}
+
+	return board, nil
 }
@@ -1,12 +1,15 @@
 package services
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
 	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -17,7 +20,8 @@ func TestCreateBoard(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepository := mock_repository.NewMockBoardRepository(ctrl)
-	service := NewBoardService(mockRepository)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewBoardService(mockRepository, mockRoomRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Boards: mockRepository}))
 
 	testCases := []struct {
 		name          string
@@ -25,6 +29,7 @@ func TestCreateBoard(t *testing.T) {
 		priority      int
 		roomId        int
 		mockSetup     func(board *entities.Board)
+		expectedId    int
 		expectedError error
 	}{
 		{
@@ -33,11 +38,28 @@ func TestCreateBoard(t *testing.T) {
 			priority:  0,
 			roomId:    1,
 			mockSetup: func(board *entities.Board) {
-				mockRepository.EXPECT().Create(board).
-					Return(nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), board).
+					DoAndReturn(func(ctx context.Context, board *entities.Board) error {
+						board.Id = 1
+						return nil
+					})
 			},
+			expectedId:    1,
 			expectedError: nil,
 		},
+		{
+			name:      "Failed to create board - Due to the room is archived",
+			boardName: "test board",
+			priority:  0,
+			roomId:    1,
+			mockSetup: func(board *entities.Board) {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Room{Id: 1, ArchivedAt: &testNow}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
 		{
 			name:          "Failed to create board - Due to number of characters in the name is more than 50",
 			boardName:     strings.Repeat("a", 51),
@@ -73,9 +95,12 @@ func TestCreateBoard(t *testing.T) {
 			}
 			tc.mockSetup(board)
 
-			err := service.Create(tc.boardName, tc.priority, tc.roomId)
+			created, err := service.Create(context.Background(), tc.boardName, tc.priority, tc.roomId)
 
 			assert.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedId, created.Id)
+			}
 		})
 	}
 }
@@ -85,7 +110,8 @@ func TestUpdateBoard(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepository := mock_repository.NewMockBoardRepository(ctrl)
-	service := NewBoardService(mockRepository)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewBoardService(mockRepository, mockRoomRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Boards: mockRepository}))
 
 	testCases := []struct {
 		name          string
@@ -101,9 +127,9 @@ func TestUpdateBoard(t *testing.T) {
 			boardName: "test board",
 			priority:  0,
 			mockSetup: func(board *entities.Board) {
-				mockRepository.EXPECT().GetById(board.Id).
+				mockRepository.EXPECT().GetById(gomock.Any(), board.Id).
 					Return(&entities.Board{Id: board.Id}, nil)
-				mockRepository.EXPECT().Update(board).
+				mockRepository.EXPECT().Update(gomock.Any(), board).
 					Return(nil)
 			},
 			expectedError: nil,
@@ -114,18 +140,29 @@ func TestUpdateBoard(t *testing.T) {
 			boardName: "test board",
 			priority:  0,
 			mockSetup: func(board *entities.Board) {
-				mockRepository.EXPECT().GetById(board.Id).
+				mockRepository.EXPECT().GetById(gomock.Any(), board.Id).
 					Return(nil, sql.ErrNoRows)
 			},
 			expectedError: sql.ErrNoRows,
 		},
+		{
+			name:      "Failed to update board - Due to the board is archived",
+			id:        1,
+			boardName: "test board",
+			priority:  0,
+			mockSetup: func(board *entities.Board) {
+				mockRepository.EXPECT().GetById(gomock.Any(), board.Id).
+					Return(&entities.Board{Id: board.Id, ArchivedAt: &testNow}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
 		{
 			name:      "Failed to update board - Due to number of characters in the name is more than 50",
 			id:        1,
 			boardName: strings.Repeat("a", 51),
 			priority:  0,
 			mockSetup: func(board *entities.Board) {
-				mockRepository.EXPECT().GetById(board.Id).
+				mockRepository.EXPECT().GetById(gomock.Any(), board.Id).
 					Return(&entities.Board{Id: board.Id}, nil)
 			},
 			expectedError: errors.New("Invalid name"),
@@ -136,7 +173,7 @@ func TestUpdateBoard(t *testing.T) {
 			boardName: "",
 			priority:  0,
 			mockSetup: func(board *entities.Board) {
-				mockRepository.EXPECT().GetById(board.Id).
+				mockRepository.EXPECT().GetById(gomock.Any(), board.Id).
 					Return(&entities.Board{Id: board.Id}, nil)
 			},
 			expectedError: errors.New("Invalid name"),
@@ -147,7 +184,7 @@ func TestUpdateBoard(t *testing.T) {
 			boardName: "test board",
 			priority:  -1,
 			mockSetup: func(board *entities.Board) {
-				mockRepository.EXPECT().GetById(board.Id).
+				mockRepository.EXPECT().GetById(gomock.Any(), board.Id).
 					Return(&entities.Board{Id: board.Id}, nil)
 			},
 			expectedError: errors.New("Invalid priority size"),
@@ -163,7 +200,7 @@ func TestUpdateBoard(t *testing.T) {
 			}
 			tc.mockSetup(updatedBoard)
 
-			err := service.Update(tc.id, tc.boardName, tc.priority)
+			err := service.Update(context.Background(), tc.id, tc.boardName, tc.priority)
 
 			assert.Equal(t, tc.expectedError, err)
 		})
@@ -175,7 +212,8 @@ func TestDeleteBoard(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepository := mock_repository.NewMockBoardRepository(ctrl)
-	service := NewBoardService(mockRepository)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewBoardService(mockRepository, mockRoomRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Boards: mockRepository}))
 
 	testCases := []struct {
 		name          string
@@ -187,18 +225,200 @@ func TestDeleteBoard(t *testing.T) {
 			name: "Success to delete board",
 			id:   1,
 			mockSetup: func() {
-				mockRepository.EXPECT().GetById(1).
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).
 					Return(&entities.Board{}, nil)
-				mockRepository.EXPECT().Delete(1).
+				mockRepository.EXPECT().Delete(gomock.Any(), 1).
 					Return(nil)
 			},
 			expectedError: nil,
 		},
+		{
+			name: "Failed to delete board - Due to the room is archived",
+			id:   1,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Board{Id: 1, RoomArchived: true}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
 		{
 			name: "Failed to delete board - Due to the board not found",
 			id:   999,
 			mockSetup: func() {
-				mockRepository.EXPECT().GetById(999).
+				mockRepository.EXPECT().GetById(gomock.Any(), 999).
+					Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Delete(context.Background(), tc.id)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestReorderBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockBoardRepository(ctrl)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewBoardService(mockRepository, mockRoomRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Boards: mockRepository, Rooms: mockRoomRepository}))
+
+	savedBoards := []*entities.Board{
+		{Id: 1, RoomId: 1},
+		{Id: 2, RoomId: 1},
+		{Id: 3, RoomId: 1},
+	}
+
+	testCases := []struct {
+		name          string
+		roomId        int
+		ids           []int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:   "Success to reorder boards",
+			roomId: 1,
+			ids:    []int{3, 1, 2},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetAllByRoomIdForUpdate(gomock.Any(), 1, false).
+					Return(savedBoards, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().Reorder(gomock.Any(), 1, []int{3, 1, 2}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Failed to reorder boards - Due to missing board",
+			roomId: 1,
+			ids:    []int{3, 1},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetAllByRoomIdForUpdate(gomock.Any(), 1, false).
+					Return(savedBoards, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Room{Id: 1}, nil)
+			},
+			expectedError: entities.ErrInvalidBoardOrder,
+		},
+		{
+			name:   "Failed to reorder boards - Due to duplicated board",
+			roomId: 1,
+			ids:    []int{3, 1, 1},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetAllByRoomIdForUpdate(gomock.Any(), 1, false).
+					Return(savedBoards, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Room{Id: 1}, nil)
+			},
+			expectedError: entities.ErrInvalidBoardOrder,
+		},
+		{
+			name:   "Failed to reorder boards - Due to board of another room",
+			roomId: 1,
+			ids:    []int{3, 1, 4},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetAllByRoomIdForUpdate(gomock.Any(), 1, false).
+					Return(savedBoards, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Room{Id: 1}, nil)
+			},
+			expectedError: entities.ErrInvalidBoardOrder,
+		},
+		{
+			name:   "Failed to reorder boards - Due to the room is archived",
+			roomId: 1,
+			ids:    []int{3, 1, 2},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetAllByRoomIdForUpdate(gomock.Any(), 1, false).
+					Return(savedBoards, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Room{Id: 1, ArchivedAt: &testNow}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+		{
+			name:   "Failed to reorder boards - Due to unexpected error",
+			roomId: 1,
+			ids:    []int{3, 1, 2},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetAllByRoomIdForUpdate(gomock.Any(), 1, false).
+					Return(nil, sql.ErrConnDone)
+			},
+			expectedError: sql.ErrConnDone,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Reorder(context.Background(), tc.roomId, tc.ids)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestArchiveBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockBoardRepository(ctrl)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewBoardService(mockRepository, mockRoomRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Boards: mockRepository}))
+	service.now = func() time.Time { return testNow }
+
+	testCases := []struct {
+		name          string
+		id            int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to archive board",
+			id:   1,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Board{Id: 1}, nil)
+				mockRepository.EXPECT().UpdateArchivedAt(gomock.Any(), 1, &testNow).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success to archive board - Keeping the time it was first archived",
+			id:   1,
+			mockSetup: func() {
+				archivedAt := testNow.Add(-time.Hour)
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Board{Id: 1, ArchivedAt: &archivedAt}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to archive board - Due to the room is archived",
+			id:   1,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Board{Id: 1, RoomArchived: true}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+		{
+			name: "Failed to archive board - Due to the board not found",
+			id:   999,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 999).
 					Return(nil, sql.ErrNoRows)
 			},
 			expectedError: sql.ErrNoRows,
@@ -209,7 +429,7 @@ func TestDeleteBoard(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.mockSetup()
 
-			err := service.Delete(tc.id)
+			err := service.Archive(context.Background(), tc.id)
 
 			assert.Equal(t, tc.expectedError, err)
 		})
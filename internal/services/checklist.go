@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+// ChecklistService reports sql.ErrNoRows when the todo is not on the board or the item is not
+// on the todo, so that a checklist is only reached through the path of its todo.
+type ChecklistService struct {
+	repo     interfaces.ChecklistRepository
+	todoRepo interfaces.TodoRepository
+	uow      interfaces.UnitOfWork
+}
+
+func NewChecklistService(repo interfaces.ChecklistRepository, todoRepo interfaces.TodoRepository, uow interfaces.UnitOfWork) *ChecklistService {
+	return &ChecklistService{
+		repo:     repo,
+		todoRepo: todoRepo,
+		uow:      uow,
+	}
+}
+
+func (cs *ChecklistService) GetAll(ctx context.Context, boardId, todoId int) ([]*entities.ChecklistItem, error) {
+	if err := cs.checkTodo(ctx, boardId, todoId); err != nil {
+		return nil, err
+	}
+
+	return cs.repo.GetAllByTodoId(ctx, todoId)
+}
+
+func (cs *ChecklistService) Create(ctx context.Context, boardId, todoId int, text string, checked bool) error {
+	if err := cs.checkTodo(ctx, boardId, todoId); err != nil {
+		return err
+	}
+
+	item := entities.NewChecklistItem(todoId, text, checked)
+	if err := item.Validate(); err != nil {
+		return err
+	}
+
+	return cs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Checklists.Create(ctx, item); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityChecklistItem, item.Id, entities.AuditActionCreate, nil, item)
+	})
+}
+
+func (cs *ChecklistService) Update(ctx context.Context, boardId, todoId, id int, text string, checked bool) error {
+	item, err := cs.getInTodo(ctx, boardId, todoId, id)
+	if err != nil {
+		return err
+	}
+
+	before := *item
+	item.UpdateAttributes(text, checked)
+	if err := item.Validate(); err != nil {
+		return err
+	}
+
+	return cs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Checklists.Update(ctx, item); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityChecklistItem, item.Id, entities.AuditActionUpdate, &before, item)
+	})
+}
+
+func (cs *ChecklistService) Delete(ctx context.Context, boardId, todoId, id int) error {
+	item, err := cs.getInTodo(ctx, boardId, todoId, id)
+	if err != nil {
+		return err
+	}
+
+	return cs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Checklists.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityChecklistItem, id, entities.AuditActionDelete, item, nil)
+	})
+}
+
+// Reorder requires ids to list every item of the checklist exactly once.
+func (cs *ChecklistService) Reorder(ctx context.Context, boardId, todoId int, ids []int) error {
+	if err := cs.checkTodo(ctx, boardId, todoId); err != nil {
+		return err
+	}
+
+	items, err := cs.repo.GetAllByTodoId(ctx, todoId)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) != len(items) {
+		return entities.ErrInvalidChecklistOrder
+	}
+
+	remaining := make(map[int]*entities.ChecklistItem, len(items))
+	for _, item := range items {
+		remaining[item.Id] = item
+	}
+	moved := make(map[int]int)
+	for position, id := range ids {
+		item, ok := remaining[id]
+		if !ok {
+			return entities.ErrInvalidChecklistOrder
+		}
+		if item.Position != position {
+			moved[id] = position
+		}
+		delete(remaining, id)
+	}
+
+	return cs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Checklists.Reorder(ctx, todoId, ids); err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			position, ok := moved[item.Id]
+			if !ok {
+				continue
+			}
+
+			after := *item
+			after.Position = position
+			if err := audit(ctx, repos.Audits, entities.AuditEntityChecklistItem, item.Id, entities.AuditActionUpdate, item, &after); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (cs *ChecklistService) checkTodo(ctx context.Context, boardId, todoId int) error {
+	todo, err := cs.todoRepo.GetById(ctx, todoId)
+	if err != nil {
+		return err
+	}
+	if todo.BoardId != boardId {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (cs *ChecklistService) getInTodo(ctx context.Context, boardId, todoId, id int) (*entities.ChecklistItem, error) {
+	if err := cs.checkTodo(ctx, boardId, todoId); err != nil {
+		return nil, err
+	}
+
+	item, err := cs.repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.TodoId != todoId {
+		return nil, sql.ErrNoRows
+	}
+
+	return item, nil
+}
@@ -0,0 +1,319 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockChecklistRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	service := NewChecklistService(mockRepository, mockTodoRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Checklists: mockRepository}))
+	todo := &entities.Todo{Id: 1, BoardId: 1}
+
+	testCases := []struct {
+		name          string
+		todoId        int
+		text          string
+		checked       bool
+		mockSetup     func(item *entities.ChecklistItem)
+		expectedError error
+	}{
+		{
+			name:    "Success to create checklist item",
+			todoId:  1,
+			text:    "buy milk",
+			checked: false,
+			mockSetup: func(item *entities.ChecklistItem) {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), item).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "Failed to create checklist item - Due to the todo on another board",
+			todoId:  2,
+			text:    "buy milk",
+			checked: false,
+			mockSetup: func(item *entities.ChecklistItem) {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 2).Return(&entities.Todo{Id: 2, BoardId: 9}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:    "Failed to create checklist item - Due to the empty text",
+			todoId:  1,
+			text:    "",
+			checked: false,
+			mockSetup: func(item *entities.ChecklistItem) {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+			},
+			expectedError: errors.New("Invalid text"),
+		},
+		{
+			name:    "Failed to create checklist item - Due to number of characters in the text is more than 100",
+			todoId:  1,
+			text:    strings.Repeat("a", 101),
+			checked: false,
+			mockSetup: func(item *entities.ChecklistItem) {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+			},
+			expectedError: errors.New("Invalid text"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			item := &entities.ChecklistItem{
+				TodoId:  tc.todoId,
+				Text:    tc.text,
+				Checked: tc.checked,
+			}
+			tc.mockSetup(item)
+
+			err := service.Create(context.Background(), 1, tc.todoId, tc.text, tc.checked)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestUpdateChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockChecklistRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	service := NewChecklistService(mockRepository, mockTodoRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Checklists: mockRepository}))
+	todo := &entities.Todo{Id: 1, BoardId: 1}
+
+	testCases := []struct {
+		name          string
+		id            int
+		text          string
+		checked       bool
+		mockSetup     func(item *entities.ChecklistItem)
+		expectedError error
+	}{
+		{
+			name:    "Success to update checklist item",
+			id:      1,
+			text:    "buy milk",
+			checked: true,
+			mockSetup: func(item *entities.ChecklistItem) {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), item.Id).
+					Return(&entities.ChecklistItem{Id: item.Id, TodoId: 1}, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), item).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "Failed to update checklist item - Due to the item not found",
+			id:      999,
+			text:    "buy milk",
+			checked: true,
+			mockSetup: func(item *entities.ChecklistItem) {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), item.Id).
+					Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:    "Failed to update checklist item - Due to the item of another todo",
+			id:      2,
+			text:    "buy milk",
+			checked: true,
+			mockSetup: func(item *entities.ChecklistItem) {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), item.Id).
+					Return(&entities.ChecklistItem{Id: item.Id, TodoId: 2}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:    "Failed to update checklist item - Due to the empty text",
+			id:      1,
+			text:    "",
+			checked: true,
+			mockSetup: func(item *entities.ChecklistItem) {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), item.Id).
+					Return(&entities.ChecklistItem{Id: item.Id, TodoId: 1}, nil)
+			},
+			expectedError: errors.New("Invalid text"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updatedItem := &entities.ChecklistItem{
+				Id:      tc.id,
+				TodoId:  1,
+				Text:    tc.text,
+				Checked: tc.checked,
+			}
+			tc.mockSetup(updatedItem)
+
+			err := service.Update(context.Background(), 1, 1, tc.id, tc.text, tc.checked)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestDeleteChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockChecklistRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	service := NewChecklistService(mockRepository, mockTodoRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Checklists: mockRepository}))
+	todo := &entities.Todo{Id: 1, BoardId: 1}
+
+	testCases := []struct {
+		name          string
+		id            int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to delete checklist item",
+			id:   1,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.ChecklistItem{Id: 1, TodoId: 1}, nil)
+				mockRepository.EXPECT().Delete(gomock.Any(), 1).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to delete checklist item - Due to the item not found",
+			id:   999,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 999).
+					Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name: "Failed to delete checklist item - Due to the item of another todo",
+			id:   2,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 2).
+					Return(&entities.ChecklistItem{Id: 2, TodoId: 2}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Delete(context.Background(), 1, 1, tc.id)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestReorderChecklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockChecklistRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	service := NewChecklistService(mockRepository, mockTodoRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Checklists: mockRepository}))
+	todo := &entities.Todo{Id: 1, BoardId: 1}
+
+	savedItems := []*entities.ChecklistItem{
+		{Id: 1, TodoId: 1},
+		{Id: 2, TodoId: 1},
+		{Id: 3, TodoId: 1},
+	}
+
+	testCases := []struct {
+		name          string
+		todoId        int
+		ids           []int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:   "Success to reorder checklist",
+			todoId: 1,
+			ids:    []int{3, 1, 2},
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetAllByTodoId(gomock.Any(), 1).
+					Return(savedItems, nil)
+				mockRepository.EXPECT().Reorder(gomock.Any(), 1, []int{3, 1, 2}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Failed to reorder checklist - Due to missing item",
+			todoId: 1,
+			ids:    []int{3, 1},
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetAllByTodoId(gomock.Any(), 1).
+					Return(savedItems, nil)
+			},
+			expectedError: entities.ErrInvalidChecklistOrder,
+		},
+		{
+			name:   "Failed to reorder checklist - Due to duplicated item",
+			todoId: 1,
+			ids:    []int{3, 1, 1},
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetAllByTodoId(gomock.Any(), 1).
+					Return(savedItems, nil)
+			},
+			expectedError: entities.ErrInvalidChecklistOrder,
+		},
+		{
+			name:   "Failed to reorder checklist - Due to item of another todo",
+			todoId: 1,
+			ids:    []int{3, 1, 4},
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetAllByTodoId(gomock.Any(), 1).
+					Return(savedItems, nil)
+			},
+			expectedError: entities.ErrInvalidChecklistOrder,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Reorder(context.Background(), 1, tc.todoId, tc.ids)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
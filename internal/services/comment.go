@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+// CommentService reports sql.ErrNoRows when the todo is not on the board, so that comments
+// are only reached through the path of their todo.
+type CommentService struct {
+	repo     interfaces.CommentRepository
+	todoRepo interfaces.TodoRepository
+	uow      interfaces.UnitOfWork
+}
+
+func NewCommentService(repo interfaces.CommentRepository, todoRepo interfaces.TodoRepository, uow interfaces.UnitOfWork) *CommentService {
+	return &CommentService{
+		repo:     repo,
+		todoRepo: todoRepo,
+		uow:      uow,
+	}
+}
+
+func (cs *CommentService) GetAll(ctx context.Context, boardId, todoId int) ([]*entities.Comment, error) {
+	todo, err := cs.todoRepo.GetById(ctx, todoId)
+	if err != nil {
+		return nil, err
+	}
+	if todo.BoardId != boardId {
+		return nil, sql.ErrNoRows
+	}
+
+	return cs.repo.GetAllByTodoId(ctx, todoId)
+}
+
+// Create posts the comment as the actor of ctx and adds it to the room's activity.
+func (cs *CommentService) Create(ctx context.Context, boardId, todoId int, body string) (*entities.Comment, error) {
+	comment := entities.NewComment(todoId, ActorFrom(ctx).Name, body)
+	if err := comment.Validate(); err != nil {
+		return nil, err
+	}
+
+	err := cs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		todo, err := repos.Todos.GetById(ctx, todoId)
+		if err != nil {
+			return err
+		}
+		if todo.BoardId != boardId {
+			return sql.ErrNoRows
+		}
+
+		if err := checkBoardWritable(ctx, repos, boardId); err != nil {
+			return err
+		}
+
+		if err := repos.Comments.Create(ctx, comment); err != nil {
+			return err
+		}
+		if err := audit(ctx, repos.Audits, entities.AuditEntityComment, comment.Id, entities.AuditActionCreate, nil, comment); err != nil {
+			return err
+		}
+
+		return repos.Activities.Create(ctx, entities.NewActivity(entities.ActivityTodoCommented, todo, comment.Author))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockCommentRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	mockBoardRepository := mock_repository.NewMockBoardRepository(ctrl)
+	mockActivityRepository := mock_repository.NewMockActivityRepository(ctrl)
+	service := NewCommentService(mockRepository, mockTodoRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{
+		Comments:   mockRepository,
+		Todos:      mockTodoRepository,
+		Boards:     mockBoardRepository,
+		Activities: mockActivityRepository,
+	}))
+	todo := &entities.Todo{Id: 1, BoardId: 1, Title: "Buy milk"}
+
+	testCases := []struct {
+		name          string
+		todoId        int
+		body          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:   "Success to create comment",
+			todoId: 1,
+			body:   "Looks good",
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1}, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.Comment{TodoId: 1, Author: "alice", Body: "Looks good"}).Return(nil)
+				mockActivityRepository.EXPECT().Create(gomock.Any(), &entities.Activity{
+					BoardId: 1,
+					TodoId:  1,
+					Kind:    entities.ActivityTodoCommented,
+					Actor:   "alice",
+					Title:   "Buy milk",
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Failed to create comment - Due to the todo on another board",
+			todoId: 2,
+			body:   "Looks good",
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 2).Return(&entities.Todo{Id: 2, BoardId: 9}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:   "Failed to create comment - Due to the archived board",
+			todoId: 1,
+			body:   "Looks good",
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1, RoomArchived: true}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+		{
+			name:          "Failed to create comment - Due to the empty body",
+			todoId:        1,
+			body:          "",
+			mockSetup:     func() {},
+			expectedError: errors.New("Invalid body"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			ctx := WithActor(context.Background(), entities.Actor{Name: "alice"})
+			_, err := service.Create(ctx, 1, tc.todoId, tc.body)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
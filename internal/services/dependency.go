@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+// DependencyService reports sql.ErrNoRows when the blocked todo is not on the board, so that
+// blockers are only reached through the path of their todo. A blocker may be on any board of
+// the same room.
+type DependencyService struct {
+	repo     interfaces.DependencyRepository
+	todoRepo interfaces.TodoRepository
+	uow      interfaces.UnitOfWork
+}
+
+func NewDependencyService(repo interfaces.DependencyRepository, todoRepo interfaces.TodoRepository, uow interfaces.UnitOfWork) *DependencyService {
+	return &DependencyService{
+		repo:     repo,
+		todoRepo: todoRepo,
+		uow:      uow,
+	}
+}
+
+func (ds *DependencyService) GetBlockers(ctx context.Context, boardId, todoId int) ([]*entities.TodoDependency, error) {
+	if _, err := getTodoOnBoard(ctx, ds.todoRepo, boardId, todoId); err != nil {
+		return nil, err
+	}
+
+	return ds.repo.GetAllByTodoId(ctx, todoId)
+}
+
+func (ds *DependencyService) AddBlocker(ctx context.Context, boardId, todoId, blockerId int) error {
+	dependency := entities.NewTodoDependency(todoId, blockerId)
+	if err := dependency.Validate(); err != nil {
+		return err
+	}
+
+	// The room is locked before its graph is read, so that two requests adding the two halves
+	// of a cycle cannot both pass the check.
+	return ds.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if _, err := getTodoOnBoard(ctx, repos.Todos, boardId, todoId); err != nil {
+			return err
+		}
+		if err := checkBoardWritable(ctx, repos, boardId); err != nil {
+			return err
+		}
+
+		roomId, err := repos.Dependencies.GetRoomIdByTodoId(ctx, todoId)
+		if err != nil {
+			return err
+		}
+		blockerRoomId, err := repos.Dependencies.GetRoomIdByTodoId(ctx, blockerId)
+		if err != nil {
+			return err
+		}
+		if roomId != blockerRoomId {
+			return entities.ErrDependencyOutsideRoom
+		}
+
+		if err := repos.Dependencies.LockRoom(ctx, roomId); err != nil {
+			return err
+		}
+
+		// Dependencies never cross rooms, so the room's edges are the whole graph.
+		dependencies, err := repos.Dependencies.GetAllByRoomId(ctx, roomId)
+		if err != nil {
+			return err
+		}
+
+		blockers := make(map[int][]int)
+		for _, d := range dependencies {
+			if d.TodoId == todoId && d.BlockerId == blockerId {
+				return nil
+			}
+			blockers[d.TodoId] = append(blockers[d.TodoId], d.BlockerId)
+		}
+
+		if reachable(blockers, blockerId, todoId) {
+			return entities.ErrDependencyCycle
+		}
+
+		// A dependency has no id of its own, so its events are kept under the blocked todo.
+		if err := repos.Dependencies.Create(ctx, dependency); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityDependency, todoId, entities.AuditActionCreate, nil, dependency)
+	})
+}
+
+func (ds *DependencyService) RemoveBlocker(ctx context.Context, boardId, todoId, blockerId int) error {
+	return ds.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if _, err := getTodoOnBoard(ctx, repos.Todos, boardId, todoId); err != nil {
+			return err
+		}
+
+		dependencies, err := repos.Dependencies.GetAllByTodoId(ctx, todoId)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range dependencies {
+			if d.BlockerId != blockerId {
+				continue
+			}
+
+			if err := checkBoardWritable(ctx, repos, boardId); err != nil {
+				return err
+			}
+
+			if err := repos.Dependencies.Delete(ctx, todoId, blockerId); err != nil {
+				return err
+			}
+
+			return audit(ctx, repos.Audits, entities.AuditEntityDependency, todoId, entities.AuditActionDelete, d, nil)
+		}
+
+		return sql.ErrNoRows
+	})
+}
+
+// reachable reports whether target can be reached from start by following edges.
+func reachable(edges map[int][]int, start, target int) bool {
+	visited := map[int]bool{start: true}
+	stack := []int{start}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current == target {
+			return true
+		}
+
+		for _, next := range edges[current] {
+			if !visited[next] {
+				visited[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+
+	return false
+}
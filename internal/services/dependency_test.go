@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddBlocker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockDependencyRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	mockBoardRepository := mock_repository.NewMockBoardRepository(ctrl)
+	service := NewDependencyService(mockRepository, mockTodoRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{
+		Dependencies: mockRepository,
+		Todos:        mockTodoRepository,
+		Boards:       mockBoardRepository,
+	}))
+	onWritableBoard := func(todoId int) {
+		mockTodoRepository.EXPECT().GetById(gomock.Any(), todoId).Return(&entities.Todo{Id: todoId, BoardId: 1}, nil)
+		mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1}, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		todoId        int
+		blockerId     int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:      "Success to add blocker",
+			todoId:    1,
+			blockerId: 2,
+			mockSetup: func() {
+				onWritableBoard(1)
+				mockRepository.EXPECT().GetRoomIdByTodoId(gomock.Any(), 1).Return(1, nil)
+				mockRepository.EXPECT().GetRoomIdByTodoId(gomock.Any(), 2).Return(1, nil)
+				mockRepository.EXPECT().LockRoom(gomock.Any(), 1).Return(nil)
+				mockRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1).
+					Return([]*entities.TodoDependency{
+						{TodoId: 2, BlockerId: 3},
+					}, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.TodoDependency{TodoId: 1, BlockerId: 2}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "Success without inserting - Due to the dependency already exists",
+			todoId:    1,
+			blockerId: 2,
+			mockSetup: func() {
+				onWritableBoard(1)
+				mockRepository.EXPECT().GetRoomIdByTodoId(gomock.Any(), 1).Return(1, nil)
+				mockRepository.EXPECT().GetRoomIdByTodoId(gomock.Any(), 2).Return(1, nil)
+				mockRepository.EXPECT().LockRoom(gomock.Any(), 1).Return(nil)
+				mockRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1).
+					Return([]*entities.TodoDependency{
+						{TodoId: 1, BlockerId: 2},
+					}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to add blocker - Due to the todo blocks itself",
+			todoId:        1,
+			blockerId:     1,
+			mockSetup:     func() {},
+			expectedError: errors.New("Invalid dependency"),
+		},
+		{
+			name:      "Failed to add blocker - Due to the todo not found",
+			todoId:    999,
+			blockerId: 2,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 999).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:      "Failed to add blocker - Due to the todo on another board",
+			todoId:    3,
+			blockerId: 2,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 3).Return(&entities.Todo{Id: 3, BoardId: 2}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:      "Failed to add blocker - Due to the archived board",
+			todoId:    1,
+			blockerId: 2,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1}, nil)
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1, RoomArchived: true}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+		{
+			name:      "Failed to add blocker - Due to the blocker is in another room",
+			todoId:    1,
+			blockerId: 2,
+			mockSetup: func() {
+				onWritableBoard(1)
+				mockRepository.EXPECT().GetRoomIdByTodoId(gomock.Any(), 1).Return(1, nil)
+				mockRepository.EXPECT().GetRoomIdByTodoId(gomock.Any(), 2).Return(2, nil)
+			},
+			expectedError: entities.ErrDependencyOutsideRoom,
+		},
+		{
+			name:      "Failed to add blocker - Due to a direct cycle",
+			todoId:    1,
+			blockerId: 2,
+			mockSetup: func() {
+				onWritableBoard(1)
+				mockRepository.EXPECT().GetRoomIdByTodoId(gomock.Any(), 1).Return(1, nil)
+				mockRepository.EXPECT().GetRoomIdByTodoId(gomock.Any(), 2).Return(1, nil)
+				mockRepository.EXPECT().LockRoom(gomock.Any(), 1).Return(nil)
+				mockRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1).
+					Return([]*entities.TodoDependency{
+						{TodoId: 2, BlockerId: 1},
+					}, nil)
+			},
+			expectedError: entities.ErrDependencyCycle,
+		},
+		{
+			name:      "Failed to add blocker - Due to a transitive cycle",
+			todoId:    1,
+			blockerId: 2,
+			mockSetup: func() {
+				onWritableBoard(1)
+				mockRepository.EXPECT().GetRoomIdByTodoId(gomock.Any(), 1).Return(1, nil)
+				mockRepository.EXPECT().GetRoomIdByTodoId(gomock.Any(), 2).Return(1, nil)
+				mockRepository.EXPECT().LockRoom(gomock.Any(), 1).Return(nil)
+				mockRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1).
+					Return([]*entities.TodoDependency{
+						{TodoId: 2, BlockerId: 3},
+						{TodoId: 3, BlockerId: 4},
+						{TodoId: 4, BlockerId: 1},
+					}, nil)
+			},
+			expectedError: entities.ErrDependencyCycle,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.AddBlocker(context.Background(), 1, tc.todoId, tc.blockerId)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestRemoveBlocker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockDependencyRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	mockBoardRepository := mock_repository.NewMockBoardRepository(ctrl)
+	service := NewDependencyService(mockRepository, mockTodoRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{
+		Dependencies: mockRepository,
+		Todos:        mockTodoRepository,
+		Boards:       mockBoardRepository,
+	}))
+	onWritableBoard := func(todoId int) {
+		mockTodoRepository.EXPECT().GetById(gomock.Any(), todoId).Return(&entities.Todo{Id: todoId, BoardId: 1}, nil)
+		mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1}, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		todoId        int
+		blockerId     int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:      "Success to remove blocker",
+			todoId:    1,
+			blockerId: 2,
+			mockSetup: func() {
+				onWritableBoard(1)
+				mockRepository.EXPECT().GetAllByTodoId(gomock.Any(), 1).
+					Return([]*entities.TodoDependency{{TodoId: 1, BlockerId: 2}}, nil)
+				mockRepository.EXPECT().Delete(gomock.Any(), 1, 2).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "Failed to remove blocker - Due to the dependency not found",
+			todoId:    1,
+			blockerId: 3,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1}, nil)
+				mockRepository.EXPECT().GetAllByTodoId(gomock.Any(), 1).
+					Return([]*entities.TodoDependency{{TodoId: 1, BlockerId: 2}}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:      "Failed to remove blocker - Due to the todo on another board",
+			todoId:    3,
+			blockerId: 2,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 3).Return(&entities.Todo{Id: 3, BoardId: 2}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:      "Failed to remove blocker - Due to the archived board",
+			todoId:    1,
+			blockerId: 2,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1}, nil)
+				mockRepository.EXPECT().GetAllByTodoId(gomock.Any(), 1).
+					Return([]*entities.TodoDependency{{TodoId: 1, BlockerId: 2}}, nil)
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1, ArchivedAt: &testNow}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.RemoveBlocker(context.Background(), 1, tc.todoId, tc.blockerId)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
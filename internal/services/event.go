@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type EventService struct {
+	hub       interfaces.EventHub
+	roomRepo  interfaces.RoomRepository
+	boardRepo interfaces.BoardRepository
+}
+
+func NewEventService(hub interfaces.EventHub, roomRepo interfaces.RoomRepository, boardRepo interfaces.BoardRepository) *EventService {
+	return &EventService{
+		hub:       hub,
+		roomRepo:  roomRepo,
+		boardRepo: boardRepo,
+	}
+}
+
+// Subscribe follows the events of an existing room, see EventHub.Subscribe.
+func (es *EventService) Subscribe(ctx context.Context, roomId int, lastEventId int64) (*entities.EventSubscription, error) {
+	if _, err := es.roomRepo.GetById(ctx, roomId); err != nil {
+		return nil, err
+	}
+
+	return es.hub.Subscribe(roomId, lastEventId)
+}
+
+// SubscribeBoard follows the events of the board's room. Receivers pick out the events of
+// the board with Event.ConcernsBoard.
+func (es *EventService) SubscribeBoard(ctx context.Context, boardId int, lastEventId int64) (*entities.EventSubscription, error) {
+	board, err := es.boardRepo.GetById(ctx, boardId)
+	if err != nil {
+		return nil, err
+	}
+
+	return es.hub.Subscribe(board.RoomId, lastEventId)
+}
+
+func (es *EventService) Unsubscribe(sub *entities.EventSubscription) {
+	es.hub.Unsubscribe(sub)
+}
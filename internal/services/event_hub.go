@@ -0,0 +1,137 @@
+package services
+
+import (
+	"sync"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+// EventHub fans the events of each room out to its subscribers within this process. The
+// events come numbered from the outbox by an OutboxRelay.
+type EventHub struct {
+	cfg config.Events
+
+	mu      sync.Mutex
+	startId int64 // events up to this id were committed before the hub followed the outbox
+	lastId  int64
+	rooms   map[int]*roomEvents
+	closed  bool
+}
+
+type roomEvents struct {
+	recent      []*entities.Event // the last BacklogSize events, oldest first
+	evictedId   int64             // id of the newest event dropped from recent
+	subscribers map[*entities.EventSubscription]chan *entities.Event
+}
+
+func NewEventHub(cfg config.Events) *EventHub {
+	return &EventHub{
+		cfg:   cfg,
+		rooms: make(map[int]*roomEvents),
+	}
+}
+
+// Follow starts the hub after lastEventId. Clients resuming from before it missed events the
+// hub never saw, so they are told to reset.
+func (h *EventHub) Follow(lastEventId int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.startId = lastEventId
+	h.lastId = lastEventId
+}
+
+// Publish hands the event to every subscriber of its room without waiting on any of them.
+// A subscriber whose buffer is full is dropped and has to resume from its last event.
+// Events already published, as the outbox may deliver more than once, are ignored.
+func (h *EventHub) Publish(event *entities.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed || event.Id <= h.lastId {
+		return
+	}
+	h.lastId = event.Id
+
+	room := h.room(event.RoomId)
+	room.recent = append(room.recent, event)
+	if n := len(room.recent) - h.cfg.BacklogSize; n > 0 {
+		room.evictedId = room.recent[n-1].Id
+		room.recent = append([]*entities.Event(nil), room.recent[n:]...)
+	}
+
+	for sub, ch := range room.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(room.subscribers, sub)
+			close(ch)
+		}
+	}
+}
+
+// Subscribe follows the room from lastEventId on, or from now when lastEventId is zero.
+func (h *EventHub) Subscribe(roomId int, lastEventId int64) (*entities.EventSubscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, entities.ErrEventsClosed
+	}
+
+	room := h.room(roomId)
+	sub := &entities.EventSubscription{RoomId: roomId}
+	if lastEventId != 0 {
+		sub.Reset = lastEventId < h.startId || lastEventId < room.evictedId
+		for _, event := range room.recent {
+			if event.Id > lastEventId {
+				sub.Backlog = append(sub.Backlog, event)
+			}
+		}
+	}
+
+	ch := make(chan *entities.Event, h.cfg.SubscriberBuffer)
+	room.subscribers[sub] = ch
+	sub.Events = ch
+
+	return sub, nil
+}
+
+func (h *EventHub) Unsubscribe(sub *entities.EventSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room, ok := h.rooms[sub.RoomId]
+	if !ok {
+		return
+	}
+	if ch, ok := room.subscribers[sub]; ok {
+		delete(room.subscribers, sub)
+		close(ch)
+	}
+}
+
+// Close ends every subscription so that open streams return and the server can shut down.
+func (h *EventHub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for _, room := range h.rooms {
+		for sub, ch := range room.subscribers {
+			delete(room.subscribers, sub)
+			close(ch)
+		}
+	}
+}
+
+func (h *EventHub) room(roomId int) *roomEvents {
+	room, ok := h.rooms[roomId]
+	if !ok {
+		room = &roomEvents{subscribers: make(map[*entities.EventSubscription]chan *entities.Event)}
+		h.rooms[roomId] = room
+	}
+
+	return room
+}
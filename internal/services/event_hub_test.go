@@ -0,0 +1,133 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func eventIds(events []*entities.Event) []int64 {
+	var ids []int64
+	for _, event := range events {
+		ids = append(ids, event.Id)
+	}
+	return ids
+}
+
+func TestEventHubPublish(t *testing.T) {
+	hub := NewEventHub(config.Events{BacklogSize: 10, SubscriberBuffer: 10})
+
+	sub, err := hub.Subscribe(1, 0)
+	require.NoError(t, err)
+	other, err := hub.Subscribe(2, 0)
+	require.NoError(t, err)
+
+	hub.Publish(&entities.Event{Id: 1, RoomId: 1, Type: entities.EventTodoCreated, Data: &entities.Todo{Id: 1}})
+	// The outbox may deliver an event again.
+	hub.Publish(&entities.Event{Id: 1, RoomId: 1, Type: entities.EventTodoCreated, Data: &entities.Todo{Id: 1}})
+
+	event := <-sub.Events
+	assert.Equal(t, int64(1), event.Id)
+	assert.Equal(t, 1, event.RoomId)
+	assert.Equal(t, entities.EventTodoCreated, event.Type)
+	assert.Equal(t, &entities.Todo{Id: 1}, event.Data)
+	assert.Empty(t, other.Events)
+	assert.Empty(t, sub.Events)
+
+	hub.Unsubscribe(sub)
+	_, ok := <-sub.Events
+	assert.False(t, ok)
+}
+
+func TestEventHubResume(t *testing.T) {
+	hub := NewEventHub(config.Events{BacklogSize: 2, SubscriberBuffer: 10})
+	hub.Follow(100)
+
+	sub, err := hub.Subscribe(1, 0)
+	require.NoError(t, err)
+	for id := int64(101); id <= 103; id++ {
+		hub.Publish(&entities.Event{Id: id, RoomId: 1, Type: entities.EventTodoUpdated, Data: &entities.Todo{Id: 1}})
+	}
+	first, second, third := <-sub.Events, <-sub.Events, <-sub.Events
+
+	testCases := []struct {
+		name            string
+		lastEventId     int64
+		expectedBacklog []int64
+		expectedReset   bool
+	}{
+		{
+			name:            "Success to resume from an event in the backlog",
+			lastEventId:     second.Id,
+			expectedBacklog: []int64{third.Id},
+			expectedReset:   false,
+		},
+		{
+			name:            "Success to resume with a reset after missing evicted events",
+			lastEventId:     first.Id - 1,
+			expectedBacklog: []int64{second.Id, third.Id},
+			expectedReset:   true,
+		},
+		{
+			name:            "Success to resume with a reset from an id before the hub started",
+			lastEventId:     99,
+			expectedBacklog: []int64{second.Id, third.Id},
+			expectedReset:   true,
+		},
+		{
+			name:            "Success to resume without missing anything",
+			lastEventId:     third.Id,
+			expectedBacklog: nil,
+			expectedReset:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resumed, err := hub.Subscribe(1, tc.lastEventId)
+			require.NoError(t, err)
+			defer hub.Unsubscribe(resumed)
+
+			assert.Equal(t, tc.expectedBacklog, eventIds(resumed.Backlog))
+			assert.Equal(t, tc.expectedReset, resumed.Reset)
+		})
+	}
+}
+
+func TestEventHubDropsSlowSubscriber(t *testing.T) {
+	hub := NewEventHub(config.Events{BacklogSize: 10, SubscriberBuffer: 1})
+
+	slow, err := hub.Subscribe(1, 0)
+	require.NoError(t, err)
+
+	hub.Publish(&entities.Event{Id: 1, RoomId: 1, Type: entities.EventTodoCreated, Data: &entities.Todo{Id: 1}})
+	hub.Publish(&entities.Event{Id: 2, RoomId: 1, Type: entities.EventTodoCreated, Data: &entities.Todo{Id: 2}})
+
+	event, ok := <-slow.Events
+	assert.True(t, ok)
+	_, ok = <-slow.Events
+	assert.False(t, ok)
+
+	resumed, err := hub.Subscribe(1, event.Id)
+	require.NoError(t, err)
+	assert.Len(t, resumed.Backlog, 1)
+	assert.False(t, resumed.Reset)
+}
+
+func TestEventHubClose(t *testing.T) {
+	hub := NewEventHub(config.Events{BacklogSize: 10, SubscriberBuffer: 10})
+
+	sub, err := hub.Subscribe(1, 0)
+	require.NoError(t, err)
+
+	hub.Close()
+	_, ok := <-sub.Events
+	assert.False(t, ok)
+
+	hub.Unsubscribe(sub)
+	_, err = hub.Subscribe(1, 0)
+	assert.Equal(t, entities.ErrEventsClosed, err)
+}
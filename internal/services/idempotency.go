@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type IdempotencyService struct {
+	repo interfaces.IdempotencyRepository
+	cfg  config.Idempotency
+	now  func() time.Time
+}
+
+func NewIdempotencyService(repo interfaces.IdempotencyRepository, cfg config.Idempotency) *IdempotencyService {
+	return &IdempotencyService{
+		repo: repo,
+		cfg:  cfg,
+		now:  time.Now,
+	}
+}
+
+// Begin claims key of the actor for the request hashed to requestHash. It returns the recorded
+// response and true for a repeat of a completed request. Otherwise it returns a new record
+// that must be completed or released once the request has been handled.
+func (is *IdempotencyService) Begin(ctx context.Context, key, requestHash string) (*entities.IdempotencyRecord, bool, error) {
+	now := is.now().UTC()
+	record := entities.NewIdempotencyRecord(ActorFrom(ctx).Name, key, requestHash, now.Add(is.cfg.LockTimeout), now.Add(is.cfg.KeyTTL))
+	if err := record.Validate(); err != nil {
+		return nil, false, err
+	}
+
+	existing, err := is.repo.Claim(ctx, record, now)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The request holding the key released it between our insert and lookup.
+		return nil, false, entities.ErrIdempotencyKeyInFlight
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if existing == nil {
+		return record, false, nil
+	}
+
+	if existing.RequestHash != requestHash {
+		return nil, false, entities.ErrIdempotencyKeyReused
+	}
+	if !existing.Completed() {
+		return nil, false, entities.ErrIdempotencyKeyInFlight
+	}
+
+	return existing, true, nil
+}
+
+func (is *IdempotencyService) Complete(ctx context.Context, record *entities.IdempotencyRecord, statusCode int, header map[string][]string, body []byte) error {
+	record.Complete(statusCode, header, body)
+
+	return is.repo.Complete(ctx, record)
+}
+
+// Release frees the key so that the request can be retried.
+func (is *IdempotencyService) Release(ctx context.Context, record *entities.IdempotencyRecord) error {
+	return is.repo.Release(ctx, record.Id)
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+// IdempotencyPurger deletes expired idempotency keys, which requests otherwise only take over
+// when they reuse them.
+type IdempotencyPurger struct {
+	repo interfaces.IdempotencyRepository
+	cfg  config.Idempotency
+	now  func() time.Time
+}
+
+func NewIdempotencyPurger(repo interfaces.IdempotencyRepository, cfg config.Idempotency) *IdempotencyPurger {
+	return &IdempotencyPurger{
+		repo: repo,
+		cfg:  cfg,
+		now:  time.Now,
+	}
+}
+
+// Run purges expired keys every purge interval until ctx is canceled.
+func (ip *IdempotencyPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(ip.cfg.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := ip.Purge(ctx); err != nil {
+			log.Printf("failed to purge idempotency keys: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ip *IdempotencyPurger) Purge(ctx context.Context) error {
+	purged, err := ip.repo.DeleteExpired(ctx, ip.now().UTC())
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		log.Printf("purged %d expired idempotency keys", purged)
+	}
+
+	return nil
+}
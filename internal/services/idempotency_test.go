@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBeginIdempotency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockIdempotencyRepository(ctrl)
+	service := NewIdempotencyService(mockRepository, config.Idempotency{KeyTTL: time.Hour, LockTimeout: time.Minute})
+	service.now = func() time.Time { return testNow }
+
+	claimed := &entities.IdempotencyRecord{UserId: "alice", Key: "key", RequestHash: "hash", LockedUntil: testNow.Add(time.Minute), ExpiresAt: testNow.Add(time.Hour)}
+	completed := &entities.IdempotencyRecord{Id: 1, Key: "key", RequestHash: "hash", StatusCode: 201, Body: []byte(`{"id":1}`)}
+
+	testCases := []struct {
+		name           string
+		key            string
+		mockSetup      func()
+		expectedRecord *entities.IdempotencyRecord
+		expectedReplay bool
+		expectedError  error
+	}{
+		{
+			name: "Success to claim a new key",
+			key:  "key",
+			mockSetup: func() {
+				mockRepository.EXPECT().Claim(gomock.Any(), claimed, testNow).Return(nil, nil)
+			},
+			expectedRecord: claimed,
+			expectedReplay: false,
+			expectedError:  nil,
+		},
+		{
+			name: "Success to replay a completed request",
+			key:  "key",
+			mockSetup: func() {
+				mockRepository.EXPECT().Claim(gomock.Any(), claimed, testNow).Return(completed, nil)
+			},
+			expectedRecord: completed,
+			expectedReplay: true,
+			expectedError:  nil,
+		},
+		{
+			name:           "Failed to begin - Due to the empty key",
+			key:            "",
+			mockSetup:      func() {},
+			expectedRecord: nil,
+			expectedError:  entities.ErrInvalidIdempotencyKey,
+		},
+		{
+			name: "Failed to begin - Due to the key used for a different request",
+			key:  "key",
+			mockSetup: func() {
+				mockRepository.EXPECT().Claim(gomock.Any(), claimed, testNow).
+					Return(&entities.IdempotencyRecord{Id: 1, Key: "key", RequestHash: "other", StatusCode: 201}, nil)
+			},
+			expectedRecord: nil,
+			expectedError:  entities.ErrIdempotencyKeyReused,
+		},
+		{
+			name: "Failed to begin - Due to the request holding the key is in progress",
+			key:  "key",
+			mockSetup: func() {
+				mockRepository.EXPECT().Claim(gomock.Any(), claimed, testNow).
+					Return(&entities.IdempotencyRecord{Id: 1, Key: "key", RequestHash: "hash"}, nil)
+			},
+			expectedRecord: nil,
+			expectedError:  entities.ErrIdempotencyKeyInFlight,
+		},
+		{
+			name: "Failed to begin - Due to the key released during the claim",
+			key:  "key",
+			mockSetup: func() {
+				mockRepository.EXPECT().Claim(gomock.Any(), claimed, testNow).Return(nil, sql.ErrNoRows)
+			},
+			expectedRecord: nil,
+			expectedError:  entities.ErrIdempotencyKeyInFlight,
+		},
+		{
+			name: "Failed to begin - Due to unexpected errors",
+			key:  "key",
+			mockSetup: func() {
+				mockRepository.EXPECT().Claim(gomock.Any(), claimed, testNow).Return(nil, errors.New("unexpected error"))
+			},
+			expectedRecord: nil,
+			expectedError:  errors.New("unexpected error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			ctx := WithActor(context.Background(), entities.Actor{Name: "alice"})
+			record, replay, err := service.Begin(ctx, tc.key, "hash")
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedRecord, record)
+			assert.Equal(t, tc.expectedReplay, replay)
+		})
+	}
+}
+
+func TestCompleteIdempotency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockIdempotencyRepository(ctrl)
+	service := NewIdempotencyService(mockRepository, config.Idempotency{KeyTTL: time.Hour})
+
+	header := map[string][]string{"Content-Type": {"application/json; charset=utf-8"}}
+	mockRepository.EXPECT().Complete(gomock.Any(), &entities.IdempotencyRecord{
+		Id:          1,
+		Key:         "key",
+		RequestHash: "hash",
+		StatusCode:  201,
+		Header:      header,
+		Body:        []byte(`{"id":1}`),
+	}).Return(nil)
+
+	record := &entities.IdempotencyRecord{Id: 1, Key: "key", RequestHash: "hash"}
+	err := service.Complete(context.Background(), record, 201, header, []byte(`{"id":1}`))
+
+	assert.NoError(t, err)
+	assert.True(t, record.Completed())
+}
+
+func TestPurgeIdempotencyKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockIdempotencyRepository(ctrl)
+	purger := NewIdempotencyPurger(mockRepository, config.Idempotency{})
+	purger.now = func() time.Time { return testNow }
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to purge expired keys",
+			mockSetup: func() {
+				mockRepository.EXPECT().DeleteExpired(gomock.Any(), testNow).Return(2, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to purge - Due to unexpected errors",
+			mockSetup: func() {
+				mockRepository.EXPECT().DeleteExpired(gomock.Any(), testNow).Return(0, errors.New("unexpected error"))
+			},
+			expectedError: errors.New("unexpected error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := purger.Purge(context.Background())
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
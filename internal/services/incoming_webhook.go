@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type IncomingWebhookService struct {
+	repo      interfaces.IncomingWebhookRepository
+	boardRepo interfaces.BoardRepository
+	todos     interfaces.TodoServicer
+	uow       interfaces.UnitOfWork
+}
+
+func NewIncomingWebhookService(repo interfaces.IncomingWebhookRepository, boardRepo interfaces.BoardRepository, todos interfaces.TodoServicer, uow interfaces.UnitOfWork) *IncomingWebhookService {
+	return &IncomingWebhookService{
+		repo:      repo,
+		boardRepo: boardRepo,
+		todos:     todos,
+		uow:       uow,
+	}
+}
+
+func (is *IncomingWebhookService) GetAll(ctx context.Context, boardId int) ([]*entities.IncomingWebhook, error) {
+	return is.repo.GetAllByBoardId(ctx, boardId)
+}
+
+func (is *IncomingWebhookService) Create(ctx context.Context, boardId int) (string, *entities.IncomingWebhook, error) {
+	if _, err := is.boardRepo.GetById(ctx, boardId); err != nil {
+		return "", nil, err
+	}
+
+	token, webhook, err := entities.NewIncomingWebhook(boardId)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = is.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.IncomingWebhooks.Create(ctx, webhook); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityIncomingWebhook, webhook.Id, entities.AuditActionCreate, nil, webhook)
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, webhook, nil
+}
+
+// Delete reports webhooks of other boards as missing. The todos the webhook created stay.
+func (is *IncomingWebhookService) Delete(ctx context.Context, boardId, id int) error {
+	webhook, err := is.repo.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if webhook.BoardId != boardId {
+		return sql.ErrNoRows
+	}
+
+	return is.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.IncomingWebhooks.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityIncomingWebhook, id, entities.AuditActionDelete, webhook, nil)
+	})
+}
+
+// errIncomingKeyTaken reports that another alert created the todo of the key while this one
+// waited for it.
+var errIncomingKeyTaken = errors.New("incoming webhook key is taken")
+
+// Receive goes through TodoService, so the todos get the same history, audit and events as
+// those changed through the API. A todo that was deleted since is created again. A new key is
+// claimed before its todo is created in the same transaction, so alerts with the same key that
+// arrive at the same time create a single todo and update it.
+func (is *IncomingWebhookService) Receive(ctx context.Context, token string, incoming *entities.IncomingTodo) (*entities.Todo, bool, error) {
+	webhook, err := is.repo.GetByTokenHash(ctx, entities.HashIncomingWebhookToken(token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, entities.ErrInvalidIncomingWebhookToken
+		}
+		return nil, false, err
+	}
+
+	if actor := ActorFrom(ctx); actor.Name == "" {
+		actor.Name = entities.IncomingWebhookActor
+		ctx = WithActor(ctx, actor)
+	}
+
+	if incoming.ExternalKey != "" {
+		todo, err := is.update(ctx, webhook.Id, incoming)
+		if err == nil {
+			return todo, false, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, false, err
+		}
+	}
+
+	var priority int
+	if incoming.Priority != nil {
+		priority = *incoming.Priority
+	}
+	if incoming.ExternalKey == "" {
+		todo, err := is.todos.Create(ctx, webhook.BoardId, incoming.Title, false, nil, priority, incoming.DueDate, nil)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return todo, true, nil
+	}
+
+	var todo *entities.Todo
+	err = is.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		todoId, err := repos.IncomingWebhooks.ClaimKey(ctx, webhook.Id, incoming.ExternalKey)
+		if err != nil {
+			return err
+		}
+		if todoId != 0 {
+			_, err := repos.Todos.GetById(ctx, todoId)
+			if err == nil {
+				return errIncomingKeyTaken
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+		}
+
+		todo, err = is.todos.CreateIn(ctx, repos, webhook.BoardId, incoming.Title, false, nil, priority, incoming.DueDate, nil)
+		if err != nil {
+			return err
+		}
+
+		return repos.IncomingWebhooks.SetTodoId(ctx, webhook.Id, incoming.ExternalKey, todo.Id)
+	})
+	if errors.Is(err, errIncomingKeyTaken) {
+		todo, err := is.update(ctx, webhook.Id, incoming)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return todo, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return todo, true, nil
+}
+
+// update returns sql.ErrNoRows when the key has no live todo.
+func (is *IncomingWebhookService) update(ctx context.Context, webhookId int, incoming *entities.IncomingTodo) (*entities.Todo, error) {
+	todoId, err := is.repo.GetTodoId(ctx, webhookId, incoming.ExternalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := is.todos.GetById(ctx, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	priority, dueDate := todo.Priority, todo.DueDate
+	if incoming.Priority != nil {
+		priority = *incoming.Priority
+	}
+	if incoming.DueDate != nil {
+		dueDate = incoming.DueDate
+	}
+
+	if err := is.todos.Update(ctx, todo.Id, incoming.Title, todo.Done, &todo.StatusId, priority, dueDate, todo.Recurrence); err != nil {
+		return nil, err
+	}
+
+	return is.todos.GetById(ctx, todo.Id)
+}
@@ -0,0 +1,242 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestIncomingWebhookService(ctrl *gomock.Controller) (*IncomingWebhookService, *mock_repository.MockIncomingWebhookRepository, *mock_repository.MockBoardRepository, *mock_repository.MockTodoRepository, *mock_repository.MockTodoServicer) {
+	mockRepository := mock_repository.NewMockIncomingWebhookRepository(ctrl)
+	mockBoardRepository := mock_repository.NewMockBoardRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	mockTodoService := mock_repository.NewMockTodoServicer(ctrl)
+	uow := newAuditedUnitOfWork(ctrl, &interfaces.Repositories{IncomingWebhooks: mockRepository, Todos: mockTodoRepository})
+	service := NewIncomingWebhookService(mockRepository, mockBoardRepository, mockTodoService, uow)
+
+	return service, mockRepository, mockBoardRepository, mockTodoRepository, mockTodoService
+}
+
+func TestCreateIncomingWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockBoardRepository, _, _ := newTestIncomingWebhookService(ctrl)
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to create incoming webhook",
+			mockSetup: func() {
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1}, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to create incoming webhook - Due to missing board",
+			mockSetup: func() {
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			token, webhook, err := service.Create(context.Background(), 1)
+
+			assert.ErrorIs(t, err, tc.expectedError)
+			if tc.expectedError == nil {
+				assert.Len(t, token, 64)
+				assert.Equal(t, 1, webhook.BoardId)
+				assert.Equal(t, entities.HashIncomingWebhookToken(token), webhook.TokenHash)
+			}
+		})
+	}
+}
+
+func TestDeleteIncomingWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, _, _, _ := newTestIncomingWebhookService(ctrl)
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to delete incoming webhook",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(&entities.IncomingWebhook{Id: 2, BoardId: 1}, nil)
+				mockRepository.EXPECT().Delete(gomock.Any(), 2).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to delete incoming webhook - Due to webhook of another board",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(&entities.IncomingWebhook{Id: 2, BoardId: 3}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Delete(context.Background(), 1, 2)
+
+			assert.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestReceiveIncomingWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, _, mockTodoRepository, mockTodoService := newTestIncomingWebhookService(ctrl)
+
+	tokenHash := entities.HashIncomingWebhookToken("token")
+	webhook := &entities.IncomingWebhook{Id: 2, BoardId: 1, TokenHash: tokenHash}
+	priority := 3
+	existing := &entities.Todo{Id: 5, BoardId: 1, Title: "disk full", StatusId: 4, Priority: 1, DueDate: &testNow}
+
+	// The todo service runs as the incoming webhook actor when the request names none.
+	asWebhook := gomock.Cond(func(ctx context.Context) bool {
+		return ActorFrom(ctx).Name == entities.IncomingWebhookActor
+	})
+
+	testCases := []struct {
+		name            string
+		token           string
+		incoming        *entities.IncomingTodo
+		mockSetup       func()
+		expectedCreated bool
+		expectedError   error
+	}{
+		{
+			name:     "Success to receive todo without external key",
+			token:    "token",
+			incoming: &entities.IncomingTodo{Title: "disk full"},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByTokenHash(gomock.Any(), tokenHash).Return(webhook, nil)
+				mockTodoService.EXPECT().Create(asWebhook, 1, "disk full", false, nil, 0, nil, nil).Return(&entities.Todo{Id: 5}, nil)
+			},
+			expectedCreated: true,
+			expectedError:   nil,
+		},
+		{
+			name:     "Success to receive todo with new external key",
+			token:    "token",
+			incoming: &entities.IncomingTodo{ExternalKey: "alert-1", Title: "disk full", Priority: &priority},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByTokenHash(gomock.Any(), tokenHash).Return(webhook, nil)
+				mockRepository.EXPECT().GetTodoId(gomock.Any(), 2, "alert-1").Return(0, sql.ErrNoRows)
+				mockRepository.EXPECT().ClaimKey(gomock.Any(), 2, "alert-1").Return(0, nil)
+				mockTodoService.EXPECT().CreateIn(asWebhook, gomock.Any(), 1, "disk full", false, nil, 3, nil, nil).Return(&entities.Todo{Id: 5}, nil)
+				mockRepository.EXPECT().SetTodoId(gomock.Any(), 2, "alert-1", 5).Return(nil)
+			},
+			expectedCreated: true,
+			expectedError:   nil,
+		},
+		{
+			name:     "Success to receive todo with known external key",
+			token:    "token",
+			incoming: &entities.IncomingTodo{ExternalKey: "alert-1", Title: "disk still full"},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByTokenHash(gomock.Any(), tokenHash).Return(webhook, nil)
+				mockRepository.EXPECT().GetTodoId(gomock.Any(), 2, "alert-1").Return(5, nil)
+				mockTodoService.EXPECT().GetById(gomock.Any(), 5).Return(existing, nil)
+				statusId := 4
+				mockTodoService.EXPECT().Update(asWebhook, 5, "disk still full", false, &statusId, 1, &testNow, nil).Return(nil)
+				mockTodoService.EXPECT().GetById(gomock.Any(), 5).Return(&entities.Todo{Id: 5}, nil)
+			},
+			expectedCreated: false,
+			expectedError:   nil,
+		},
+		{
+			name:     "Success to receive todo with external key of deleted todo",
+			token:    "token",
+			incoming: &entities.IncomingTodo{ExternalKey: "alert-1", Title: "disk full"},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByTokenHash(gomock.Any(), tokenHash).Return(webhook, nil)
+				mockRepository.EXPECT().GetTodoId(gomock.Any(), 2, "alert-1").Return(5, nil)
+				mockTodoService.EXPECT().GetById(gomock.Any(), 5).Return(nil, sql.ErrNoRows)
+				mockRepository.EXPECT().ClaimKey(gomock.Any(), 2, "alert-1").Return(5, nil)
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 5).Return(nil, sql.ErrNoRows)
+				mockTodoService.EXPECT().CreateIn(asWebhook, gomock.Any(), 1, "disk full", false, nil, 0, nil, nil).Return(&entities.Todo{Id: 6}, nil)
+				mockRepository.EXPECT().SetTodoId(gomock.Any(), 2, "alert-1", 6).Return(nil)
+			},
+			expectedCreated: true,
+			expectedError:   nil,
+		},
+		{
+			name:     "Success to update todo created by concurrent alert with the same new key",
+			token:    "token",
+			incoming: &entities.IncomingTodo{ExternalKey: "alert-1", Title: "disk still full"},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByTokenHash(gomock.Any(), tokenHash).Return(webhook, nil)
+				mockRepository.EXPECT().GetTodoId(gomock.Any(), 2, "alert-1").Return(0, sql.ErrNoRows)
+				mockRepository.EXPECT().ClaimKey(gomock.Any(), 2, "alert-1").Return(5, nil)
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 5).Return(existing, nil)
+				mockRepository.EXPECT().GetTodoId(gomock.Any(), 2, "alert-1").Return(5, nil)
+				mockTodoService.EXPECT().GetById(gomock.Any(), 5).Return(existing, nil)
+				statusId := 4
+				mockTodoService.EXPECT().Update(asWebhook, 5, "disk still full", false, &statusId, 1, &testNow, nil).Return(nil)
+				mockTodoService.EXPECT().GetById(gomock.Any(), 5).Return(&entities.Todo{Id: 5}, nil)
+			},
+			expectedCreated: false,
+			expectedError:   nil,
+		},
+		{
+			name:     "Failed to receive todo - Due to unknown token",
+			token:    "unknown",
+			incoming: &entities.IncomingTodo{Title: "disk full"},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByTokenHash(gomock.Any(), entities.HashIncomingWebhookToken("unknown")).Return(nil, sql.ErrNoRows)
+			},
+			expectedCreated: false,
+			expectedError:   entities.ErrInvalidIncomingWebhookToken,
+		},
+		{
+			name:     "Failed to receive todo - Due to archived board",
+			token:    "token",
+			incoming: &entities.IncomingTodo{ExternalKey: "alert-1", Title: "disk full"},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByTokenHash(gomock.Any(), tokenHash).Return(webhook, nil)
+				mockRepository.EXPECT().GetTodoId(gomock.Any(), 2, "alert-1").Return(0, sql.ErrNoRows)
+				mockRepository.EXPECT().ClaimKey(gomock.Any(), 2, "alert-1").Return(0, nil)
+				mockTodoService.EXPECT().CreateIn(asWebhook, gomock.Any(), 1, "disk full", false, nil, 0, nil, nil).Return(nil, entities.ErrArchived)
+			},
+			expectedCreated: false,
+			expectedError:   entities.ErrArchived,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			_, created, err := service.Receive(context.Background(), tc.token, tc.incoming)
+
+			assert.ErrorIs(t, err, tc.expectedError)
+			assert.Equal(t, tc.expectedCreated, created)
+		})
+	}
+}
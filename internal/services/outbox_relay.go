@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+// OutboxRelay hands the events of the outbox to the subscribers on this server. Every
+// replica runs one, so changes reach clients on whichever replica they are connected to.
+type OutboxRelay struct {
+	repo interfaces.OutboxRepository
+	hub  interfaces.EventHub
+	cfg  config.Outbox
+	id   string
+	now  func() time.Time
+
+	lastId int64 // every event up to this id has been handed to the hub
+}
+
+func NewOutboxRelay(repo interfaces.OutboxRepository, hub interfaces.EventHub, cfg config.Outbox) *OutboxRelay {
+	b := make([]byte, 16)
+	rand.Read(b)
+
+	return &OutboxRelay{
+		repo: repo,
+		hub:  hub,
+		cfg:  cfg,
+		id:   hex.EncodeToString(b),
+		now:  time.Now,
+	}
+}
+
+// Start registers the relay after the newest event, which is where the hub starts too.
+func (ob *OutboxRelay) Start(ctx context.Context) error {
+	lastId, err := ob.repo.Register(ctx, ob.id, ob.now().UTC())
+	if err != nil {
+		return err
+	}
+
+	ob.lastId = lastId
+	ob.hub.Follow(lastId)
+
+	return nil
+}
+
+// Run relays events every poll interval, and purges delivered ones every relay timeout,
+// until ctx is canceled.
+func (ob *OutboxRelay) Run(ctx context.Context) {
+	poll := time.NewTicker(ob.cfg.PollInterval)
+	defer poll.Stop()
+	purge := time.NewTicker(ob.cfg.RelayTimeout)
+	defer purge.Stop()
+
+	for {
+		if err := ob.Relay(ctx); err != nil {
+			log.Printf("failed to relay events: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+		case <-purge.C:
+			if err := ob.Purge(ctx); err != nil {
+				log.Printf("failed to purge the outbox: %v", err)
+			}
+		}
+	}
+}
+
+// Relay hands the events committed since the last call to the hub in id order, and then
+// records how far it got. Event ids come from the change sequence, which commits in order,
+// so no event with a smaller id can show up later. The hub ignores events it already has,
+// so relaying twice is harmless.
+func (ob *OutboxRelay) Relay(ctx context.Context) error {
+	for {
+		events, err := ob.repo.GetAfter(ctx, ob.lastId, ob.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, oe := range events {
+			if event, err := oe.Event(); err != nil {
+				log.Printf("dropping outbox event %d: %v", oe.Id, err)
+			} else {
+				ob.hub.Publish(event)
+			}
+			ob.lastId = oe.Id
+		}
+
+		if len(events) < ob.cfg.BatchSize {
+			return ob.repo.MarkDelivered(ctx, ob.id, ob.lastId, ob.now().UTC())
+		}
+	}
+}
+
+// Purge deletes the events every live relay has delivered.
+func (ob *OutboxRelay) Purge(ctx context.Context) error {
+	purged, err := ob.repo.Purge(ctx, ob.now().UTC().Add(-ob.cfg.RelayTimeout))
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		log.Printf("purged %d events from the outbox", purged)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newOutboxEvent(t *testing.T, id int64, todo *entities.Todo) *entities.OutboxEvent {
+	event, err := entities.NewOutboxEvent(1, entities.EventTodoUpdated, todo)
+	require.NoError(t, err)
+	event.Id = id
+
+	return event
+}
+
+// publishedEvent matches the event with the id restored from the outbox.
+func publishedEvent(id int64) gomock.Matcher {
+	return gomock.Cond(func(event *entities.Event) bool { return event.Id == id })
+}
+
+func TestStartOutboxRelay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockOutboxRepository(ctrl)
+	mockHub := mock_repository.NewMockEventHub(ctrl)
+	relay := NewOutboxRelay(mockRepository, mockHub, config.Outbox{})
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to start after the newest event",
+			mockSetup: func() {
+				mockRepository.EXPECT().Register(gomock.Any(), relay.id, gomock.Any()).Return(int64(10), nil)
+				mockHub.EXPECT().Follow(int64(10))
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to start - Due to unexpected errors",
+			mockSetup: func() {
+				mockRepository.EXPECT().Register(gomock.Any(), relay.id, gomock.Any()).Return(int64(0), errors.New("unexpected error"))
+			},
+			expectedError: errors.New("unexpected error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := relay.Start(context.Background())
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestRelayOutbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockOutboxRepository(ctrl)
+	mockHub := mock_repository.NewMockEventHub(ctrl)
+
+	now := time.Date(2025, 8, 30, 9, 0, 0, 0, time.UTC)
+	newRelay := func() *OutboxRelay {
+		relay := NewOutboxRelay(mockRepository, mockHub, config.Outbox{BatchSize: 2})
+		relay.now = func() time.Time { return now }
+		relay.lastId = 10
+		return relay
+	}
+	todo := &entities.Todo{Id: 3, BoardId: 2, Title: "Buy milk"}
+
+	t.Run("Success to relay events in id order", func(t *testing.T) {
+		relay := newRelay()
+		gomock.InOrder(
+			mockRepository.EXPECT().GetAfter(gomock.Any(), int64(10), 2).Return([]*entities.OutboxEvent{newOutboxEvent(t, 11, todo), newOutboxEvent(t, 12, todo)}, nil),
+			mockHub.EXPECT().Publish(&entities.Event{Id: 11, RoomId: 1, Type: entities.EventTodoUpdated, Data: todo}),
+			mockHub.EXPECT().Publish(publishedEvent(12)),
+			// A full batch is followed by another one.
+			mockRepository.EXPECT().GetAfter(gomock.Any(), int64(12), 2).Return([]*entities.OutboxEvent{newOutboxEvent(t, 13, todo)}, nil),
+			mockHub.EXPECT().Publish(publishedEvent(13)),
+			mockRepository.EXPECT().MarkDelivered(gomock.Any(), relay.id, int64(13), now).Return(nil),
+		)
+
+		assert.NoError(t, relay.Relay(context.Background()))
+	})
+
+	t.Run("Success to relay events past ids taken by other changes", func(t *testing.T) {
+		relay := newRelay()
+		gomock.InOrder(
+			mockRepository.EXPECT().GetAfter(gomock.Any(), int64(10), 2).Return([]*entities.OutboxEvent{newOutboxEvent(t, 14, todo)}, nil),
+			mockHub.EXPECT().Publish(publishedEvent(14)),
+			mockRepository.EXPECT().MarkDelivered(gomock.Any(), relay.id, int64(14), now).Return(nil),
+		)
+
+		assert.NoError(t, relay.Relay(context.Background()))
+	})
+
+	t.Run("Success to drop an event of an unknown type", func(t *testing.T) {
+		relay := newRelay()
+		unknown := newOutboxEvent(t, 11, todo)
+		unknown.Type = "board.renamed"
+		gomock.InOrder(
+			mockRepository.EXPECT().GetAfter(gomock.Any(), int64(10), 2).Return([]*entities.OutboxEvent{unknown}, nil),
+			mockRepository.EXPECT().MarkDelivered(gomock.Any(), relay.id, int64(11), now).Return(nil),
+		)
+
+		assert.NoError(t, relay.Relay(context.Background()))
+	})
+
+	t.Run("Failed to relay events - Due to unexpected errors", func(t *testing.T) {
+		relay := newRelay()
+		mockRepository.EXPECT().GetAfter(gomock.Any(), int64(10), 2).Return(nil, errors.New("unexpected error"))
+
+		assert.Equal(t, errors.New("unexpected error"), relay.Relay(context.Background()))
+	})
+}
+
+func TestPurgeOutbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockOutboxRepository(ctrl)
+	mockHub := mock_repository.NewMockEventHub(ctrl)
+
+	now := time.Date(2025, 8, 30, 9, 0, 0, 0, time.UTC)
+	relay := NewOutboxRelay(mockRepository, mockHub, config.Outbox{RelayTimeout: time.Minute})
+	relay.now = func() time.Time { return now }
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to purge the events delivered by relays seen within the relay timeout",
+			mockSetup: func() {
+				mockRepository.EXPECT().Purge(gomock.Any(), time.Date(2025, 8, 30, 8, 59, 0, 0, time.UTC)).Return(3, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to purge - Due to unexpected errors",
+			mockSetup: func() {
+				mockRepository.EXPECT().Purge(gomock.Any(), gomock.Any()).Return(0, errors.New("unexpected error"))
+			},
+			expectedError: errors.New("unexpected error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := relay.Purge(context.Background())
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+)
+
+// PresenceHub keeps track of who views each board on this server. Viewers connected to
+// other servers are not seen.
+type PresenceHub struct {
+	mu      sync.Mutex
+	lastSeq int
+	boards  map[int]map[*entities.PresenceSession]*presenceMember
+}
+
+type presenceMember struct {
+	seq      int // join order, to list viewers stably
+	presence entities.Presence
+	updates  chan []entities.Presence
+}
+
+func NewPresenceHub() *PresenceHub {
+	return &PresenceHub{
+		boards: make(map[int]map[*entities.PresenceSession]*presenceMember),
+	}
+}
+
+// Join adds a viewer to the board and tells every viewer, the new one included.
+func (h *PresenceHub) Join(boardId int, actor string) *entities.PresenceSession {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	members, ok := h.boards[boardId]
+	if !ok {
+		members = make(map[*entities.PresenceSession]*presenceMember)
+		h.boards[boardId] = members
+	}
+
+	h.lastSeq++
+	updates := make(chan []entities.Presence, 1)
+	session := &entities.PresenceSession{BoardId: boardId, Updates: updates}
+	members[session] = &presenceMember{
+		seq:      h.lastSeq,
+		presence: entities.Presence{Actor: actor},
+		updates:  updates,
+	}
+	h.broadcast(boardId)
+
+	return session
+}
+
+// SetEditing records the todo the viewer edits, or that it stopped editing when todoId is nil.
+func (h *PresenceHub) SetEditing(session *entities.PresenceSession, todoId *int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	member, ok := h.boards[session.BoardId][session]
+	if !ok {
+		return
+	}
+	member.presence.EditingTodoId = todoId
+	h.broadcast(session.BoardId)
+}
+
+func (h *PresenceHub) Leave(session *entities.PresenceSession) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	members := h.boards[session.BoardId]
+	member, ok := members[session]
+	if !ok {
+		return
+	}
+	delete(members, session)
+	close(member.updates)
+
+	if len(members) == 0 {
+		delete(h.boards, session.BoardId)
+		return
+	}
+	h.broadcast(session.BoardId)
+}
+
+// broadcast replaces whatever list a viewer has not received yet with the current one.
+func (h *PresenceHub) broadcast(boardId int) {
+	members := make([]*presenceMember, 0, len(h.boards[boardId]))
+	for _, member := range h.boards[boardId] {
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].seq < members[j].seq })
+
+	viewers := make([]entities.Presence, len(members))
+	for i, member := range members {
+		viewers[i] = member.presence
+	}
+
+	for _, member := range members {
+		select {
+		case <-member.updates:
+		default:
+		}
+		member.updates <- viewers
+	}
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresenceHub(t *testing.T) {
+	hub := NewPresenceHub()
+
+	alice := hub.Join(1, "alice")
+	assert.Equal(t, []entities.Presence{{Actor: "alice"}}, <-alice.Updates)
+
+	bob := hub.Join(1, "bob")
+	other := hub.Join(2, "carol")
+	assert.Equal(t, []entities.Presence{{Actor: "alice"}, {Actor: "bob"}}, <-alice.Updates)
+	assert.Equal(t, []entities.Presence{{Actor: "alice"}, {Actor: "bob"}}, <-bob.Updates)
+	assert.Equal(t, []entities.Presence{{Actor: "carol"}}, <-other.Updates)
+
+	// Viewers that fall behind only get the latest list.
+	todoId := 3
+	hub.SetEditing(bob, &todoId)
+	hub.SetEditing(bob, nil)
+	hub.SetEditing(alice, &todoId)
+	expected := []entities.Presence{{Actor: "alice", EditingTodoId: &todoId}, {Actor: "bob"}}
+	assert.Equal(t, expected, <-alice.Updates)
+	assert.Equal(t, expected, <-bob.Updates)
+	assert.Empty(t, other.Updates)
+
+	hub.Leave(alice)
+	_, ok := <-alice.Updates
+	assert.False(t, ok)
+	assert.Equal(t, []entities.Presence{{Actor: "bob"}}, <-bob.Updates)
+
+	// Leaving twice does nothing.
+	hub.Leave(alice)
+	assert.Empty(t, bob.Updates)
+}
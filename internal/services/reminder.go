@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+// ReminderService reports sql.ErrNoRows when the todo is not on the board or the reminder is
+// not on the todo, so that reminders are only reached through the path of their todo.
+type ReminderService struct {
+	repo     interfaces.ReminderRepository
+	todoRepo interfaces.TodoRepository
+	uow      interfaces.UnitOfWork
+	cfg      config.Reminder
+}
+
+func NewReminderService(repo interfaces.ReminderRepository, todoRepo interfaces.TodoRepository, uow interfaces.UnitOfWork, cfg config.Reminder) *ReminderService {
+	return &ReminderService{
+		repo:     repo,
+		todoRepo: todoRepo,
+		uow:      uow,
+		cfg:      cfg,
+	}
+}
+
+func (rs *ReminderService) GetAll(ctx context.Context, boardId, todoId int) ([]*entities.Reminder, error) {
+	if _, err := getTodoOnBoard(ctx, rs.todoRepo, boardId, todoId); err != nil {
+		return nil, err
+	}
+
+	return rs.repo.GetAllByTodoId(ctx, todoId)
+}
+
+// Create rejects the webhook channel while no webhook url is configured, since such a
+// reminder could never be sent.
+func (rs *ReminderService) Create(ctx context.Context, boardId, todoId int, remindAt *time.Time, offsetMinutes *int, channel string) error {
+	reminder := entities.NewReminder(todoId, remindAt, offsetMinutes, channel)
+	if err := reminder.Validate(); err != nil {
+		return err
+	}
+	if reminder.Channel == entities.ReminderChannelWebhook && rs.cfg.WebhookURL == "" {
+		return entities.ErrReminderChannelUnavailable
+	}
+
+	return rs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if _, err := getTodoOnBoard(ctx, repos.Todos, boardId, todoId); err != nil {
+			return err
+		}
+		if err := checkBoardWritable(ctx, repos, boardId); err != nil {
+			return err
+		}
+
+		if err := repos.Reminders.Create(ctx, reminder); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityReminder, reminder.Id, entities.AuditActionCreate, nil, reminder)
+	})
+}
+
+func (rs *ReminderService) Delete(ctx context.Context, boardId, todoId, id int) error {
+	return rs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if _, err := getTodoOnBoard(ctx, repos.Todos, boardId, todoId); err != nil {
+			return err
+		}
+
+		reminder, err := repos.Reminders.GetById(ctx, id)
+		if err != nil {
+			return err
+		}
+		if reminder.TodoId != todoId {
+			return sql.ErrNoRows
+		}
+
+		if err := checkBoardWritable(ctx, repos, boardId); err != nil {
+			return err
+		}
+
+		if err := repos.Reminders.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityReminder, id, entities.AuditActionDelete, reminder, nil)
+	})
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type ReminderScheduler struct {
+	repo      interfaces.ReminderRepository
+	notifiers map[string]interfaces.Notifier
+	cfg       config.Reminder
+	now       func() time.Time
+}
+
+func NewReminderScheduler(repo interfaces.ReminderRepository, notifiers map[string]interfaces.Notifier, cfg config.Reminder) *ReminderScheduler {
+	return &ReminderScheduler{
+		repo:      repo,
+		notifiers: notifiers,
+		cfg:       cfg,
+		now:       time.Now,
+	}
+}
+
+// Run dispatches due reminders every poll interval until ctx is canceled. A dispatch in
+// flight is not canceled with ctx, so that sent reminders still get marked as sent.
+func (rs *ReminderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(rs.cfg.PollInterval)
+	defer ticker.Stop()
+
+	dispatchCtx := context.WithoutCancel(ctx)
+	for {
+		if err := rs.DispatchDue(dispatchCtx); err != nil {
+			log.Printf("failed to dispatch reminders: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue claims one batch of due reminders and hands each to its channel.
+// A reminder whose notification fails is released so that the next poll retries it.
+func (rs *ReminderScheduler) DispatchDue(ctx context.Context) error {
+	now := rs.now().UTC()
+
+	reminders, err := rs.repo.ClaimDue(ctx, now, now.Add(-rs.cfg.ClaimTimeout), rs.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, reminder := range reminders {
+		notifier, ok := rs.notifiers[reminder.Channel]
+		if !ok {
+			log.Printf("no notifier for channel %q, dropping reminder %d", reminder.Channel, reminder.Id)
+		} else if err := notifier.Notify(ctx, reminder); err != nil {
+			log.Printf("failed to send reminder %d: %v", reminder.Id, err)
+			if err := rs.repo.Release(ctx, reminder.Id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := rs.repo.MarkSent(ctx, reminder.Id, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDispatchDueReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockReminderRepository(ctrl)
+	mockLog := mock_repository.NewMockNotifier(ctrl)
+	mockWebhook := mock_repository.NewMockNotifier(ctrl)
+
+	now := time.Date(2025, 6, 20, 9, 0, 0, 0, time.UTC)
+	cfg := config.Reminder{BatchSize: 10, ClaimTimeout: 5 * time.Minute}
+	scheduler := NewReminderScheduler(mockRepository, map[string]interfaces.Notifier{
+		entities.ReminderChannelLog:     mockLog,
+		entities.ReminderChannelWebhook: mockWebhook,
+	}, cfg)
+	scheduler.now = func() time.Time { return now }
+
+	logReminder := &entities.DueReminder{Reminder: entities.Reminder{Id: 1, Channel: entities.ReminderChannelLog}}
+	webhookReminder := &entities.DueReminder{Reminder: entities.Reminder{Id: 2, Channel: entities.ReminderChannelWebhook}}
+	unknownReminder := &entities.DueReminder{Reminder: entities.Reminder{Id: 3, Channel: "pigeon"}}
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to mark delivered reminders as sent",
+			mockSetup: func() {
+				mockRepository.EXPECT().ClaimDue(gomock.Any(), now, now.Add(-5*time.Minute), 10).
+					Return([]*entities.DueReminder{logReminder, unknownReminder}, nil)
+				mockLog.EXPECT().Notify(gomock.Any(), logReminder).Return(nil)
+				mockRepository.EXPECT().MarkSent(gomock.Any(), 1, now).Return(nil)
+				mockRepository.EXPECT().MarkSent(gomock.Any(), 3, now).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success to release reminders whose notification failed",
+			mockSetup: func() {
+				mockRepository.EXPECT().ClaimDue(gomock.Any(), now, now.Add(-5*time.Minute), 10).
+					Return([]*entities.DueReminder{webhookReminder, logReminder}, nil)
+				mockWebhook.EXPECT().Notify(gomock.Any(), webhookReminder).Return(errors.New("unreachable"))
+				mockRepository.EXPECT().Release(gomock.Any(), 2).Return(nil)
+				mockLog.EXPECT().Notify(gomock.Any(), logReminder).Return(nil)
+				mockRepository.EXPECT().MarkSent(gomock.Any(), 1, now).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to dispatch - Due to claim error",
+			mockSetup: func() {
+				mockRepository.EXPECT().ClaimDue(gomock.Any(), now, now.Add(-5*time.Minute), 10).
+					Return(nil, errors.New("deadlock"))
+			},
+			expectedError: errors.New("deadlock"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := scheduler.DispatchDue(context.Background())
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestReminderSchedulerStopsOnCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockReminderRepository(ctrl)
+	mockRepository.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	scheduler := NewReminderScheduler(mockRepository, nil, config.Reminder{PollInterval: time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		scheduler.Run(ctx)
+		close(done)
+	}()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop after cancel")
+	}
+}
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAllReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockReminderRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	service := NewReminderService(mockRepository, mockTodoRepository, &fakeUnitOfWork{}, config.Reminder{})
+
+	testCases := []struct {
+		name          string
+		todoId        int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:   "Success to get reminders",
+			todoId: 1,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1}, nil)
+				mockRepository.EXPECT().GetAllByTodoId(gomock.Any(), 1).Return([]*entities.Reminder{{Id: 1, TodoId: 1}}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Failed to get reminders - Due to the todo on another board",
+			todoId: 2,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 2).Return(&entities.Todo{Id: 2, BoardId: 2}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			_, err := service.GetAll(context.Background(), 1, tc.todoId)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestCreateReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockReminderRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	mockBoardRepository := mock_repository.NewMockBoardRepository(ctrl)
+	uow := newAuditedUnitOfWork(ctrl, &interfaces.Repositories{
+		Reminders: mockRepository,
+		Todos:     mockTodoRepository,
+		Boards:    mockBoardRepository,
+	})
+	service := NewReminderService(mockRepository, mockTodoRepository, uow, config.Reminder{WebhookURL: "https://hooks.example.com"})
+	todo := &entities.Todo{Id: 1, BoardId: 1}
+
+	remindAt := time.Date(2025, 6, 20, 9, 0, 0, 0, time.UTC)
+	offset := 30
+
+	testCases := []struct {
+		name          string
+		service       *ReminderService
+		todoId        int
+		remindAt      *time.Time
+		offsetMinutes *int
+		channel       string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:     "Success to create reminder with default channel",
+			todoId:   1,
+			remindAt: &remindAt,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1}, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.Reminder{
+					TodoId:   1,
+					RemindAt: &remindAt,
+					Channel:  entities.ReminderChannelLog,
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Success to create reminder with offset",
+			todoId:        1,
+			offsetMinutes: &offset,
+			channel:       entities.ReminderChannelWebhook,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1}, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.Reminder{
+					TodoId:        1,
+					OffsetMinutes: &offset,
+					Channel:       entities.ReminderChannelWebhook,
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to create reminder - Due to both time and offset",
+			todoId:        1,
+			remindAt:      &remindAt,
+			offsetMinutes: &offset,
+			mockSetup:     func() {},
+			expectedError: entities.ErrInvalidReminderTime,
+		},
+		{
+			name:          "Failed to create reminder - Due to unknown channel",
+			todoId:        1,
+			remindAt:      &remindAt,
+			channel:       "pigeon",
+			mockSetup:     func() {},
+			expectedError: entities.ErrInvalidReminderChannel,
+		},
+		{
+			name:          "Failed to create reminder - Due to webhook url not configured",
+			service:       NewReminderService(mockRepository, mockTodoRepository, uow, config.Reminder{}),
+			todoId:        1,
+			remindAt:      &remindAt,
+			channel:       entities.ReminderChannelWebhook,
+			mockSetup:     func() {},
+			expectedError: entities.ErrReminderChannelUnavailable,
+		},
+		{
+			name:     "Failed to create reminder - Due to not found todo",
+			todoId:   999,
+			remindAt: &remindAt,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 999).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:     "Failed to create reminder - Due to the todo on another board",
+			todoId:   2,
+			remindAt: &remindAt,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 2).Return(&entities.Todo{Id: 2, BoardId: 2}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:     "Failed to create reminder - Due to the archived board",
+			todoId:   1,
+			remindAt: &remindAt,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1, RoomArchived: true}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			s := service
+			if tc.service != nil {
+				s = tc.service
+			}
+			err := s.Create(context.Background(), 1, tc.todoId, tc.remindAt, tc.offsetMinutes, tc.channel)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestDeleteReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockReminderRepository(ctrl)
+	mockTodoRepository := mock_repository.NewMockTodoRepository(ctrl)
+	mockBoardRepository := mock_repository.NewMockBoardRepository(ctrl)
+	service := NewReminderService(mockRepository, mockTodoRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{
+		Reminders: mockRepository,
+		Todos:     mockTodoRepository,
+		Boards:    mockBoardRepository,
+	}), config.Reminder{})
+	todo := &entities.Todo{Id: 1, BoardId: 1}
+
+	testCases := []struct {
+		name          string
+		id            int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to delete reminder",
+			id:   1,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Reminder{Id: 1, TodoId: 1}, nil)
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1}, nil)
+				mockRepository.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to delete reminder - Due to not found",
+			id:   999,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 999).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name: "Failed to delete reminder - Due to the reminder on another todo",
+			id:   2,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(&entities.Reminder{Id: 2, TodoId: 2}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name: "Failed to delete reminder - Due to the archived board",
+			id:   1,
+			mockSetup: func() {
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 1).Return(todo, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Reminder{Id: 1, TodoId: 1}, nil)
+				mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Board{Id: 1, RoomArchived: true}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Delete(context.Background(), 1, 1, tc.id)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
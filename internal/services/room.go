@@ -1,51 +1,135 @@
 package services
 
 import (
+	"context"
+	"time"
+
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
 	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
 )
 
 type RoomService struct {
 	repo interfaces.RoomRepository
+	uow  interfaces.UnitOfWork
+	now  func() time.Time
 }
 
-func NewRoomService(repo interfaces.RoomRepository) *RoomService {
+func NewRoomService(repo interfaces.RoomRepository, uow interfaces.UnitOfWork) *RoomService {
 	return &RoomService{
 		repo: repo,
+		uow:  uow,
+		now:  time.Now,
 	}
 }
 
-func (rs *RoomService) GetAll() ([]*entities.Room, error) {
-	return rs.repo.GetAll()
+func (rs *RoomService) GetAll(ctx context.Context, includeArchived bool) ([]*entities.Room, error) {
+	return rs.repo.GetAll(ctx, includeArchived)
+}
+
+func (rs *RoomService) GetById(ctx context.Context, id int) (*entities.Room, error) {
+	return rs.repo.GetById(ctx, id)
 }
 
-func (rs *RoomService) Create(name string) error {
+func (rs *RoomService) Create(ctx context.Context, name string) (*entities.Room, error) {
 	room := entities.NewRoom(name)
 	if err := room.Validate(); err != nil {
-		return err
+		return nil, err
+	}
+
+	err := rs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Rooms.Create(ctx, room); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityRoom, room.Id, entities.AuditActionCreate, nil, room)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return rs.repo.Create(room)
+	return room, nil
+}
+
+func (rs *RoomService) Update(ctx context.Context, id int, name string) error {
+	return rs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		return rs.UpdateIn(ctx, repos, id, name)
+	})
 }
 
-func (rs *RoomService) Update(id int, name string) error {
-	room, err := rs.repo.GetById(id)
+func (rs *RoomService) UpdateIn(ctx context.Context, repos *interfaces.Repositories, id int, name string) error {
+	room, err := repos.Rooms.GetById(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	if err := room.Writable(); err != nil {
+		return err
+	}
+
+	before := *room
 	room.UpdateAttributes(name)
 	if err := room.Validate(); err != nil {
 		return err
 	}
 
-	return rs.repo.Update(room)
+	if err := repos.Rooms.Update(ctx, room); err != nil {
+		return err
+	}
+
+	return audit(ctx, repos.Audits, entities.AuditEntityRoom, room.Id, entities.AuditActionUpdate, &before, room)
 }
 
-func (rs *RoomService) Delete(id int) error {
-	if _, err := rs.repo.GetById(id); err != nil {
+// Archive makes the room and everything in it read-only. Archiving twice keeps the first time.
+func (rs *RoomService) Archive(ctx context.Context, id int) error {
+	room, err := rs.repo.GetById(ctx, id)
+	if err != nil {
 		return err
 	}
+	if room.ArchivedAt != nil {
+		return nil
+	}
+
+	now := rs.now().UTC()
+	return rs.setArchivedAt(ctx, room, &now)
+}
+
+func (rs *RoomService) Unarchive(ctx context.Context, id int) error {
+	room, err := rs.repo.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return rs.setArchivedAt(ctx, room, nil)
+}
+
+func (rs *RoomService) Delete(ctx context.Context, id int) error {
+	return rs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		return rs.DeleteIn(ctx, repos, id)
+	})
+}
+
+func (rs *RoomService) DeleteIn(ctx context.Context, repos *interfaces.Repositories, id int) error {
+	room, err := repos.Rooms.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := repos.Rooms.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	return audit(ctx, repos.Audits, entities.AuditEntityRoom, id, entities.AuditActionDelete, room, nil)
+}
+
+func (rs *RoomService) setArchivedAt(ctx context.Context, room *entities.Room, archivedAt *time.Time) error {
+	before := *room
+	room.ArchivedAt = archivedAt
+
+	return rs.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Rooms.UpdateArchivedAt(ctx, room.Id, archivedAt); err != nil {
+			return err
+		}
 
-	return rs.repo.Delete(id)
+		return audit(ctx, repos.Audits, entities.AuditEntityRoom, room.Id, entities.AuditActionUpdate, &before, room)
+	})
 }
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type RoomMemberService struct {
+	repo     interfaces.RoomMemberRepository
+	roomRepo interfaces.RoomRepository
+	uow      interfaces.UnitOfWork
+}
+
+func NewRoomMemberService(repo interfaces.RoomMemberRepository, roomRepo interfaces.RoomRepository, uow interfaces.UnitOfWork) *RoomMemberService {
+	return &RoomMemberService{
+		repo:     repo,
+		roomRepo: roomRepo,
+		uow:      uow,
+	}
+}
+
+func (ms *RoomMemberService) GetAll(ctx context.Context, roomId int) ([]*entities.RoomMember, error) {
+	if _, err := ms.roomRepo.GetById(ctx, roomId); err != nil {
+		return nil, err
+	}
+
+	return ms.repo.GetAllByRoomId(ctx, roomId)
+}
+
+// Join records the member joining in the room's activity the first time only.
+func (ms *RoomMemberService) Join(ctx context.Context, roomId int) (*entities.RoomMember, error) {
+	member, err := entities.NewRoomMember(roomId, ActorFrom(ctx).Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		room, err := repos.Rooms.GetById(ctx, roomId)
+		if err != nil {
+			return err
+		}
+		if err := room.Writable(); err != nil {
+			return err
+		}
+
+		joined, err := repos.Members.Create(ctx, member)
+		if err != nil || !joined {
+			return err
+		}
+		if err := audit(ctx, repos.Audits, entities.AuditEntityRoomMember, member.Id, entities.AuditActionCreate, nil, member); err != nil {
+			return err
+		}
+
+		return repos.Activities.Create(ctx, entities.NewMemberActivity(member))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestJoinRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockRoomMemberRepository(ctrl)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	mockActivityRepository := mock_repository.NewMockActivityRepository(ctrl)
+	service := NewRoomMemberService(mockRepository, mockRoomRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{
+		Members:    mockRepository,
+		Rooms:      mockRoomRepository,
+		Activities: mockActivityRepository,
+	}))
+	archivedAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		actor         string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:  "Success to join room and record it",
+			actor: "alice",
+			mockSetup: func() {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.RoomMember{RoomId: 1, Actor: "alice"}).Return(true, nil)
+				mockActivityRepository.EXPECT().Create(gomock.Any(), &entities.Activity{RoomId: 1, Kind: entities.ActivityMemberJoined, Actor: "alice"}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "Success to join room again without recording it",
+			actor: "alice",
+			mockSetup: func() {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.RoomMember{RoomId: 1, Actor: "alice"}).Return(false, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to join room - Due to missing actor",
+			actor:         "",
+			mockSetup:     func() {},
+			expectedError: entities.ErrActorRequired,
+		},
+		{
+			name:  "Failed to join room - Due to the archived room",
+			actor: "alice",
+			mockSetup: func() {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1, ArchivedAt: &archivedAt}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+		{
+			name:  "Failed to join room - Due to the room does not exist",
+			actor: "alice",
+			mockSetup: func() {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			ctx := WithActor(context.Background(), entities.Actor{Name: tc.actor})
+			_, err := service.Join(ctx, 1)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
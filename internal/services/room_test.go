@@ -1,12 +1,14 @@
 package services
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"strings"
 	"testing"
 
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
 	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -17,21 +19,26 @@ func TestCreateRoom(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepository := mock_repository.NewMockRoomRepository(ctrl)
-	service := NewRoomService(mockRepository)
+	service := NewRoomService(mockRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Rooms: mockRepository}))
 
 	testCases := []struct {
 		name          string
 		roomName      string
 		mockSetup     func(room *entities.Room)
+		expectedId    int
 		expectedError error
 	}{
 		{
 			name:     "Success to create room",
 			roomName: "test room",
 			mockSetup: func(room *entities.Room) {
-				mockRepository.EXPECT().Create(room).
-					Return(nil)
+				mockRepository.EXPECT().Create(gomock.Any(), room).
+					DoAndReturn(func(ctx context.Context, room *entities.Room) error {
+						room.Id = 1
+						return nil
+					})
 			},
+			expectedId:    1,
 			expectedError: nil,
 		},
 		{
@@ -55,9 +62,12 @@ func TestCreateRoom(t *testing.T) {
 			}
 			tc.mockSetup(room)
 
-			err := service.Create(tc.roomName)
+			created, err := service.Create(context.Background(), tc.roomName)
 
 			assert.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedId, created.Id)
+			}
 		})
 	}
 }
@@ -67,7 +77,7 @@ func TestUpdateRoom(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepository := mock_repository.NewMockRoomRepository(ctrl)
-	service := NewRoomService(mockRepository)
+	service := NewRoomService(mockRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Rooms: mockRepository}))
 
 	testCases := []struct {
 		name          string
@@ -81,9 +91,9 @@ func TestUpdateRoom(t *testing.T) {
 			id:       1,
 			roomName: "test room",
 			mockSetup: func(room *entities.Room) {
-				mockRepository.EXPECT().GetById(room.Id).
+				mockRepository.EXPECT().GetById(gomock.Any(), room.Id).
 					Return(&entities.Room{Id: room.Id}, nil)
-				mockRepository.EXPECT().Update(room).
+				mockRepository.EXPECT().Update(gomock.Any(), room).
 					Return(nil)
 			},
 			expectedError: nil,
@@ -93,17 +103,27 @@ func TestUpdateRoom(t *testing.T) {
 			id:       999,
 			roomName: "test room",
 			mockSetup: func(room *entities.Room) {
-				mockRepository.EXPECT().GetById(room.Id).
+				mockRepository.EXPECT().GetById(gomock.Any(), room.Id).
 					Return(nil, sql.ErrNoRows)
 			},
 			expectedError: sql.ErrNoRows,
 		},
+		{
+			name:     "Failed to update room - Due to the room is archived",
+			id:       1,
+			roomName: "test room",
+			mockSetup: func(room *entities.Room) {
+				mockRepository.EXPECT().GetById(gomock.Any(), room.Id).
+					Return(&entities.Room{Id: room.Id, ArchivedAt: &testNow}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
 		{
 			name:     "Failed to update room - Due to number of characters in the name is more than 50",
 			id:       1,
 			roomName: strings.Repeat("a", 51),
 			mockSetup: func(room *entities.Room) {
-				mockRepository.EXPECT().GetById(room.Id).
+				mockRepository.EXPECT().GetById(gomock.Any(), room.Id).
 					Return(&entities.Room{Id: room.Id}, nil)
 			},
 			expectedError: errors.New("Invalid name"),
@@ -113,7 +133,7 @@ func TestUpdateRoom(t *testing.T) {
 			id:       1,
 			roomName: "",
 			mockSetup: func(room *entities.Room) {
-				mockRepository.EXPECT().GetById(room.Id).
+				mockRepository.EXPECT().GetById(gomock.Any(), room.Id).
 					Return(&entities.Room{Id: room.Id}, nil)
 			},
 			expectedError: errors.New("Invalid name"),
@@ -128,7 +148,7 @@ func TestUpdateRoom(t *testing.T) {
 			}
 			tc.mockSetup(updatedRoom)
 
-			err := service.Update(tc.id, tc.roomName)
+			err := service.Update(context.Background(), tc.id, tc.roomName)
 
 			assert.Equal(t, tc.expectedError, err)
 		})
@@ -140,7 +160,7 @@ func TestDeleteRoom(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepository := mock_repository.NewMockRoomRepository(ctrl)
-	service := NewRoomService(mockRepository)
+	service := NewRoomService(mockRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Rooms: mockRepository}))
 
 	testCases := []struct {
 		name          string
@@ -152,9 +172,9 @@ func TestDeleteRoom(t *testing.T) {
 			name: "Success to delete room",
 			id:   1,
 			mockSetup: func() {
-				mockRepository.EXPECT().GetById(1).
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).
 					Return(&entities.Room{}, nil)
-				mockRepository.EXPECT().Delete(1).
+				mockRepository.EXPECT().Delete(gomock.Any(), 1).
 					Return(nil)
 			},
 			expectedError: nil,
@@ -163,7 +183,53 @@ func TestDeleteRoom(t *testing.T) {
 			name: "Failed to delete room - Due to the room not found",
 			id:   999,
 			mockSetup: func() {
-				mockRepository.EXPECT().GetById(999).
+				mockRepository.EXPECT().GetById(gomock.Any(), 999).
+					Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Delete(context.Background(), tc.id)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestUnarchiveRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewRoomService(mockRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Rooms: mockRepository}))
+
+	testCases := []struct {
+		name          string
+		id            int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to unarchive room",
+			id:   1,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Room{Id: 1, ArchivedAt: &testNow}, nil)
+				mockRepository.EXPECT().UpdateArchivedAt(gomock.Any(), 1, nil).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to unarchive room - Due to the room not found",
+			id:   999,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 999).
 					Return(nil, sql.ErrNoRows)
 			},
 			expectedError: sql.ErrNoRows,
@@ -174,7 +240,7 @@ func TestDeleteRoom(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.mockSetup()
 
-			err := service.Delete(tc.id)
+			err := service.Unarchive(context.Background(), tc.id)
 
 			assert.Equal(t, tc.expectedError, err)
 		})
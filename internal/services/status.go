@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type StatusService struct {
+	repo     interfaces.StatusRepository
+	roomRepo interfaces.RoomRepository
+	uow      interfaces.UnitOfWork
+}
+
+func NewStatusService(repo interfaces.StatusRepository, roomRepo interfaces.RoomRepository, uow interfaces.UnitOfWork) *StatusService {
+	return &StatusService{
+		repo:     repo,
+		roomRepo: roomRepo,
+		uow:      uow,
+	}
+}
+
+func (ss *StatusService) GetAll(ctx context.Context, roomId int) ([]*entities.Status, error) {
+	return ss.repo.GetAllByRoomId(ctx, roomId)
+}
+
+func (ss *StatusService) Create(ctx context.Context, roomId int, name, category string) error {
+	status := entities.NewStatus(roomId, name, category)
+	if err := status.Validate(); err != nil {
+		return err
+	}
+
+	if err := ss.checkWritable(ctx, ss.roomRepo, roomId); err != nil {
+		return err
+	}
+
+	return ss.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Statuses.Create(ctx, status); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityStatus, status.Id, entities.AuditActionCreate, nil, status)
+	})
+}
+
+// Update refuses to recategorize a status that todos are in, since their completion state would silently change.
+func (ss *StatusService) Update(ctx context.Context, id int, name, category string) error {
+	return ss.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		status, err := repos.Statuses.GetByIdForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := ss.checkWritable(ctx, repos.Rooms, status.RoomId); err != nil {
+			return err
+		}
+
+		if status.Category != category {
+			if err := ss.checkRemovable(ctx, repos.Statuses, status); err != nil {
+				return err
+			}
+		}
+
+		before := *status
+		status.UpdateAttributes(name, category)
+		if err := status.Validate(); err != nil {
+			return err
+		}
+
+		if err := repos.Statuses.Update(ctx, status); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityStatus, status.Id, entities.AuditActionUpdate, &before, status)
+	})
+}
+
+func (ss *StatusService) Delete(ctx context.Context, id int) error {
+	return ss.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		status, err := repos.Statuses.GetByIdForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := ss.checkWritable(ctx, repos.Rooms, status.RoomId); err != nil {
+			return err
+		}
+
+		if err := ss.checkRemovable(ctx, repos.Statuses, status); err != nil {
+			return err
+		}
+
+		if err := repos.Statuses.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityStatus, id, entities.AuditActionDelete, status, nil)
+	})
+}
+
+// SetTransitions restricts the statuses todos may move to from the status to those of toIds,
+// which must be other statuses of its room. No ids lifts the restriction.
+func (ss *StatusService) SetTransitions(ctx context.Context, id int, toIds []int) error {
+	status, err := ss.repo.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := ss.checkWritable(ctx, ss.roomRepo, status.RoomId); err != nil {
+		return err
+	}
+
+	statuses, err := ss.repo.GetAllByRoomId(ctx, status.RoomId)
+	if err != nil {
+		return err
+	}
+	inRoom := make(map[int]bool, len(statuses))
+	for _, s := range statuses {
+		inRoom[s.Id] = true
+	}
+
+	transitions := make([]int, 0, len(toIds))
+	for _, toId := range toIds {
+		if toId == id || !inRoom[toId] {
+			return entities.ErrInvalidStatus
+		}
+		if !slices.Contains(transitions, toId) {
+			transitions = append(transitions, toId)
+		}
+	}
+	slices.Sort(transitions)
+
+	before := *status
+	if len(transitions) > 0 {
+		status.Transitions = transitions
+	} else {
+		status.Transitions = nil
+	}
+
+	return ss.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Statuses.SetTransitions(ctx, id, status.Transitions); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityStatus, id, entities.AuditActionUpdate, &before, status)
+	})
+}
+
+// checkRemovable reports whether status can leave its category: no todo may be in it,
+// and the room must keep a todo and a done status for todos created without an explicit one.
+// It runs in the transaction that holds the lock on status.
+func (ss *StatusService) checkRemovable(ctx context.Context, repo interfaces.StatusRepository, status *entities.Status) error {
+	count, err := repo.CountTodos(ctx, status.Id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return entities.ErrStatusInUse
+	}
+
+	if status.Category == entities.StatusCategoryDoing {
+		return nil
+	}
+
+	if err := repo.LockWorkflow(ctx, status.RoomId); err != nil {
+		return err
+	}
+	statuses, err := repo.GetAllByRoomId(ctx, status.RoomId)
+	if err != nil {
+		return err
+	}
+	for _, s := range statuses {
+		if s.Id != status.Id && s.Category == status.Category {
+			return nil
+		}
+	}
+
+	return entities.ErrStatusRequired
+}
+
+// checkWritable rejects changes to the workflow of an archived room.
+func (ss *StatusService) checkWritable(ctx context.Context, repo interfaces.RoomRepository, roomId int) error {
+	room, err := repo.GetById(ctx, roomId)
+	if err != nil {
+		return err
+	}
+
+	return room.Writable()
+}
@@ -0,0 +1,334 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockStatusRepository(ctrl)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewStatusService(mockRepository, mockRoomRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Statuses: mockRepository}))
+
+	testCases := []struct {
+		name          string
+		statusName    string
+		category      string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:       "Success to create status",
+			statusName: "In Review",
+			category:   entities.StatusCategoryDoing,
+			mockSetup: func() {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.Status{
+					RoomId:   1,
+					Name:     "In Review",
+					Category: entities.StatusCategoryDoing,
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:       "Failed to create status - Due to the room is archived",
+			statusName: "In Review",
+			category:   entities.StatusCategoryDoing,
+			mockSetup: func() {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1, ArchivedAt: &testNow}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+		{
+			name:          "Failed to create status - Due to unknown category",
+			statusName:    "Blocked",
+			category:      "blocked",
+			mockSetup:     func() {},
+			expectedError: errors.New("Invalid category"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Create(context.Background(), 1, tc.statusName, tc.category)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestUpdateStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockStatusRepository(ctrl)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewStatusService(mockRepository, mockRoomRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Statuses: mockRepository, Rooms: mockRoomRepository}))
+
+	testCases := []struct {
+		name          string
+		statusName    string
+		category      string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:       "Success to rename status in use",
+			statusName: "Doing",
+			category:   entities.StatusCategoryDoing,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 2).
+					Return(&entities.Status{Id: 2, RoomId: 1, Name: "In Progress", Category: entities.StatusCategoryDoing}, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Status{Id: 2, RoomId: 1, Name: "Doing", Category: entities.StatusCategoryDoing}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:       "Success to recategorize unused status",
+			statusName: "In Progress",
+			category:   entities.StatusCategoryDone,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 2).
+					Return(&entities.Status{Id: 2, RoomId: 1, Name: "In Progress", Category: entities.StatusCategoryDoing}, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().CountTodos(gomock.Any(), 2).Return(0, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Status{Id: 2, RoomId: 1, Name: "In Progress", Category: entities.StatusCategoryDone}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:       "Failed to update status - Due to recategorizing status in use",
+			statusName: "In Progress",
+			category:   entities.StatusCategoryDone,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 2).
+					Return(&entities.Status{Id: 2, RoomId: 1, Name: "In Progress", Category: entities.StatusCategoryDoing}, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().CountTodos(gomock.Any(), 2).Return(3, nil)
+			},
+			expectedError: entities.ErrStatusInUse,
+		},
+		{
+			name:       "Failed to update status - Due to the room is archived",
+			statusName: "Doing",
+			category:   entities.StatusCategoryDoing,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 2).
+					Return(&entities.Status{Id: 2, RoomId: 1, Name: "In Progress", Category: entities.StatusCategoryDoing}, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1, ArchivedAt: &testNow}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+		{
+			name:       "Failed to update status - Due to not found",
+			statusName: "In Progress",
+			category:   entities.StatusCategoryDoing,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 2).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Update(context.Background(), 2, tc.statusName, tc.category)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestDeleteStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockStatusRepository(ctrl)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewStatusService(mockRepository, mockRoomRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Statuses: mockRepository, Rooms: mockRoomRepository}))
+
+	testCases := []struct {
+		name          string
+		id            int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to delete unused doing status",
+			id:   2,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 2).Return(roomStatuses[1], nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().CountTodos(gomock.Any(), 2).Return(0, nil)
+				mockRepository.EXPECT().Delete(gomock.Any(), 2).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success to delete done status while another remains",
+			id:   4,
+			mockSetup: func() {
+				archived := &entities.Status{Id: 4, RoomId: 1, Name: "Archived", Category: entities.StatusCategoryDone}
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 4).Return(archived, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().CountTodos(gomock.Any(), 4).Return(0, nil)
+				mockRepository.EXPECT().LockWorkflow(gomock.Any(), 1).Return(nil)
+				mockRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1).Return(append(roomStatuses, archived), nil)
+				mockRepository.EXPECT().Delete(gomock.Any(), 4).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to delete status - Due to todos in it",
+			id:   2,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 2).Return(roomStatuses[1], nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().CountTodos(gomock.Any(), 2).Return(1, nil)
+			},
+			expectedError: entities.ErrStatusInUse,
+		},
+		{
+			name: "Failed to delete status - Due to the last done status",
+			id:   3,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 3).Return(roomStatuses[2], nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().CountTodos(gomock.Any(), 3).Return(0, nil)
+				mockRepository.EXPECT().LockWorkflow(gomock.Any(), 1).Return(nil)
+				mockRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1).Return(roomStatuses, nil)
+			},
+			expectedError: entities.ErrStatusRequired,
+		},
+		{
+			name: "Failed to delete status - Due to not found",
+			id:   999,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 999).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Delete(context.Background(), tc.id)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestSetTransitionsStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockStatusRepository(ctrl)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	service := NewStatusService(mockRepository, mockRoomRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Statuses: mockRepository}))
+
+	testCases := []struct {
+		name          string
+		id            int
+		toIds         []int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:  "Success to set transitions",
+			id:    1,
+			toIds: []int{3, 2, 3},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Status{Id: 1, RoomId: 1, Category: entities.StatusCategoryTodo}, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1).Return(roomStatuses, nil)
+				mockRepository.EXPECT().SetTransitions(gomock.Any(), 1, []int{2, 3}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "Success to lift transitions",
+			id:    1,
+			toIds: []int{},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Status{Id: 1, RoomId: 1, Transitions: []int{2}}, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1).Return(roomStatuses, nil)
+				mockRepository.EXPECT().SetTransitions(gomock.Any(), 1, nil).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "Failed to set transitions - Due to status of another room",
+			id:    1,
+			toIds: []int{2, 9},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Status{Id: 1, RoomId: 1}, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1).Return(roomStatuses, nil)
+			},
+			expectedError: entities.ErrInvalidStatus,
+		},
+		{
+			name:  "Failed to set transitions - Due to transition to itself",
+			id:    1,
+			toIds: []int{1},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Status{Id: 1, RoomId: 1}, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1).Return(roomStatuses, nil)
+			},
+			expectedError: entities.ErrInvalidStatus,
+		},
+		{
+			name:  "Failed to set transitions - Due to archived room",
+			id:    1,
+			toIds: []int{2},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Status{Id: 1, RoomId: 1}, nil)
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1, ArchivedAt: &testNow}, nil)
+			},
+			expectedError: entities.ErrArchived,
+		},
+		{
+			name:  "Failed to set transitions - Due to not found",
+			id:    999,
+			toIds: []int{2},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 999).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.SetTransitions(context.Background(), tc.id, tc.toIds)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+// SyncService lets offline clients catch up with the changes to rooms, boards and todos and
+// upload the changes they made meanwhile. Writes go through the services of each entity, so
+// they are checked, audited and published like those made through the other endpoints.
+type SyncService struct {
+	repo   interfaces.SyncRepository
+	rooms  interfaces.RoomServicer
+	boards interfaces.BoardServicer
+	todos  interfaces.TodoServicer
+	uow    interfaces.UnitOfWork
+	cfg    config.Sync
+}
+
+func NewSyncService(repo interfaces.SyncRepository, rooms interfaces.RoomServicer, boards interfaces.BoardServicer, todos interfaces.TodoServicer, uow interfaces.UnitOfWork, cfg config.Sync) *SyncService {
+	return &SyncService{
+		repo:   repo,
+		rooms:  rooms,
+		boards: boards,
+		todos:  todos,
+		uow:    uow,
+		cfg:    cfg,
+	}
+}
+
+// Pull returns the rooms, boards and todos written after token in their current state, all
+// of them when token is empty, a page at a time. Rooms have no members, so clients see every
+// room.
+//
+// Deleting or restoring a room or board writes its contents too, so they come with it.
+func (ss *SyncService) Pull(ctx context.Context, token string) (*entities.SyncPage, error) {
+	var since int64
+	if token != "" {
+		var err error
+		if since, err = entities.ParseSyncToken(token); err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := ss.repo.GetChanges(ctx, since, ss.cfg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &entities.SyncPage{
+		Changes: changes,
+		Token:   entities.FormatSyncToken(since),
+		HasMore: len(changes) >= ss.cfg.PageSize,
+	}
+	if len(changes) > 0 {
+		page.Token = entities.FormatSyncToken(changes[len(changes)-1].Version)
+	}
+
+	return page, nil
+}
+
+// Push applies the mutations one by one in order, so that one failing leaves the others
+// applied. A creation that refers to an entity created in the same push has to wait for the
+// next push, once the client knows the id.
+func (ss *SyncService) Push(ctx context.Context, mutations []*entities.SyncMutation) ([]*entities.SyncResult, error) {
+	if len(mutations) > ss.cfg.MaxMutations {
+		return nil, entities.ErrBatchTooLarge
+	}
+
+	results := make([]*entities.SyncResult, 0, len(mutations))
+	for _, mutation := range mutations {
+		results = append(results, ss.apply(ctx, mutation))
+	}
+
+	return results, nil
+}
+
+// apply answers from the current entity when the mutation already conflicts with it, and
+// otherwise claims the base version in the transaction of the write, so that a change made
+// in between by someone else makes it a conflict rather than being overwritten.
+func (ss *SyncService) apply(ctx context.Context, m *entities.SyncMutation) *entities.SyncResult {
+	if err := m.Validate(); err != nil {
+		return &entities.SyncResult{Err: err}
+	}
+
+	id := m.Id
+	if m.Op == entities.SyncOpCreate {
+		var err error
+		if id, err = ss.create(ctx, m); err != nil {
+			return &entities.SyncResult{Err: err}
+		}
+	} else {
+		current, err := ss.current(ctx, m.EntityType, m.Id)
+		if err != nil {
+			return &entities.SyncResult{Err: err}
+		}
+
+		switch {
+		case current.Entity == nil && m.Op == entities.SyncOpDelete:
+			return &entities.SyncResult{Change: current}
+		case current.Entity == nil, current.Version != m.BaseVersion:
+			return &entities.SyncResult{Err: entities.ErrSyncConflict, Change: current}
+		}
+
+		err = ss.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+			if err := repos.Sync.ClaimVersion(ctx, m.EntityType, m.Id, m.BaseVersion); err != nil {
+				return err
+			}
+
+			return ss.write(ctx, repos, m, current.Entity)
+		})
+		if errors.Is(err, entities.ErrSyncConflict) {
+			if current, err = ss.current(ctx, m.EntityType, m.Id); err != nil {
+				return &entities.SyncResult{Err: err}
+			}
+			if current.Entity == nil && m.Op == entities.SyncOpDelete {
+				return &entities.SyncResult{Change: current}
+			}
+			return &entities.SyncResult{Err: entities.ErrSyncConflict, Change: current}
+		}
+		if err != nil {
+			return &entities.SyncResult{Err: err}
+		}
+	}
+
+	change, err := ss.current(ctx, m.EntityType, id)
+	if err != nil {
+		return &entities.SyncResult{Err: err}
+	}
+
+	return &entities.SyncResult{Change: change}
+}
+
+func (ss *SyncService) create(ctx context.Context, m *entities.SyncMutation) (int, error) {
+	switch m.EntityType {
+	case entities.SyncEntityRoom:
+		room, err := ss.rooms.Create(ctx, m.Name)
+		if err != nil {
+			return 0, err
+		}
+		return room.Id, nil
+	case entities.SyncEntityBoard:
+		board, err := ss.boards.Create(ctx, m.Name, m.Priority, m.RoomId)
+		if err != nil {
+			return 0, err
+		}
+		return board.Id, nil
+	default:
+		todo, err := ss.todos.Create(ctx, m.BoardId, m.Title, m.Done, m.StatusId, m.Priority, m.DueDate, nil)
+		if err != nil {
+			return 0, err
+		}
+		return todo.Id, nil
+	}
+}
+
+// write updates or deletes the entity, which is current, in the unit of work repos belong to.
+// Todos keep their recurrence, which clients do not sync.
+func (ss *SyncService) write(ctx context.Context, repos *interfaces.Repositories, m *entities.SyncMutation, current any) error {
+	if m.Op == entities.SyncOpDelete {
+		switch m.EntityType {
+		case entities.SyncEntityRoom:
+			return ss.rooms.DeleteIn(ctx, repos, m.Id)
+		case entities.SyncEntityBoard:
+			return ss.boards.DeleteIn(ctx, repos, m.Id)
+		default:
+			return ss.todos.DeleteIn(ctx, repos, m.Id)
+		}
+	}
+
+	switch m.EntityType {
+	case entities.SyncEntityRoom:
+		return ss.rooms.UpdateIn(ctx, repos, m.Id, m.Name)
+	case entities.SyncEntityBoard:
+		return ss.boards.UpdateIn(ctx, repos, m.Id, m.Name, m.Priority)
+	default:
+		recurrence := current.(*entities.Todo).Recurrence
+		return ss.todos.UpdateIn(ctx, repos, m.Id, m.Title, m.Done, m.StatusId, m.Priority, m.DueDate, recurrence)
+	}
+}
+
+// current returns the entity with its version, or a change without entity when it is deleted.
+func (ss *SyncService) current(ctx context.Context, entityType string, id int) (*entities.SyncChange, error) {
+	entity, err := ss.get(ctx, entityType, id)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &entities.SyncChange{EntityType: entityType, EntityId: id, Entity: entity}
+	if err := ss.setVersions(ctx, []*entities.SyncChange{change}); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// get returns nil for entities that are deleted or were never there.
+func (ss *SyncService) get(ctx context.Context, entityType string, id int) (any, error) {
+	var entity any
+	var err error
+	switch entityType {
+	case entities.SyncEntityRoom:
+		entity, err = ss.rooms.GetById(ctx, id)
+	case entities.SyncEntityBoard:
+		entity, err = ss.boards.GetById(ctx, id)
+	default:
+		entity, err = ss.todos.GetById(ctx, id)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (ss *SyncService) setVersions(ctx context.Context, changes []*entities.SyncChange) error {
+	ids := map[string][]int{}
+	for _, change := range changes {
+		ids[change.EntityType] = append(ids[change.EntityType], change.EntityId)
+	}
+
+	versions := map[string]map[int]int64{}
+	for entityType, entityIds := range ids {
+		var err error
+		if versions[entityType], err = ss.repo.GetVersions(ctx, entityType, entityIds); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range changes {
+		change.Version = versions[change.EntityType][change.EntityId]
+	}
+
+	return nil
+}
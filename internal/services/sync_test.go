@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type testSyncMocks struct {
+	repo   *mock_repository.MockSyncRepository
+	rooms  *mock_repository.MockRoomServicer
+	boards *mock_repository.MockBoardServicer
+	todos  *mock_repository.MockTodoServicer
+}
+
+func newTestSyncService(ctrl *gomock.Controller) (*SyncService, *testSyncMocks) {
+	mocks := &testSyncMocks{
+		repo:   mock_repository.NewMockSyncRepository(ctrl),
+		rooms:  mock_repository.NewMockRoomServicer(ctrl),
+		boards: mock_repository.NewMockBoardServicer(ctrl),
+		todos:  mock_repository.NewMockTodoServicer(ctrl),
+	}
+	uow := &fakeUnitOfWork{repos: &interfaces.Repositories{Sync: mocks.repo}}
+	service := NewSyncService(mocks.repo, mocks.rooms, mocks.boards, mocks.todos, uow, config.Sync{PageSize: 3, MaxMutations: 2})
+
+	return service, mocks
+}
+
+func TestPullSync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mocks := newTestSyncService(ctrl)
+
+	room := &entities.Room{Id: 1, Name: "room"}
+	board := &entities.Board{Id: 2, Name: "board", RoomId: 1}
+	todo := &entities.Todo{Id: 3, Title: "todo", BoardId: 2}
+
+	testCases := []struct {
+		name            string
+		token           string
+		mockSetup       func()
+		expectedChanges []*entities.SyncChange
+		expectedToken   string
+		expectedHasMore bool
+		expectedError   error
+	}{
+		{
+			name:  "Success to pull the first page without token",
+			token: "",
+			mockSetup: func() {
+				mocks.repo.EXPECT().GetChanges(gomock.Any(), int64(0), 3).Return([]*entities.SyncChange{
+					{EntityType: entities.SyncEntityRoom, EntityId: 1, Version: 10, Entity: room},
+					{EntityType: entities.SyncEntityBoard, EntityId: 2, Version: 20, Entity: board},
+				}, nil)
+			},
+			expectedChanges: []*entities.SyncChange{
+				{EntityType: entities.SyncEntityRoom, EntityId: 1, Version: 10, Entity: room},
+				{EntityType: entities.SyncEntityBoard, EntityId: 2, Version: 20, Entity: board},
+			},
+			expectedToken:   "20",
+			expectedHasMore: false,
+			expectedError:   nil,
+		},
+		{
+			name:  "Success to pull nothing without token",
+			token: "",
+			mockSetup: func() {
+				mocks.repo.EXPECT().GetChanges(gomock.Any(), int64(0), 3).Return(nil, nil)
+			},
+			expectedChanges: nil,
+			expectedToken:   "0",
+			expectedHasMore: false,
+			expectedError:   nil,
+		},
+		{
+			name:  "Success to pull changed and deleted entities",
+			token: "40",
+			mockSetup: func() {
+				mocks.repo.EXPECT().GetChanges(gomock.Any(), int64(40), 3).Return([]*entities.SyncChange{
+					{EntityType: entities.SyncEntityTodo, EntityId: 3, Version: 41, Entity: todo},
+					{EntityType: entities.SyncEntityTodo, EntityId: 4, Version: 43},
+					{EntityType: entities.SyncEntityBoard, EntityId: 2, Version: 44, Entity: board},
+				}, nil)
+			},
+			expectedChanges: []*entities.SyncChange{
+				{EntityType: entities.SyncEntityTodo, EntityId: 3, Version: 41, Entity: todo},
+				{EntityType: entities.SyncEntityTodo, EntityId: 4, Version: 43, Entity: nil},
+				{EntityType: entities.SyncEntityBoard, EntityId: 2, Version: 44, Entity: board},
+			},
+			expectedToken:   "44",
+			expectedHasMore: true,
+			expectedError:   nil,
+		},
+		{
+			name:  "Success to pull nothing and keep token",
+			token: "45",
+			mockSetup: func() {
+				mocks.repo.EXPECT().GetChanges(gomock.Any(), int64(45), 3).Return(nil, nil)
+			},
+			expectedChanges: nil,
+			expectedToken:   "45",
+			expectedHasMore: false,
+			expectedError:   nil,
+		},
+		{
+			name:          "Failed to pull - Due to invalid token",
+			token:         "abc",
+			mockSetup:     func() {},
+			expectedError: entities.ErrInvalidSyncToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			page, err := service.Pull(context.Background(), tc.token)
+
+			assert.ErrorIs(t, err, tc.expectedError)
+			if tc.expectedError == nil {
+				assert.Equal(t, tc.expectedChanges, page.Changes)
+				assert.Equal(t, tc.expectedToken, page.Token)
+				assert.Equal(t, tc.expectedHasMore, page.HasMore)
+			}
+		})
+	}
+}
+
+func TestPushSync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mocks := newTestSyncService(ctrl)
+
+	recurrence := &entities.Recurrence{Rule: "FREQ=DAILY"}
+	todo := &entities.Todo{Id: 3, Title: "todo", BoardId: 2, StatusId: 1, Recurrence: recurrence}
+	updated := &entities.Todo{Id: 3, Title: "updated", BoardId: 2, StatusId: 1, Recurrence: recurrence}
+
+	testCases := []struct {
+		name            string
+		mutations       []*entities.SyncMutation
+		mockSetup       func()
+		expectedResults []*entities.SyncResult
+		expectedError   error
+	}{
+		{
+			name: "Success to push creation and update",
+			mutations: []*entities.SyncMutation{
+				{EntityType: entities.SyncEntityRoom, Op: entities.SyncOpCreate, Name: "room"},
+				{EntityType: entities.SyncEntityTodo, Op: entities.SyncOpUpdate, Id: 3, BaseVersion: 30, Title: "updated"},
+			},
+			mockSetup: func() {
+				room := &entities.Room{Id: 1, Name: "room"}
+				mocks.rooms.EXPECT().Create(gomock.Any(), "room").Return(room, nil)
+				mocks.rooms.EXPECT().GetById(gomock.Any(), 1).Return(room, nil)
+				mocks.repo.EXPECT().GetVersions(gomock.Any(), entities.SyncEntityRoom, []int{1}).Return(map[int]int64{1: 31}, nil)
+
+				mocks.todos.EXPECT().GetById(gomock.Any(), 3).Return(todo, nil)
+				mocks.repo.EXPECT().GetVersions(gomock.Any(), entities.SyncEntityTodo, []int{3}).Return(map[int]int64{3: 30}, nil)
+				mocks.repo.EXPECT().ClaimVersion(gomock.Any(), entities.SyncEntityTodo, 3, int64(30)).Return(nil)
+				mocks.todos.EXPECT().UpdateIn(gomock.Any(), gomock.Any(), 3, "updated", false, nil, 0, nil, recurrence).Return(nil)
+				mocks.todos.EXPECT().GetById(gomock.Any(), 3).Return(updated, nil)
+				mocks.repo.EXPECT().GetVersions(gomock.Any(), entities.SyncEntityTodo, []int{3}).Return(map[int]int64{3: 32}, nil)
+			},
+			expectedResults: []*entities.SyncResult{
+				{Change: &entities.SyncChange{EntityType: entities.SyncEntityRoom, EntityId: 1, Version: 31, Entity: &entities.Room{Id: 1, Name: "room"}}},
+				{Change: &entities.SyncChange{EntityType: entities.SyncEntityTodo, EntityId: 3, Version: 32, Entity: updated}},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success to report conflict with current todo",
+			mutations: []*entities.SyncMutation{
+				{EntityType: entities.SyncEntityTodo, Op: entities.SyncOpUpdate, Id: 3, BaseVersion: 20, Title: "updated"},
+			},
+			mockSetup: func() {
+				mocks.todos.EXPECT().GetById(gomock.Any(), 3).Return(todo, nil)
+				mocks.repo.EXPECT().GetVersions(gomock.Any(), entities.SyncEntityTodo, []int{3}).Return(map[int]int64{3: 30}, nil)
+			},
+			expectedResults: []*entities.SyncResult{
+				{Err: entities.ErrSyncConflict, Change: &entities.SyncChange{EntityType: entities.SyncEntityTodo, EntityId: 3, Version: 30, Entity: todo}},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success to report conflict with todo changed after it was read",
+			mutations: []*entities.SyncMutation{
+				{EntityType: entities.SyncEntityTodo, Op: entities.SyncOpUpdate, Id: 3, BaseVersion: 30, Title: "updated"},
+			},
+			mockSetup: func() {
+				mocks.todos.EXPECT().GetById(gomock.Any(), 3).Return(todo, nil)
+				mocks.repo.EXPECT().GetVersions(gomock.Any(), entities.SyncEntityTodo, []int{3}).Return(map[int]int64{3: 30}, nil)
+				mocks.repo.EXPECT().ClaimVersion(gomock.Any(), entities.SyncEntityTodo, 3, int64(30)).Return(entities.ErrSyncConflict)
+				mocks.todos.EXPECT().GetById(gomock.Any(), 3).Return(updated, nil)
+				mocks.repo.EXPECT().GetVersions(gomock.Any(), entities.SyncEntityTodo, []int{3}).Return(map[int]int64{3: 33}, nil)
+			},
+			expectedResults: []*entities.SyncResult{
+				{Err: entities.ErrSyncConflict, Change: &entities.SyncChange{EntityType: entities.SyncEntityTodo, EntityId: 3, Version: 33, Entity: updated}},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success to report conflict for update of deleted todo and accept its deletion",
+			mutations: []*entities.SyncMutation{
+				{EntityType: entities.SyncEntityTodo, Op: entities.SyncOpUpdate, Id: 4, BaseVersion: 20, Title: "updated"},
+				{EntityType: entities.SyncEntityTodo, Op: entities.SyncOpDelete, Id: 4, BaseVersion: 20},
+			},
+			mockSetup: func() {
+				mocks.todos.EXPECT().GetById(gomock.Any(), 4).Return(nil, sql.ErrNoRows).Times(2)
+				mocks.repo.EXPECT().GetVersions(gomock.Any(), entities.SyncEntityTodo, []int{4}).Return(map[int]int64{4: 25}, nil).Times(2)
+			},
+			expectedResults: []*entities.SyncResult{
+				{Err: entities.ErrSyncConflict, Change: &entities.SyncChange{EntityType: entities.SyncEntityTodo, EntityId: 4, Version: 25}},
+				{Change: &entities.SyncChange{EntityType: entities.SyncEntityTodo, EntityId: 4, Version: 25}},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success to report failed mutation without applying it",
+			mutations: []*entities.SyncMutation{
+				{EntityType: entities.SyncEntityBoard, Op: entities.SyncOpCreate, Name: "board"},
+				{EntityType: entities.SyncEntityBoard, Op: entities.SyncOpCreate, Name: "board", RoomId: 1},
+			},
+			mockSetup: func() {
+				mocks.boards.EXPECT().Create(gomock.Any(), "board", 0, 1).Return(nil, entities.ErrArchived)
+			},
+			expectedResults: []*entities.SyncResult{
+				{Err: entities.ErrInvalidSyncMutation},
+				{Err: entities.ErrArchived},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to push - Due to too many mutations",
+			mutations: []*entities.SyncMutation{
+				{EntityType: entities.SyncEntityRoom, Op: entities.SyncOpCreate, Name: "a"},
+				{EntityType: entities.SyncEntityRoom, Op: entities.SyncOpCreate, Name: "b"},
+				{EntityType: entities.SyncEntityRoom, Op: entities.SyncOpCreate, Name: "c"},
+			},
+			mockSetup:     func() {},
+			expectedError: entities.ErrBatchTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			results, err := service.Push(context.Background(), tc.mutations)
+
+			assert.ErrorIs(t, err, tc.expectedError)
+			assert.Equal(t, tc.expectedResults, results)
+		})
+	}
+}
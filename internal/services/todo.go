@@ -1,53 +1,576 @@
 package services
 
 import (
+	"context"
+	"database/sql"
 	"time"
 
+	"github.com/rm-ryou/sample_todo_app/internal/config"
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
 	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
 )
 
 type TodoService struct {
-	repo interfaces.TodoRepository
+	repo         interfaces.TodoRepository
+	revisionRepo interfaces.TodoRevisionRepository
+	uow          interfaces.UnitOfWork
+	cfg          config.Todo
+	now          func() time.Time
 }
 
-func NewTodoService(repo interfaces.TodoRepository) *TodoService {
+func NewTodoService(repo interfaces.TodoRepository, revisionRepo interfaces.TodoRevisionRepository, uow interfaces.UnitOfWork, cfg config.Todo) *TodoService {
 	return &TodoService{
-		repo: repo,
+		repo:         repo,
+		revisionRepo: revisionRepo,
+		uow:          uow,
+		cfg:          cfg,
+		now:          time.Now,
 	}
 }
 
-func (ts *TodoService) GetById(id int) (*entities.Todo, error) {
-	return ts.repo.GetById(id)
+func (ts *TodoService) GetAll(ctx context.Context, boardId int) ([]*entities.Todo, error) {
+	return ts.repo.GetAllByBoardId(ctx, boardId)
 }
 
-func (ts *TodoService) Create(boardId int, title string, done bool, priority int, dueDate *time.Time) error {
-	todo := entities.NewTodo(boardId, title, done, priority, dueDate)
+func (ts *TodoService) GetById(ctx context.Context, id int) (*entities.Todo, error) {
+	return ts.repo.GetById(ctx, id)
+}
+
+// Create places the todo in statusId, or in the room's first todo or done status according to done.
+func (ts *TodoService) Create(ctx context.Context, boardId int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) (*entities.Todo, error) {
+	var todo *entities.Todo
+	err := ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		var err error
+		todo, err = ts.CreateIn(ctx, repos, boardId, title, done, statusId, priority, dueDate, recurrence)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+func (ts *TodoService) CreateIn(ctx context.Context, repos *interfaces.Repositories, boardId int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) (*entities.Todo, error) {
+	todo := entities.NewTodo(boardId, title, priority, dueDate, recurrence)
 	if err := todo.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := checkBoardWritable(ctx, repos, boardId); err != nil {
+		return nil, err
+	}
+
+	statuses, err := repos.Statuses.GetAllByBoardId(ctx, boardId)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := resolveStatus(statuses, statusId, done, 0)
+	if err != nil {
+		return nil, err
+	}
+	todo.Transition(status, ts.now().UTC())
+
+	if todo.Rank, err = appendRankIn(ctx, repos.Todos, boardId); err != nil {
+		return nil, err
+	}
+
+	if err := repos.Todos.Create(ctx, todo); err != nil {
+		return nil, err
+	}
+	if err := recordHistory(ctx, repos, nil, todo); err != nil {
+		return nil, err
+	}
+	if err := audit(ctx, repos.Audits, entities.AuditEntityTodo, todo.Id, entities.AuditActionCreate, nil, todo); err != nil {
+		return nil, err
+	}
+	if err := publish(ctx, repos, entities.EventTodoCreated, todo); err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+// Update transitions the todo to statusId, or between the room's first todo and done
+// statuses when only done changes. Completing a recurring todo spawns its next occurrence
+// in the same transaction. The todo stays locked from the read to the write, so concurrent
+// updates apply one after the other.
+func (ts *TodoService) Update(ctx context.Context, id int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) error {
+	return ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		return ts.UpdateIn(ctx, repos, id, title, done, statusId, priority, dueDate, recurrence)
+	})
+}
+
+func (ts *TodoService) UpdateIn(ctx context.Context, repos *interfaces.Repositories, id int, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) error {
+	todo, err := repos.Todos.GetByIdForUpdate(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	before := *todo
+	next, err := ts.applyUpdate(ctx, repos, todo, title, done, statusId, priority, dueDate, recurrence)
+	if err != nil {
 		return err
 	}
 
-	return ts.repo.Create(todo)
+	if err := repos.Todos.Update(ctx, todo); err != nil {
+		return err
+	}
+	if err := recordHistory(ctx, repos, &before, todo); err != nil {
+		return err
+	}
+	if err := audit(ctx, repos.Audits, entities.AuditEntityTodo, todo.Id, entities.AuditActionUpdate, &before, todo); err != nil {
+		return err
+	}
+	if err := publish(ctx, repos, entities.EventTodoUpdated, todo); err != nil {
+		return err
+	}
+
+	if next == nil {
+		return nil
+	}
+
+	if next.Rank, err = appendRankIn(ctx, repos.Todos, next.BoardId); err != nil {
+		return err
+	}
+
+	if err := repos.Todos.Create(ctx, next); err != nil {
+		return err
+	}
+	if err := recordHistory(ctx, repos, nil, next); err != nil {
+		return err
+	}
+	if err := audit(ctx, repos.Audits, entities.AuditEntityTodo, next.Id, entities.AuditActionCreate, nil, next); err != nil {
+		return err
+	}
+
+	return publish(ctx, repos, entities.EventTodoCreated, next)
+}
+
+// Move places the todo right before beforeId or right after afterId; when both are given
+// the todo goes between them. Only the moved todo is rewritten unless its new rank grows
+// past RankMaxLength, in which case the whole board is rebalanced. The board stays locked
+// while the neighbouring ranks are read, so concurrent moves never take the same rank.
+func (ts *TodoService) Move(ctx context.Context, boardId, id int, beforeId, afterId *int) error {
+	if beforeId == nil && afterId == nil {
+		return entities.ErrInvalidRank
+	}
+
+	return ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Todos.LockBoard(ctx, boardId); err != nil {
+			return err
+		}
+
+		todo, err := getInBoard(ctx, repos.Todos.GetByIdForUpdate, boardId, id)
+		if err != nil {
+			return err
+		}
+
+		if err := checkBoardWritable(ctx, repos, boardId); err != nil {
+			return err
+		}
+
+		var lower, upper string
+		if afterId != nil {
+			after, err := getInBoard(ctx, repos.Todos.GetById, boardId, *afterId)
+			if err != nil {
+				return err
+			}
+			lower = after.Rank
+		}
+		if beforeId != nil {
+			before, err := getInBoard(ctx, repos.Todos.GetById, boardId, *beforeId)
+			if err != nil {
+				return err
+			}
+			upper = before.Rank
+		}
+
+		if beforeId == nil {
+			if upper, err = repos.Todos.GetNextRank(ctx, boardId, lower, todo.Id); err != nil {
+				return err
+			}
+		}
+		if afterId == nil {
+			if lower, err = repos.Todos.GetPrevRank(ctx, boardId, upper, todo.Id); err != nil {
+				return err
+			}
+		}
+
+		rank, err := entities.RankBetween(lower, upper)
+		if err != nil {
+			return err
+		}
+
+		before := *todo
+		todo.Rank = rank
+
+		if err := repos.Todos.UpdateRank(ctx, todo.Id, rank); err != nil {
+			return err
+		}
+		if err := audit(ctx, repos.Audits, entities.AuditEntityTodo, todo.Id, entities.AuditActionUpdate, &before, todo); err != nil {
+			return err
+		}
+
+		if len(rank) <= ts.cfg.RankMaxLength {
+			return publish(ctx, repos, entities.EventTodoMoved, todo)
+		}
+
+		if err := repos.Todos.Rebalance(ctx, boardId); err != nil {
+			return err
+		}
+
+		// A rebalance rewrites every rank of the board, so subscribers get all of them.
+		todos, err := repos.Todos.GetAllByBoardId(ctx, boardId)
+		if err != nil {
+			return err
+		}
+		for _, t := range todos {
+			if err := publish(ctx, repos, entities.EventTodoMoved, t); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// MoveToBoard moves the todo to the end of targetBoardId, keeping its id, checklist and reminders.
+// Moving to another room maps the todo onto an equivalent status there, see mapStatus.
+func (ts *TodoService) MoveToBoard(ctx context.Context, boardId, id, targetBoardId int) error {
+	return ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		todo, err := getInBoard(ctx, repos.Todos.GetByIdForUpdate, boardId, id)
+		if err != nil {
+			return err
+		}
+		if todo.BoardId == targetBoardId {
+			return nil
+		}
+
+		before := *todo
+		if err := ts.applyMove(ctx, repos, todo, targetBoardId, func(ctx context.Context, boardId int) (string, error) {
+			return appendRankIn(ctx, repos.Todos, boardId)
+		}); err != nil {
+			return err
+		}
+
+		if err := repos.Todos.MoveToBoard(ctx, todo); err != nil {
+			return err
+		}
+		if err := recordHistory(ctx, repos, &before, todo); err != nil {
+			return err
+		}
+		if err := audit(ctx, repos.Audits, entities.AuditEntityTodo, todo.Id, entities.AuditActionUpdate, &before, todo); err != nil {
+			return err
+		}
+
+		return publish(ctx, repos, entities.EventTodoMoved, todo, before.BoardId)
+	})
+}
+
+// CopyToBoard appends a copy of the todo and its checklist to targetBoardId and returns the copy.
+// Dependencies and reminders stay with the original.
+func (ts *TodoService) CopyToBoard(ctx context.Context, boardId, id, targetBoardId int) (*entities.Todo, error) {
+	var copied *entities.Todo
+	err := ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		todo, err := getInBoard(ctx, repos.Todos.GetById, boardId, id)
+		if err != nil {
+			return err
+		}
+
+		if err := checkBoardWritable(ctx, repos, targetBoardId); err != nil {
+			return err
+		}
+
+		status, err := ts.targetStatus(ctx, repos, todo, targetBoardId)
+		if err != nil {
+			return err
+		}
+
+		copied = todo.CopyTo(targetBoardId)
+		copied.Transition(status, ts.now().UTC())
+		if copied.Rank, err = appendRankIn(ctx, repos.Todos, targetBoardId); err != nil {
+			return err
+		}
+
+		if err := repos.Todos.Copy(ctx, todo.Id, copied); err != nil {
+			return err
+		}
+		if err := recordHistory(ctx, repos, nil, copied); err != nil {
+			return err
+		}
+		if err := audit(ctx, repos.Audits, entities.AuditEntityTodo, copied.Id, entities.AuditActionCreate, nil, copied); err != nil {
+			return err
+		}
+
+		// The copy is read back for the checklist it got.
+		if copied, err = repos.Todos.GetById(ctx, copied.Id); err != nil {
+			return err
+		}
+
+		return publish(ctx, repos, entities.EventTodoCreated, copied)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return copied, nil
 }
 
-func (ts *TodoService) Update(id int, title string, done bool, priority int, dueDate *time.Time) error {
-	todo, err := ts.repo.GetById(id)
+func (ts *TodoService) Delete(ctx context.Context, id int) error {
+	return ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		return ts.DeleteIn(ctx, repos, id)
+	})
+}
+
+func (ts *TodoService) DeleteIn(ctx context.Context, repos *interfaces.Repositories, id int) error {
+	todo, err := repos.Todos.GetByIdForUpdate(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	todo.UpdateAttributes(title, done, priority, dueDate)
+	if err := checkBoardWritable(ctx, repos, todo.BoardId); err != nil {
+		return err
+	}
+
+	if err := repos.Todos.Delete(ctx, id); err != nil {
+		return err
+	}
+	if err := audit(ctx, repos.Audits, entities.AuditEntityTodo, id, entities.AuditActionDelete, todo, nil); err != nil {
+		return err
+	}
+
+	return publish(ctx, repos, entities.EventTodoDeleted, todo)
+}
+
+// applyUpdate changes the todo in memory. It returns the next occurrence to create when the
+// update completes a recurring todo, or nil.
+func (ts *TodoService) applyUpdate(ctx context.Context, repos *interfaces.Repositories, todo *entities.Todo, title string, done bool, statusId *int, priority int, dueDate *time.Time, recurrence *entities.Recurrence) (*entities.Todo, error) {
+	if err := checkBoardWritable(ctx, repos, todo.BoardId); err != nil {
+		return nil, err
+	}
+
+	statuses, err := repos.Statuses.GetAllByBoardId(ctx, todo.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := resolveStatus(statuses, statusId, done, todo.StatusId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range statuses {
+		if s.Id == todo.StatusId && !s.AllowsTransitionTo(status) {
+			return nil, entities.ErrTransitionNotAllowed
+		}
+	}
+
+	completed := !todo.Done && status.IsDone()
+	if ts.cfg.RequireBlockersDone && completed && todo.Blocked {
+		return nil, entities.ErrTodoBlocked
+	}
+
+	todo.UpdateAttributes(title, priority, dueDate, recurrence)
 	if err := todo.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := ts.now().UTC()
+	todo.Transition(status, now)
+
+	if !completed {
+		return nil, nil
+	}
+
+	next, ok := todo.NextOccurrence()
+	if !ok {
+		return nil, nil
+	}
+
+	initial, err := resolveStatus(statuses, nil, false, 0)
+	if err != nil {
+		return nil, err
+	}
+	next.Transition(initial, now)
+
+	return next, nil
+}
+
+// applyMove places the todo at the end of targetBoardId in memory, taking its rank from appendRank.
+func (ts *TodoService) applyMove(ctx context.Context, repos *interfaces.Repositories, todo *entities.Todo, targetBoardId int, appendRank func(ctx context.Context, boardId int) (string, error)) error {
+	for _, boardId := range []int{todo.BoardId, targetBoardId} {
+		if err := checkBoardWritable(ctx, repos, boardId); err != nil {
+			return err
+		}
+	}
+
+	status, err := ts.targetStatus(ctx, repos, todo, targetBoardId)
+	if err != nil {
 		return err
 	}
 
-	return ts.repo.Update(todo)
+	todo.BoardId = targetBoardId
+	todo.Transition(status, ts.now().UTC())
+	todo.Rank, err = appendRank(ctx, targetBoardId)
+
+	return err
+}
+
+// appendRankIn locks the board and returns a rank after every todo in it.
+func appendRankIn(ctx context.Context, repo interfaces.TodoRepository, boardId int) (string, error) {
+	if err := repo.LockBoard(ctx, boardId); err != nil {
+		return "", err
+	}
+
+	last, err := repo.GetLastRank(ctx, boardId)
+	if err != nil {
+		return "", err
+	}
+
+	return entities.RankBetween(last, "")
 }
 
-func (ts *TodoService) Delete(id int) error {
-	if _, err := ts.repo.GetById(id); err != nil {
+// getTodoOnBoard reports sql.ErrNoRows for a todo that is not on boardId, so that what
+// hangs off a todo is only reached through the path of its board.
+func getTodoOnBoard(ctx context.Context, repo interfaces.TodoRepository, boardId, todoId int) (*entities.Todo, error) {
+	todo, err := repo.GetById(ctx, todoId)
+	if err != nil {
+		return nil, err
+	}
+	if todo.BoardId != boardId {
+		return nil, sql.ErrNoRows
+	}
+
+	return todo, nil
+}
+
+// checkBoardWritable rejects changes to the todos of an archived board or room.
+func checkBoardWritable(ctx context.Context, repos *interfaces.Repositories, boardId int) error {
+	board, err := repos.Boards.GetById(ctx, boardId)
+	if err != nil {
 		return err
 	}
 
-	return ts.repo.Delete(id)
+	return board.Writable()
+}
+
+// publish stores the change in the outbox for the rooms of the todo's board and of
+// otherBoardIds, so that subscribers learn about it exactly when the transaction commits,
+// and queues it for the webhooks of those rooms.
+func publish(ctx context.Context, repos *interfaces.Repositories, eventType string, todo *entities.Todo, otherBoardIds ...int) error {
+	published := make(map[int]bool)
+	for _, boardId := range append([]int{todo.BoardId}, otherBoardIds...) {
+		board, err := repos.Boards.GetById(ctx, boardId)
+		if err != nil {
+			return err
+		}
+		if published[board.RoomId] {
+			continue
+		}
+		published[board.RoomId] = true
+
+		event, err := entities.NewOutboxEvent(board.RoomId, eventType, todo)
+		if err != nil {
+			return err
+		}
+		if err := repos.Outbox.Create(ctx, event); err != nil {
+			return err
+		}
+		if err := repos.Webhooks.Enqueue(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getInBoard reads the todo with get, reporting todos of other boards as missing.
+func getInBoard(ctx context.Context, get func(ctx context.Context, id int) (*entities.Todo, error), boardId, id int) (*entities.Todo, error) {
+	todo, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if todo.BoardId != boardId {
+		return nil, sql.ErrNoRows
+	}
+
+	return todo, nil
+}
+
+// targetStatus finds the status the todo takes on targetBoardId. Every room keeps a todo and
+// a done status, so a board without statuses does not exist.
+func (ts *TodoService) targetStatus(ctx context.Context, repos *interfaces.Repositories, todo *entities.Todo, targetBoardId int) (*entities.Status, error) {
+	statuses, err := repos.Statuses.GetAllByBoardId(ctx, targetBoardId)
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	current, err := repos.Statuses.GetById(ctx, todo.StatusId)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapStatus(statuses, current)
+}
+
+// mapStatus keeps current when it belongs to statuses, as it does within a room. Otherwise it
+// prefers a status with the same name and category, then the first one of the same category,
+// and finally falls back to the first todo or done status.
+func mapStatus(statuses []*entities.Status, current *entities.Status) (*entities.Status, error) {
+	for _, s := range statuses {
+		if s.Id == current.Id {
+			return s, nil
+		}
+	}
+
+	for _, s := range statuses {
+		if s.Name == current.Name && s.Category == current.Category {
+			return s, nil
+		}
+	}
+
+	for _, s := range statuses {
+		if s.Category == current.Category {
+			return s, nil
+		}
+	}
+
+	return resolveStatus(statuses, nil, current.IsDone(), 0)
+}
+
+// resolveStatus picks the target status among the room's statuses. An explicit statusId must
+// belong to the room. Otherwise the current status is kept while it agrees with done, and the
+// first status of the matching category is used when it does not.
+func resolveStatus(statuses []*entities.Status, statusId *int, done bool, currentId int) (*entities.Status, error) {
+	if statusId != nil {
+		for _, s := range statuses {
+			if s.Id == *statusId {
+				return s, nil
+			}
+		}
+		return nil, entities.ErrInvalidStatus
+	}
+
+	for _, s := range statuses {
+		if s.Id == currentId && s.IsDone() == done {
+			return s, nil
+		}
+	}
+
+	category := entities.StatusCategoryTodo
+	if done {
+		category = entities.StatusCategoryDone
+	}
+	for _, s := range statuses {
+		if s.Category == category {
+			return s, nil
+		}
+	}
+
+	return nil, entities.ErrStatusRequired
 }
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+// Batch checks every operation against working copies of the todos it touches, so later
+// operations see the effect of earlier ones, and then writes all changes. Reads and writes
+// share one transaction, and the todos stay locked from their first read to the commit.
+// The returned slice holds the failure of each operation, nil for the ones that succeeded.
+// When any operation fails nothing is written and ErrBatchAborted is returned.
+func (ts *TodoService) Batch(ctx context.Context, ops []*entities.TodoBatchOp) ([]error, error) {
+	if len(ops) > ts.cfg.BatchMaxSize {
+		return nil, entities.ErrBatchTooLarge
+	}
+
+	var errs []error
+	err := ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		batch := &todoBatch{
+			service: ts,
+			repos:   repos,
+			todos:   make(map[int]*entities.Todo),
+			ranks:   make(map[int]string),
+		}
+
+		errs = make([]error, len(ops))
+		failed := false
+		for i, op := range ops {
+			if errs[i] = batch.apply(ctx, op); errs[i] != nil {
+				failed = true
+			}
+		}
+		if failed {
+			return entities.ErrBatchAborted
+		}
+
+		return batch.write(ctx)
+	})
+	if errors.Is(err, entities.ErrBatchAborted) {
+		return errs, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return errs, nil
+}
+
+type todoBatch struct {
+	service *TodoService
+	repos   *interfaces.Repositories
+	todos   map[int]*entities.Todo // working copies by id, nil once deleted
+	ranks   map[int]string         // last rank handed out per board
+	changes []*entities.TodoChange
+}
+
+// apply works on a copy of the todo so that a failed operation leaves no trace.
+func (b *todoBatch) apply(ctx context.Context, op *entities.TodoBatchOp) error {
+	if err := op.Validate(); err != nil {
+		return err
+	}
+
+	todo, err := b.get(ctx, op.Id)
+	if err != nil {
+		return err
+	}
+	before := b.todos[op.Id]
+
+	switch op.Op {
+	case entities.TodoBatchUpdate:
+		title, done, priority, dueDate := todo.Title, todo.Done, todo.Priority, todo.DueDate
+		if op.Title != nil {
+			title = *op.Title
+		}
+		if op.Done != nil {
+			done = *op.Done
+		}
+		if op.Priority != nil {
+			priority = *op.Priority
+		}
+		if op.DueDate != nil {
+			dueDate = op.DueDate
+		}
+		if op.ClearDueDate {
+			dueDate = nil
+		}
+
+		next, err := b.service.applyUpdate(ctx, b.repos, todo, title, done, op.StatusId, priority, dueDate, todo.Recurrence)
+		if err != nil {
+			return err
+		}
+		if next != nil {
+			if next.Rank, err = b.appendRank(ctx, next.BoardId); err != nil {
+				return err
+			}
+		}
+
+		b.record(entities.TodoChangeUpdate, before, todo)
+		if next != nil {
+			b.changes = append(b.changes, &entities.TodoChange{Kind: entities.TodoChangeCreate, Todo: next})
+		}
+	case entities.TodoBatchMove:
+		if todo.BoardId == *op.BoardId {
+			return nil
+		}
+		if err := b.service.applyMove(ctx, b.repos, todo, *op.BoardId, b.appendRank); err != nil {
+			return err
+		}
+
+		b.record(entities.TodoChangeMove, before, todo)
+	case entities.TodoBatchDelete:
+		if err := checkBoardWritable(ctx, b.repos, todo.BoardId); err != nil {
+			return err
+		}
+
+		b.changes = append(b.changes, &entities.TodoChange{Kind: entities.TodoChangeDelete, Before: before, Todo: todo})
+		b.todos[op.Id] = nil
+	}
+
+	return nil
+}
+
+// get returns a copy of the working todo, loading and locking it on first use.
+func (b *todoBatch) get(ctx context.Context, id int) (*entities.Todo, error) {
+	todo, ok := b.todos[id]
+	if !ok {
+		var err error
+		if todo, err = b.repos.Todos.GetByIdForUpdate(ctx, id); err != nil {
+			return nil, err
+		}
+		b.todos[id] = todo
+	}
+	if todo == nil {
+		return nil, sql.ErrNoRows
+	}
+
+	working := *todo
+	return &working, nil
+}
+
+// record keeps todo as the working copy and queues its write.
+func (b *todoBatch) record(kind string, before, todo *entities.Todo) {
+	b.todos[todo.Id] = todo
+	b.changes = append(b.changes, &entities.TodoChange{Kind: kind, Before: before, Todo: todo})
+}
+
+// appendRank hands out ranks one after another, as the board's last rank in the database
+// does not move until the batch is written. The board is locked on first use.
+func (b *todoBatch) appendRank(ctx context.Context, boardId int) (string, error) {
+	last, ok := b.ranks[boardId]
+	if !ok {
+		if err := b.repos.Todos.LockBoard(ctx, boardId); err != nil {
+			return "", err
+		}
+
+		var err error
+		if last, err = b.repos.Todos.GetLastRank(ctx, boardId); err != nil {
+			return "", err
+		}
+	}
+
+	rank, err := entities.RankBetween(last, "")
+	if err != nil {
+		return "", err
+	}
+	b.ranks[boardId] = rank
+
+	return rank, nil
+}
+
+// write applies the queued changes in order, auditing each of them, keeping its history and
+// publishing it.
+func (b *todoBatch) write(ctx context.Context) error {
+	repos := b.repos
+	for _, change := range b.changes {
+		var err error
+		switch change.Kind {
+		case entities.TodoChangeCreate:
+			if err = repos.Todos.Create(ctx, change.Todo); err == nil {
+				err = recordHistory(ctx, repos, nil, change.Todo)
+			}
+			if err == nil {
+				err = audit(ctx, repos.Audits, entities.AuditEntityTodo, change.Todo.Id, entities.AuditActionCreate, nil, change.Todo)
+			}
+		case entities.TodoChangeUpdate:
+			if err = repos.Todos.Update(ctx, change.Todo); err == nil {
+				err = recordHistory(ctx, repos, change.Before, change.Todo)
+			}
+			if err == nil {
+				err = audit(ctx, repos.Audits, entities.AuditEntityTodo, change.Todo.Id, entities.AuditActionUpdate, change.Before, change.Todo)
+			}
+		case entities.TodoChangeMove:
+			if err = repos.Todos.MoveToBoard(ctx, change.Todo); err == nil {
+				err = recordHistory(ctx, repos, change.Before, change.Todo)
+			}
+			if err == nil {
+				err = audit(ctx, repos.Audits, entities.AuditEntityTodo, change.Todo.Id, entities.AuditActionUpdate, change.Before, change.Todo)
+			}
+		case entities.TodoChangeDelete:
+			if err = repos.Todos.Delete(ctx, change.Todo.Id); err == nil {
+				err = audit(ctx, repos.Audits, entities.AuditEntityTodo, change.Todo.Id, entities.AuditActionDelete, change.Before, nil)
+			}
+		default:
+			err = fmt.Errorf("unknown todo change %q", change.Kind)
+		}
+		if err == nil {
+			err = publishChange(ctx, repos, change)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func publishChange(ctx context.Context, repos *interfaces.Repositories, change *entities.TodoChange) error {
+	switch change.Kind {
+	case entities.TodoChangeCreate:
+		return publish(ctx, repos, entities.EventTodoCreated, change.Todo)
+	case entities.TodoChangeUpdate:
+		return publish(ctx, repos, entities.EventTodoUpdated, change.Todo)
+	case entities.TodoChangeMove:
+		return publish(ctx, repos, entities.EventTodoMoved, change.Todo, change.Before.BoardId)
+	default:
+		return publish(ctx, repos, entities.EventTodoDeleted, change.Todo)
+	}
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBatchTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{BatchMaxSize: 3})
+
+	done := true
+	title := "renamed"
+	emptyTitle := ""
+	targetBoardId := 2
+
+	otherRoomStatuses := []*entities.Status{
+		{Id: 11, RoomId: 2, Name: "To Do", Category: entities.StatusCategoryTodo},
+		{Id: 13, RoomId: 2, Name: "Done", Category: entities.StatusCategoryDone},
+	}
+
+	testCases := []struct {
+		name           string
+		ops            []*entities.TodoBatchOp
+		mockSetup      func()
+		expectedErrors []error
+		expectedError  error
+	}{
+		{
+			name: "Success to apply every operation in one batch",
+			ops: []*entities.TodoBatchOp{
+				{Op: entities.TodoBatchUpdate, Id: 1, Done: &done},
+				{Op: entities.TodoBatchUpdate, Id: 2, Title: &title},
+				{Op: entities.TodoBatchDelete, Id: 3},
+			},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1, Title: "first", StatusId: 1}, nil)
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 2).Return(&entities.Todo{Id: 2, BoardId: 1, Title: "second", StatusId: 2}, nil)
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 3).Return(&entities.Todo{Id: 3, BoardId: 1, Title: "third", StatusId: 1}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil).Times(2)
+				gomock.InOrder(
+					mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{Id: 1, BoardId: 1, Title: "first", StatusId: 3, Done: true, CompletedAt: &testNow}).Return(nil),
+					mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{Id: 2, BoardId: 1, Title: "renamed", StatusId: 2}).Return(nil),
+					mockRepository.EXPECT().Delete(gomock.Any(), 3).Return(nil),
+				)
+			},
+			expectedErrors: []error{nil, nil, nil},
+			expectedError:  nil,
+		},
+		{
+			name: "Success to clear due date",
+			ops: []*entities.TodoBatchOp{
+				{Op: entities.TodoBatchUpdate, Id: 1, ClearDueDate: true},
+			},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1, Title: "first", StatusId: 1, DueDate: &testNow}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{Id: 1, BoardId: 1, Title: "first", StatusId: 1}).Return(nil)
+			},
+			expectedErrors: []error{nil},
+			expectedError:  nil,
+		},
+		{
+			name: "Success to hand out consecutive ranks when moving to the same board",
+			ops: []*entities.TodoBatchOp{
+				{Op: entities.TodoBatchMove, Id: 1, BoardId: &targetBoardId},
+				{Op: entities.TodoBatchMove, Id: 2, BoardId: &targetBoardId},
+			},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1, Title: "first", StatusId: 1, Rank: "A"}, nil)
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 2).Return(&entities.Todo{Id: 2, BoardId: 1, Title: "second", StatusId: 1, Rank: "B"}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 2).Return(otherRoomStatuses, nil).Times(2)
+				mockStatusRepository.EXPECT().GetById(gomock.Any(), 1).Return(roomStatuses[0], nil).Times(2)
+				mockRepository.EXPECT().GetLastRank(gomock.Any(), 2).Return("V", nil)
+				gomock.InOrder(
+					mockRepository.EXPECT().MoveToBoard(gomock.Any(), &entities.Todo{Id: 1, BoardId: 2, Title: "first", StatusId: 11, Rank: "W"}).Return(nil),
+					mockRepository.EXPECT().MoveToBoard(gomock.Any(), &entities.Todo{Id: 2, BoardId: 2, Title: "second", StatusId: 11, Rank: "X"}).Return(nil),
+				)
+			},
+			expectedErrors: []error{nil, nil},
+			expectedError:  nil,
+		},
+		{
+			name: "Failed to apply batch - Due to operations that fail",
+			ops: []*entities.TodoBatchOp{
+				{Op: entities.TodoBatchDelete, Id: 1},
+				{Op: entities.TodoBatchUpdate, Id: 1, Done: &done},
+				{Op: entities.TodoBatchUpdate, Id: 2, Title: &emptyTitle},
+			},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1, Title: "first", StatusId: 1}, nil)
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 2).Return(&entities.Todo{Id: 2, BoardId: 1, Title: "second", StatusId: 1}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil)
+			},
+			expectedErrors: []error{nil, sql.ErrNoRows, entities.ErrInvalidTitle},
+			expectedError:  entities.ErrBatchAborted,
+		},
+		{
+			name: "Failed to apply batch - Due to invalid operation",
+			ops: []*entities.TodoBatchOp{
+				{Op: entities.TodoBatchMove, Id: 1},
+			},
+			mockSetup:      func() {},
+			expectedErrors: []error{entities.ErrInvalidBatchOperation},
+			expectedError:  entities.ErrBatchAborted,
+		},
+		{
+			name: "Failed to apply batch - Due to too many operations",
+			ops: []*entities.TodoBatchOp{
+				{Op: entities.TodoBatchDelete, Id: 1},
+				{Op: entities.TodoBatchDelete, Id: 2},
+				{Op: entities.TodoBatchDelete, Id: 3},
+				{Op: entities.TodoBatchDelete, Id: 4},
+			},
+			mockSetup:      func() {},
+			expectedErrors: nil,
+			expectedError:  entities.ErrBatchTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			errs, err := service.Batch(context.Background(), tc.ops)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedErrors, errs)
+		})
+	}
+}
+
+func TestBatchTodoSpawnsNextOccurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{BatchMaxSize: 10})
+
+	done := true
+	dueDate := testNow
+	nextDueDate := testNow.AddDate(0, 0, 7)
+
+	mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+		Return(&entities.Todo{Id: 1, BoardId: 1, Title: "chore", StatusId: 1, DueDate: &dueDate, Recurrence: &entities.Recurrence{Rule: "FREQ=WEEKLY"}}, nil)
+	mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil)
+	mockRepository.EXPECT().GetLastRank(gomock.Any(), 1).Return("V", nil)
+	gomock.InOrder(
+		mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{Id: 1, BoardId: 1, Title: "chore", StatusId: 3, Done: true, DueDate: &dueDate, CompletedAt: &testNow}).Return(nil),
+		mockRepository.EXPECT().Create(gomock.Any(), &entities.Todo{BoardId: 1, Title: "chore", StatusId: 1, Rank: "W", DueDate: &nextDueDate, Recurrence: &entities.Recurrence{Rule: "FREQ=WEEKLY"}}).Return(nil),
+	)
+
+	errs, err := service.Batch(context.Background(), []*entities.TodoBatchOp{{Op: entities.TodoBatchUpdate, Id: 1, Done: &done}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []error{nil}, errs)
+}
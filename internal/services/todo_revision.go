@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+// GetRevisions returns the revisions of the todo, oldest first.
+func (ts *TodoService) GetRevisions(ctx context.Context, boardId, id int) ([]*entities.TodoRevision, error) {
+	if _, err := getInBoard(ctx, ts.repo.GetById, boardId, id); err != nil {
+		return nil, err
+	}
+
+	return ts.revisionRepo.GetAllByTodoId(ctx, id)
+}
+
+// Revert applies the fields of an earlier revision through Update, so the result is
+// validated like any other edit and stored as a new revision. The revision's status is
+// mapped onto the todo's current room like MoveToBoard does, since the todo may have moved
+// since then, and falls back to the room's first status of the todo's state once it was
+// deleted.
+func (ts *TodoService) Revert(ctx context.Context, boardId, id, version int) error {
+	return ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		todo, err := getInBoard(ctx, repos.Todos.GetById, boardId, id)
+		if err != nil {
+			return err
+		}
+
+		revision, err := repos.Revisions.GetByVersion(ctx, id, version)
+		if err != nil {
+			return err
+		}
+
+		status, err := revertedStatus(ctx, repos, todo, revision.StatusId)
+		if err != nil {
+			return err
+		}
+
+		return ts.UpdateIn(ctx, repos, id, revision.Title, todo.Done, &status.Id, revision.Priority, revision.DueDate, revision.Recurrence)
+	})
+}
+
+// revertedStatus finds the status of the todo's room that stands for statusId.
+func revertedStatus(ctx context.Context, repos *interfaces.Repositories, todo *entities.Todo, statusId int) (*entities.Status, error) {
+	statuses, err := repos.Statuses.GetAllByBoardId(ctx, todo.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := repos.Statuses.GetById(ctx, statusId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return resolveStatus(statuses, nil, todo.Done, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mapStatus(statuses, status)
+}
+
+// recordRevision stores the todo as its next revision. Changes that leave the revisioned
+// fields as they were in before, such as reordering, are skipped.
+func recordRevision(ctx context.Context, repo interfaces.TodoRevisionRepository, before, todo *entities.Todo) error {
+	revision := entities.NewTodoRevision(todo, ActorFrom(ctx).Name)
+	if before != nil && len(revision.ChangedFields(entities.NewTodoRevision(before, ""))) == 0 {
+		return nil
+	}
+
+	return repo.Create(ctx, revision)
+}
+
+// recordHistory keeps the revision and the room activity of a change to a todo. before is
+// nil for new todos.
+func recordHistory(ctx context.Context, repos *interfaces.Repositories, before, todo *entities.Todo) error {
+	if err := recordRevision(ctx, repos.Revisions, before, todo); err != nil {
+		return err
+	}
+
+	return recordActivities(ctx, repos.Activities, before, todo)
+}
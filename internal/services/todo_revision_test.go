@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetTodoRevisions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, _ := newTestTodoService(ctrl, config.Todo{})
+	mockRevisionRepository := mock_repository.NewMockTodoRevisionRepository(ctrl)
+	service.revisionRepo = mockRevisionRepository
+
+	revisions := []*entities.TodoRevision{
+		{Id: 1, TodoId: 1, Version: 1, Title: "first", StatusId: 1},
+		{Id: 2, TodoId: 1, Version: 2, Title: "second", StatusId: 1},
+	}
+
+	testCases := []struct {
+		name              string
+		mockSetup         func()
+		expectedRevisions []*entities.TodoRevision
+		expectedError     error
+	}{
+		{
+			name: "Success to get todo revisions",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1}, nil)
+				mockRevisionRepository.EXPECT().GetAllByTodoId(gomock.Any(), 1).Return(revisions, nil)
+			},
+			expectedRevisions: revisions,
+			expectedError:     nil,
+		},
+		{
+			name: "Failed to get todo revisions - Due to todo in another board",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 5}, nil)
+			},
+			expectedRevisions: nil,
+			expectedError:     sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			revisions, err := service.GetRevisions(context.Background(), 1, 1)
+
+			assert.Equal(t, tc.expectedRevisions, revisions)
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestRevertTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{})
+	mockRevisionRepository := mock_repository.NewMockTodoRevisionRepository(ctrl)
+	mockRevisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	service.uow.(*fakeUnitOfWork).repos.Revisions = mockRevisionRepository
+
+	newTodo := func() *entities.Todo {
+		return &entities.Todo{Id: 1, BoardId: 1, Title: "renamed", StatusId: 2, Priority: 1, Rank: "V"}
+	}
+
+	testCases := []struct {
+		name          string
+		version       int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:    "Success to revert todo",
+			version: 1,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(newTodo(), nil)
+				mockRevisionRepository.EXPECT().GetByVersion(gomock.Any(), 1, 1).
+					Return(&entities.TodoRevision{TodoId: 1, Version: 1, Title: "original", StatusId: 1, Priority: 3}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil).Times(2)
+				mockStatusRepository.EXPECT().GetById(gomock.Any(), 1).Return(roomStatuses[0], nil)
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(newTodo(), nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{Id: 1, BoardId: 1, Title: "original", StatusId: 1, Priority: 3, Rank: "V"}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "Success to revert todo - Mapping the status of the room the todo left",
+			version: 1,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(newTodo(), nil)
+				mockRevisionRepository.EXPECT().GetByVersion(gomock.Any(), 1, 1).
+					Return(&entities.TodoRevision{TodoId: 1, Version: 1, Title: "original", StatusId: 7, Priority: 3}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil).Times(2)
+				mockStatusRepository.EXPECT().GetById(gomock.Any(), 7).
+					Return(&entities.Status{Id: 7, RoomId: 2, Name: "Done", Category: entities.StatusCategoryDone}, nil)
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(newTodo(), nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{Id: 1, BoardId: 1, Title: "original", StatusId: 3, Done: true, CompletedAt: &testNow, Priority: 3, Rank: "V"}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "Success to revert todo - Falling back once the status of the revision was deleted",
+			version: 1,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(newTodo(), nil)
+				mockRevisionRepository.EXPECT().GetByVersion(gomock.Any(), 1, 1).
+					Return(&entities.TodoRevision{TodoId: 1, Version: 1, Title: "original", StatusId: 42}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil).Times(2)
+				mockStatusRepository.EXPECT().GetById(gomock.Any(), 42).Return(nil, sql.ErrNoRows)
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(newTodo(), nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{Id: 1, BoardId: 1, Title: "original", StatusId: 1, Rank: "V"}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "Failed to revert todo - Due to not exist version",
+			version: 99,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(newTodo(), nil)
+				mockRevisionRepository.EXPECT().GetByVersion(gomock.Any(), 1, 99).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:    "Failed to revert todo - Due to todo in another board",
+			version: 1,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 5}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Revert(context.Background(), 1, 1, tc.version)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestRecordRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRevisionRepository := mock_repository.NewMockTodoRevisionRepository(ctrl)
+	ctx := WithActor(context.Background(), entities.Actor{Name: "alice"})
+
+	testCases := []struct {
+		name      string
+		before    *entities.Todo
+		todo      *entities.Todo
+		mockSetup func()
+	}{
+		{
+			name:   "Success to record the first revision of a new todo",
+			before: nil,
+			todo:   &entities.Todo{Id: 1, Title: "new", StatusId: 1},
+			mockSetup: func() {
+				mockRevisionRepository.EXPECT().Create(gomock.Any(), &entities.TodoRevision{TodoId: 1, Title: "new", StatusId: 1, Actor: "alice"}).
+					Return(nil)
+			},
+		},
+		{
+			name:   "Success to record a revision of an edited todo",
+			before: &entities.Todo{Id: 1, Title: "new", StatusId: 1},
+			todo:   &entities.Todo{Id: 1, Title: "new", StatusId: 2},
+			mockSetup: func() {
+				mockRevisionRepository.EXPECT().Create(gomock.Any(), &entities.TodoRevision{TodoId: 1, Title: "new", StatusId: 2, Actor: "alice"}).
+					Return(nil)
+			},
+		},
+		{
+			name:      "No revision when only the rank changes",
+			before:    &entities.Todo{Id: 1, Title: "new", StatusId: 1, Rank: "A"},
+			todo:      &entities.Todo{Id: 1, Title: "new", StatusId: 1, Rank: "B"},
+			mockSetup: func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := recordRevision(ctx, mockRevisionRepository, tc.before, tc.todo)
+
+			assert.NoError(t, err)
+		})
+	}
+}
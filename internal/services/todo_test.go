@@ -1,33 +1,72 @@
 package services
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/rm-ryou/sample_todo_app/internal/config"
 	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
 	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
 
+var (
+	testNow      = time.Date(2025, 6, 28, 9, 0, 0, 0, time.UTC)
+	roomStatuses = []*entities.Status{
+		{Id: 1, RoomId: 1, Name: "To Do", Category: entities.StatusCategoryTodo},
+		{Id: 2, RoomId: 1, Name: "In Progress", Category: entities.StatusCategoryDoing},
+		{Id: 3, RoomId: 1, Name: "Done", Category: entities.StatusCategoryDone},
+	}
+)
+
+func newTestTodoService(ctrl *gomock.Controller, cfg config.Todo) (*TodoService, *mock_repository.MockTodoRepository, *mock_repository.MockStatusRepository) {
+	mockRepository := mock_repository.NewMockTodoRepository(ctrl)
+	mockStatusRepository := mock_repository.NewMockStatusRepository(ctrl)
+	mockRepository.EXPECT().LockBoard(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockBoardRepository := mock_repository.NewMockBoardRepository(ctrl)
+	mockBoardRepository.EXPECT().GetById(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id int) (*entities.Board, error) {
+			return &entities.Board{Id: id}, nil
+		}).AnyTimes()
+	mockRevisionRepository := mock_repository.NewMockTodoRevisionRepository(ctrl)
+	mockRevisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockActivityRepository := mock_repository.NewMockActivityRepository(ctrl)
+	mockActivityRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockOutboxRepository := mock_repository.NewMockOutboxRepository(ctrl)
+	mockOutboxRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockWebhookRepository := mock_repository.NewMockWebhookRepository(ctrl)
+	mockWebhookRepository.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	uow := newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Todos: mockRepository, Statuses: mockStatusRepository, Boards: mockBoardRepository, Revisions: mockRevisionRepository, Activities: mockActivityRepository, Outbox: mockOutboxRepository, Webhooks: mockWebhookRepository})
+	service := NewTodoService(mockRepository, mockRevisionRepository, uow, cfg)
+	service.now = func() time.Time { return testNow }
+
+	return service, mockRepository, mockStatusRepository
+}
+
 func TestCreateTodo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockRepository := mock_repository.NewMockTodoRepository(ctrl)
-	service := NewTodoService(mockRepository)
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{})
+
+	doingStatusId := 2
+	otherRoomStatusId := 99
 
 	testCases := []struct {
 		name          string
 		title         string
 		done          bool
+		statusId      *int
 		priority      int
 		dueDate       *time.Time
 		boardId       int
-		mockSetup     func(todo *entities.Todo)
+		mockSetup     func()
 		expectedError error
 	}{
 		{
@@ -37,12 +76,77 @@ func TestCreateTodo(t *testing.T) {
 			priority: 0,
 			dueDate:  nil,
 			boardId:  1,
-			mockSetup: func(todo *entities.Todo) {
-				mockRepository.EXPECT().Create(todo).
-					Return(nil)
+			mockSetup: func() {
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
+				mockRepository.EXPECT().GetLastRank(gomock.Any(), 1).
+					Return("", nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.Todo{
+					BoardId:  1,
+					Title:    "Test title",
+					StatusId: 1,
+					Rank:     "1",
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Success to create done todo in the first done status",
+			title:    "Test title",
+			done:     true,
+			priority: 0,
+			dueDate:  nil,
+			boardId:  1,
+			mockSetup: func() {
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
+				mockRepository.EXPECT().GetLastRank(gomock.Any(), 1).
+					Return("V", nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.Todo{
+					BoardId:     1,
+					Title:       "Test title",
+					StatusId:    3,
+					Done:        true,
+					Rank:        "W",
+					CompletedAt: &testNow,
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Success to create todo in an explicit status",
+			title:    "Test title",
+			statusId: &doingStatusId,
+			priority: 0,
+			dueDate:  nil,
+			boardId:  1,
+			mockSetup: func() {
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
+				mockRepository.EXPECT().GetLastRank(gomock.Any(), 1).
+					Return("z", nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.Todo{
+					BoardId:  1,
+					Title:    "Test title",
+					StatusId: 2,
+					Rank:     "z1",
+				}).Return(nil)
 			},
 			expectedError: nil,
 		},
+		{
+			name:     "Failed to create todo - Due to status of another room",
+			title:    "Test title",
+			statusId: &otherRoomStatusId,
+			priority: 0,
+			dueDate:  nil,
+			boardId:  1,
+			mockSetup: func() {
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
+			},
+			expectedError: entities.ErrInvalidStatus,
+		},
 		{
 			name:          "Failed to create todo - Due to number of characters in the title is more than 50",
 			title:         strings.Repeat("a", 51),
@@ -50,7 +154,7 @@ func TestCreateTodo(t *testing.T) {
 			priority:      0,
 			dueDate:       nil,
 			boardId:       1,
-			mockSetup:     func(todo *entities.Todo) {},
+			mockSetup:     func() {},
 			expectedError: errors.New("Invalid title"),
 		},
 		{
@@ -60,7 +164,7 @@ func TestCreateTodo(t *testing.T) {
 			priority:      0,
 			dueDate:       nil,
 			boardId:       1,
-			mockSetup:     func(todo *entities.Todo) {},
+			mockSetup:     func() {},
 			expectedError: errors.New("Invalid title"),
 		},
 		{
@@ -70,23 +174,58 @@ func TestCreateTodo(t *testing.T) {
 			priority:      -1,
 			dueDate:       nil,
 			boardId:       1,
-			mockSetup:     func(todo *entities.Todo) {},
+			mockSetup:     func() {},
 			expectedError: errors.New("Invalid priority size"),
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			todo := &entities.Todo{
-				BoardId:  tc.boardId,
-				Title:    tc.title,
-				Done:     tc.done,
-				Priority: tc.priority,
-				DueDate:  tc.dueDate,
+			tc.mockSetup()
+
+			todo, err := service.Create(context.Background(), tc.boardId, tc.title, tc.done, tc.statusId, tc.priority, tc.dueDate, nil)
+
+			assert.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.title, todo.Title)
+				assert.Equal(t, tc.boardId, todo.BoardId)
 			}
-			tc.mockSetup(todo)
+		})
+	}
+}
 
-			err := service.Create(tc.boardId, tc.title, tc.done, tc.priority, tc.dueDate)
+func TestCreateTodoInArchivedBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockTodoRepository(ctrl)
+	mockStatusRepository := mock_repository.NewMockStatusRepository(ctrl)
+	mockBoardRepository := mock_repository.NewMockBoardRepository(ctrl)
+	uow := &fakeUnitOfWork{repos: &interfaces.Repositories{Todos: mockRepository, Statuses: mockStatusRepository, Boards: mockBoardRepository}}
+	service := NewTodoService(mockRepository, nil, uow, config.Todo{})
+
+	testCases := []struct {
+		name          string
+		board         *entities.Board
+		expectedError error
+	}{
+		{
+			name:          "Failed to create todo - Due to the board is archived",
+			board:         &entities.Board{Id: 1, ArchivedAt: &testNow},
+			expectedError: entities.ErrArchived,
+		},
+		{
+			name:          "Failed to create todo - Due to the room is archived",
+			board:         &entities.Board{Id: 1, RoomArchived: true},
+			expectedError: entities.ErrArchived,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockBoardRepository.EXPECT().GetById(gomock.Any(), 1).Return(tc.board, nil)
+
+			_, err := service.Create(context.Background(), 1, "title", false, nil, 0, nil, nil)
 
 			assert.Equal(t, tc.expectedError, err)
 		})
@@ -97,17 +236,21 @@ func TestUpdateTodo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockRepository := mock_repository.NewMockTodoRepository(ctrl)
-	service := NewTodoService(mockRepository)
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{})
+
+	earlier := time.Date(2025, 6, 27, 9, 0, 0, 0, time.UTC)
+	doneStatusId := 3
+	otherRoomStatusId := 99
 
 	testCases := []struct {
 		name          string
 		id            int
 		title         string
 		done          bool
+		statusId      *int
 		priority      int
 		dueDate       *time.Time
-		mockSetup     func(todo *entities.Todo)
+		mockSetup     func()
 		expectedError error
 	}{
 		{
@@ -117,14 +260,130 @@ func TestUpdateTodo(t *testing.T) {
 			done:     false,
 			priority: 0,
 			dueDate:  nil,
-			mockSetup: func(todo *entities.Todo) {
-				mockRepository.EXPECT().GetById(todo.Id).
-					Return(&entities.Todo{Id: todo.Id}, nil)
-				mockRepository.EXPECT().Update(todo).
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 1}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{Id: 1, BoardId: 1, Title: "Test title", StatusId: 1}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Success to keep in-progress status while done is unchanged",
+			id:       1,
+			title:    "Test title",
+			done:     false,
+			priority: 0,
+			dueDate:  nil,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 2}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{Id: 1, BoardId: 1, Title: "Test title", StatusId: 2}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Success to complete todo via done flag",
+			id:       1,
+			title:    "Test title",
+			done:     true,
+			priority: 0,
+			dueDate:  nil,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 2}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{
+					Id:          1,
+					BoardId:     1,
+					Title:       "Test title",
+					StatusId:    3,
+					Done:        true,
+					CompletedAt: &testNow,
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Success to complete todo via status",
+			id:       1,
+			title:    "Test title",
+			statusId: &doneStatusId,
+			priority: 0,
+			dueDate:  nil,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 1}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{
+					Id:          1,
+					BoardId:     1,
+					Title:       "Test title",
+					StatusId:    3,
+					Done:        true,
+					CompletedAt: &testNow,
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Success to reopen todo and clear completed_at",
+			id:       1,
+			title:    "Test title",
+			done:     false,
+			priority: 0,
+			dueDate:  nil,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 3, Done: true, CompletedAt: &earlier}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), &entities.Todo{Id: 1, BoardId: 1, Title: "Test title", StatusId: 1}).
 					Return(nil)
 			},
 			expectedError: nil,
 		},
+		{
+			name:     "Failed to update todo - Due to status of another room",
+			id:       1,
+			title:    "Test title",
+			statusId: &otherRoomStatusId,
+			priority: 0,
+			dueDate:  nil,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 1}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
+			},
+			expectedError: entities.ErrInvalidStatus,
+		},
+		{
+			name:     "Failed to update todo - Due to transition not allowed by the workflow",
+			id:       1,
+			title:    "Test title",
+			statusId: &doneStatusId,
+			priority: 0,
+			dueDate:  nil,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 1}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return([]*entities.Status{
+						{Id: 1, RoomId: 1, Name: "To Do", Category: entities.StatusCategoryTodo, Transitions: []int{2}},
+						roomStatuses[1],
+						roomStatuses[2],
+					}, nil)
+			},
+			expectedError: entities.ErrTransitionNotAllowed,
+		},
 		{
 			name:     "Failed to update todo - Due to the todo not found",
 			id:       999,
@@ -132,8 +391,8 @@ func TestUpdateTodo(t *testing.T) {
 			done:     false,
 			priority: 0,
 			dueDate:  nil,
-			mockSetup: func(todo *entities.Todo) {
-				mockRepository.EXPECT().GetById(todo.Id).
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 999).
 					Return(nil, sql.ErrNoRows)
 			},
 			expectedError: sql.ErrNoRows,
@@ -145,9 +404,11 @@ func TestUpdateTodo(t *testing.T) {
 			done:     false,
 			priority: 0,
 			dueDate:  nil,
-			mockSetup: func(todo *entities.Todo) {
-				mockRepository.EXPECT().GetById(todo.Id).
-					Return(&entities.Todo{Id: todo.Id}, nil)
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 1}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
 			},
 			expectedError: errors.New("Invalid title"),
 		},
@@ -158,9 +419,11 @@ func TestUpdateTodo(t *testing.T) {
 			done:     false,
 			priority: 0,
 			dueDate:  nil,
-			mockSetup: func(todo *entities.Todo) {
-				mockRepository.EXPECT().GetById(todo.Id).
-					Return(&entities.Todo{Id: todo.Id}, nil)
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 1}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
 			},
 			expectedError: errors.New("Invalid title"),
 		},
@@ -171,9 +434,11 @@ func TestUpdateTodo(t *testing.T) {
 			done:     false,
 			priority: -1,
 			dueDate:  nil,
-			mockSetup: func(todo *entities.Todo) {
-				mockRepository.EXPECT().GetById(todo.Id).
-					Return(&entities.Todo{Id: todo.Id}, nil)
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 1}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).
+					Return(roomStatuses, nil)
 			},
 			expectedError: errors.New("Invalid priority size"),
 		},
@@ -181,16 +446,9 @@ func TestUpdateTodo(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			updatedTodo := &entities.Todo{
-				Id:       tc.id,
-				Title:    tc.title,
-				Done:     tc.done,
-				Priority: tc.priority,
-				DueDate:  tc.dueDate,
-			}
-			tc.mockSetup(updatedTodo)
+			tc.mockSetup()
 
-			err := service.Update(tc.id, tc.title, tc.done, tc.priority, tc.dueDate)
+			err := service.Update(context.Background(), tc.id, tc.title, tc.done, tc.statusId, tc.priority, tc.dueDate, nil)
 
 			assert.Equal(t, tc.expectedError, err)
 		})
@@ -201,8 +459,7 @@ func TestDeleteTodo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockRepository := mock_repository.NewMockTodoRepository(ctrl)
-	service := NewTodoService(mockRepository)
+	service, mockRepository, _ := newTestTodoService(ctrl, config.Todo{})
 
 	testCases := []struct {
 		name          string
@@ -214,9 +471,9 @@ func TestDeleteTodo(t *testing.T) {
 			name: "Success to delete todo",
 			id:   1,
 			mockSetup: func() {
-				mockRepository.EXPECT().GetById(1).
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
 					Return(&entities.Todo{}, nil)
-				mockRepository.EXPECT().Delete(1).
+				mockRepository.EXPECT().Delete(gomock.Any(), 1).
 					Return(nil)
 			},
 			expectedError: nil,
@@ -225,7 +482,7 @@ func TestDeleteTodo(t *testing.T) {
 			name: "Failed to delete todo - Due to the todo not found",
 			id:   999,
 			mockSetup: func() {
-				mockRepository.EXPECT().GetById(999).
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 999).
 					Return(nil, sql.ErrNoRows)
 			},
 			expectedError: sql.ErrNoRows,
@@ -236,9 +493,549 @@ func TestDeleteTodo(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.mockSetup()
 
-			err := service.Delete(tc.id)
+			err := service.Delete(context.Background(), tc.id)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestUpdateTodoSpawnsNextOccurrenceOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{})
+
+	dueDate := testNow
+	stored := &entities.Todo{Id: 1, BoardId: 1, Title: "chore", StatusId: 1, DueDate: &dueDate, Recurrence: &entities.Recurrence{Rule: "FREQ=WEEKLY"}}
+	var spawned []*entities.Todo
+
+	mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil).AnyTimes()
+	mockRepository.EXPECT().GetLastRank(gomock.Any(), 1).Return("V", nil).AnyTimes()
+	mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).
+		DoAndReturn(func(ctx context.Context, id int) (*entities.Todo, error) {
+			todo := *stored
+			return &todo, nil
+		}).AnyTimes()
+	mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, todo *entities.Todo) error {
+			saved := *todo
+			stored = &saved
+			return nil
+		}).AnyTimes()
+	mockRepository.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, todo *entities.Todo) error {
+			spawned = append(spawned, todo)
+			return nil
+		}).AnyTimes()
+
+	// Clients send back the recurrence they read, as sync does.
+	for _, done := range []bool{true, false, true} {
+		err := service.Update(context.Background(), 1, "chore", done, nil, 0, &dueDate, stored.Recurrence)
+		assert.NoError(t, err)
+	}
+
+	assert.Len(t, spawned, 1)
+	assert.Nil(t, stored.Recurrence)
+	assert.Equal(t, &entities.Recurrence{Rule: "FREQ=WEEKLY"}, spawned[0].Recurrence)
+}
+
+func TestUpdateTodoWithRequireBlockersDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{RequireBlockersDone: true})
+
+	testCases := []struct {
+		name          string
+		savedTodo     *entities.Todo
+		done          bool
+		mockSetup     func(todo *entities.Todo)
+		expectedError error
+	}{
+		{
+			name:      "Success to complete todo without open blockers",
+			savedTodo: &entities.Todo{Id: 1, BoardId: 1, Title: "Test title", StatusId: 1, Done: false, Blocked: false},
+			done:      true,
+			mockSetup: func(todo *entities.Todo) {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(todo, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), todo).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "Success to edit blocked todo without completing it",
+			savedTodo: &entities.Todo{Id: 1, BoardId: 1, Title: "Test title", StatusId: 1, Done: false, Blocked: true},
+			done:      false,
+			mockSetup: func(todo *entities.Todo) {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(todo, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), todo).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "Failed to complete todo - Due to open blockers",
+			savedTodo: &entities.Todo{Id: 1, BoardId: 1, Title: "Test title", StatusId: 1, Done: false, Blocked: true},
+			done:      true,
+			mockSetup: func(todo *entities.Todo) {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(todo, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil)
+			},
+			expectedError: entities.ErrTodoBlocked,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(tc.savedTodo)
+
+			err := service.Update(context.Background(), 1, "Test title", tc.done, nil, 0, nil, nil)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestUpdateRecurringTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{})
+
+	dueDate := time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC)
+	nextDueDate := time.Date(2025, 6, 21, 0, 0, 0, 0, time.UTC)
+	recurrence := &entities.Recurrence{Rule: "FREQ=WEEKLY"}
+
+	testCases := []struct {
+		name          string
+		savedTodo     *entities.Todo
+		done          bool
+		mockSetup     func(todo *entities.Todo)
+		expectedError error
+	}{
+		{
+			name:      "Spawns next occurrence when recurring todo is completed",
+			savedTodo: &entities.Todo{Id: 1, BoardId: 1, Title: "chore", StatusId: 2, Done: false, DueDate: &dueDate, Recurrence: recurrence},
+			done:      true,
+			mockSetup: func(todo *entities.Todo) {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(todo, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), todo).Return(nil)
+				mockRepository.EXPECT().GetLastRank(gomock.Any(), 1).Return("V", nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.Todo{
+					BoardId:    1,
+					Title:      "chore",
+					StatusId:   1,
+					Done:       false,
+					Rank:       "W",
+					DueDate:    &nextDueDate,
+					Recurrence: &entities.Recurrence{Rule: "FREQ=WEEKLY"},
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "Does not spawn when recurring todo was already done",
+			savedTodo: &entities.Todo{Id: 1, BoardId: 1, Title: "chore", StatusId: 3, Done: true, DueDate: &dueDate, Recurrence: recurrence},
+			done:      true,
+			mockSetup: func(todo *entities.Todo) {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(todo, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), todo).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "Does not spawn when todo stays open",
+			savedTodo: &entities.Todo{Id: 1, BoardId: 1, Title: "chore", StatusId: 1, Done: false, DueDate: &dueDate, Recurrence: recurrence},
+			done:      false,
+			mockSetup: func(todo *entities.Todo) {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(todo, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), todo).Return(nil)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup(tc.savedTodo)
+
+			err := service.Update(context.Background(), 1, "chore", tc.done, nil, 0, &dueDate, recurrence)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestMoveTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, _ := newTestTodoService(ctrl, config.Todo{RankMaxLength: 3})
+
+	first := &entities.Todo{Id: 1, BoardId: 1, Rank: "A"}
+	second := &entities.Todo{Id: 2, BoardId: 1, Rank: "B"}
+	moved := &entities.Todo{Id: 3, BoardId: 1, Rank: "C"}
+	deep := &entities.Todo{Id: 4, BoardId: 1, Rank: "B001"}
+	otherBoard := &entities.Todo{Id: 5, BoardId: 2, Rank: "A"}
+
+	firstId, secondId, deepId, otherBoardId, missingId := 1, 2, 4, 5, 999
+
+	testCases := []struct {
+		name          string
+		beforeId      *int
+		afterId       *int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:     "Success to move todo between two todos",
+			beforeId: &secondId,
+			afterId:  &firstId,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 3).Return(moved, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(first, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(second, nil)
+				mockRepository.EXPECT().UpdateRank(gomock.Any(), 3, "AV").Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Success to move todo to the top",
+			beforeId: &firstId,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 3).Return(moved, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(first, nil)
+				mockRepository.EXPECT().GetPrevRank(gomock.Any(), 1, "A", 3).Return("", nil)
+				mockRepository.EXPECT().UpdateRank(gomock.Any(), 3, "5").Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "Success to move todo after another",
+			afterId: &firstId,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 3).Return(moved, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(first, nil)
+				mockRepository.EXPECT().GetNextRank(gomock.Any(), 1, "A", 3).Return("B", nil)
+				mockRepository.EXPECT().UpdateRank(gomock.Any(), 3, "AV").Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "Rebalances the board when the rank grows too long",
+			afterId: &deepId,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 3).Return(moved, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 4).Return(deep, nil)
+				mockRepository.EXPECT().GetNextRank(gomock.Any(), 1, "B001", 3).Return("B002", nil)
+				mockRepository.EXPECT().UpdateRank(gomock.Any(), 3, "B001V").Return(nil)
+				mockRepository.EXPECT().Rebalance(gomock.Any(), 1).Return(nil)
+				mockRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return([]*entities.Todo{moved, deep}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to move todo - Due to no neighbour",
+			mockSetup:     func() {},
+			expectedError: entities.ErrInvalidRank,
+		},
+		{
+			name:     "Failed to move todo - Due to neighbours in the wrong order",
+			beforeId: &firstId,
+			afterId:  &secondId,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 3).Return(moved, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(second, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(first, nil)
+			},
+			expectedError: entities.ErrInvalidRank,
+		},
+		{
+			name:     "Failed to move todo - Due to neighbour in another board",
+			beforeId: &otherBoardId,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 3).Return(moved, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 5).Return(otherBoard, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:     "Failed to move todo - Due to not exist neighbour",
+			beforeId: &missingId,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 3).Return(moved, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 999).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.Move(context.Background(), 1, 3, tc.beforeId, tc.afterId)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestMoveToBoardTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{})
+
+	otherRoomStatuses := []*entities.Status{
+		{Id: 11, RoomId: 2, Name: "Backlog", Category: entities.StatusCategoryTodo},
+		{Id: 12, RoomId: 2, Name: "Review", Category: entities.StatusCategoryDoing},
+		{Id: 13, RoomId: 2, Name: "In Progress", Category: entities.StatusCategoryDoing},
+		{Id: 14, RoomId: 2, Name: "Shipped", Category: entities.StatusCategoryDone},
+	}
+	noDoingStatuses := []*entities.Status{
+		{Id: 21, RoomId: 3, Name: "Open", Category: entities.StatusCategoryTodo},
+		{Id: 22, RoomId: 3, Name: "Closed", Category: entities.StatusCategoryDone},
+	}
+
+	newTodo := func() *entities.Todo {
+		return &entities.Todo{Id: 1, BoardId: 1, Title: "moved", StatusId: 2, Rank: "V"}
+	}
+
+	testCases := []struct {
+		name          string
+		targetBoardId int
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:          "Success to move todo within the room",
+			targetBoardId: 2,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(newTodo(), nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 2).Return(roomStatuses, nil)
+				mockStatusRepository.EXPECT().GetById(gomock.Any(), 2).Return(roomStatuses[1], nil)
+				mockRepository.EXPECT().GetLastRank(gomock.Any(), 2).Return("A", nil)
+				mockRepository.EXPECT().MoveToBoard(gomock.Any(), &entities.Todo{Id: 1, BoardId: 2, Title: "moved", StatusId: 2, Rank: "B"}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Success to move todo to a status with the same name in another room",
+			targetBoardId: 3,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(newTodo(), nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 3).Return(otherRoomStatuses, nil)
+				mockStatusRepository.EXPECT().GetById(gomock.Any(), 2).Return(roomStatuses[1], nil)
+				mockRepository.EXPECT().GetLastRank(gomock.Any(), 3).Return("", nil)
+				mockRepository.EXPECT().MoveToBoard(gomock.Any(), &entities.Todo{Id: 1, BoardId: 3, Title: "moved", StatusId: 13, Rank: "1"}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Success to move todo to the first todo status when the category is missing",
+			targetBoardId: 4,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(newTodo(), nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 4).Return(noDoingStatuses, nil)
+				mockStatusRepository.EXPECT().GetById(gomock.Any(), 2).Return(roomStatuses[1], nil)
+				mockRepository.EXPECT().GetLastRank(gomock.Any(), 4).Return("", nil)
+				mockRepository.EXPECT().MoveToBoard(gomock.Any(), &entities.Todo{Id: 1, BoardId: 4, Title: "moved", StatusId: 21, Rank: "1"}).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "No change when moving todo to its own board",
+			targetBoardId: 1,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(newTodo(), nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to move todo - Due to not exist target board",
+			targetBoardId: 999,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(newTodo(), nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 999).Return(nil, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name:          "Failed to move todo - Due to todo in another board",
+			targetBoardId: 2,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 5}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.MoveToBoard(context.Background(), 1, 1, tc.targetBoardId)
 
 			assert.Equal(t, tc.expectedError, err)
 		})
 	}
 }
+
+func TestCopyToBoardTodo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{})
+
+	completedAt := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	otherRoomStatuses := []*entities.Status{
+		{Id: 11, RoomId: 2, Name: "Backlog", Category: entities.StatusCategoryTodo},
+		{Id: 14, RoomId: 2, Name: "Shipped", Category: entities.StatusCategoryDone},
+	}
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+		expectedData  *entities.Todo
+	}{
+		{
+			name: "Success to copy done todo to another room",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).
+					Return(&entities.Todo{Id: 1, BoardId: 1, Title: "copied", StatusId: 3, Done: true, Rank: "V", CompletedAt: &completedAt}, nil)
+				mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 3).Return(otherRoomStatuses, nil)
+				mockStatusRepository.EXPECT().GetById(gomock.Any(), 3).Return(roomStatuses[2], nil)
+				mockRepository.EXPECT().GetLastRank(gomock.Any(), 3).Return("A", nil)
+				mockRepository.EXPECT().Copy(gomock.Any(), 1, &entities.Todo{BoardId: 3, Title: "copied", StatusId: 14, Done: true, Rank: "B", CompletedAt: &completedAt}).
+					DoAndReturn(func(ctx context.Context, sourceId int, todo *entities.Todo) error {
+						todo.Id = 7
+						return nil
+					})
+				mockRepository.EXPECT().GetById(gomock.Any(), 7).
+					Return(&entities.Todo{Id: 7, BoardId: 3, Title: "copied", StatusId: 14, Done: true, Rank: "B"}, nil)
+			},
+			expectedError: nil,
+			expectedData:  &entities.Todo{Id: 7, BoardId: 3, Title: "copied", StatusId: 14, Done: true, Rank: "B"},
+		},
+		{
+			name: "Failed to copy todo - Due to not exist todo",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+			expectedData:  nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			todo, err := service.CopyToBoard(context.Background(), 1, 1, 3)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedData, todo)
+		})
+	}
+}
+
+func TestTodoServicePublishes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockStatusRepository := newTestTodoService(ctrl, config.Todo{})
+	mockBoardRepository := mock_repository.NewMockBoardRepository(ctrl)
+	mockBoardRepository.EXPECT().GetById(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id int) (*entities.Board, error) {
+			// boards 1 and 2 belong to room 10, board 3 to room 30
+			return &entities.Board{Id: id, RoomId: map[int]int{1: 10, 2: 10, 3: 30}[id]}, nil
+		}).AnyTimes()
+	mockOutboxRepository := mock_repository.NewMockOutboxRepository(ctrl)
+	mockWebhookRepository := mock_repository.NewMockWebhookRepository(ctrl)
+	repos := service.uow.(*fakeUnitOfWork).repos
+	repos.Boards = mockBoardRepository
+	repos.Outbox = mockOutboxRepository
+	repos.Webhooks = mockWebhookRepository
+
+	// outboxEvent matches an event stored for the room, with the todo's snapshot when given.
+	outboxEvent := func(roomId int, eventType string, todo *entities.Todo) gomock.Matcher {
+		return gomock.Cond(func(event *entities.OutboxEvent) bool {
+			if event.RoomId != roomId || event.Type != eventType {
+				return false
+			}
+			if todo == nil {
+				return true
+			}
+			restored, err := event.Event()
+			return err == nil && assert.ObjectsAreEqual(todo, restored.Data)
+		})
+	}
+
+	t.Run("Success to publish an update to the room of the todo", func(t *testing.T) {
+		mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 1}, nil)
+		mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 1).Return(roomStatuses, nil)
+		mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockOutboxRepository.EXPECT().Create(gomock.Any(), outboxEvent(10, entities.EventTodoUpdated, &entities.Todo{Id: 1, BoardId: 1, Title: "renamed", StatusId: 1})).Return(nil)
+		mockWebhookRepository.EXPECT().Enqueue(gomock.Any(), outboxEvent(10, entities.EventTodoUpdated, nil)).Return(nil)
+
+		err := service.Update(context.Background(), 1, "renamed", false, nil, 0, nil, nil)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success to publish a move to both rooms", func(t *testing.T) {
+		mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1, StatusId: 1}, nil)
+		mockStatusRepository.EXPECT().GetAllByBoardId(gomock.Any(), 3).Return(roomStatuses, nil)
+		mockStatusRepository.EXPECT().GetById(gomock.Any(), 1).Return(roomStatuses[0], nil)
+		mockRepository.EXPECT().GetLastRank(gomock.Any(), 3).Return("", nil)
+		mockRepository.EXPECT().MoveToBoard(gomock.Any(), gomock.Any()).Return(nil)
+		mockOutboxRepository.EXPECT().Create(gomock.Any(), outboxEvent(30, entities.EventTodoMoved, nil)).Return(nil)
+		mockOutboxRepository.EXPECT().Create(gomock.Any(), outboxEvent(10, entities.EventTodoMoved, nil)).Return(nil)
+		mockWebhookRepository.EXPECT().Enqueue(gomock.Any(), outboxEvent(30, entities.EventTodoMoved, nil)).Return(nil)
+		mockWebhookRepository.EXPECT().Enqueue(gomock.Any(), outboxEvent(10, entities.EventTodoMoved, nil)).Return(nil)
+
+		err := service.MoveToBoard(context.Background(), 1, 1, 3)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failed to change todo - Due to the outbox rejecting the event", func(t *testing.T) {
+		mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1}, nil)
+		mockRepository.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+		mockOutboxRepository.EXPECT().Create(gomock.Any(), outboxEvent(10, entities.EventTodoDeleted, nil)).Return(errors.New("db error"))
+
+		err := service.Delete(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Failed to change todo - Due to the webhook deliveries not being queued", func(t *testing.T) {
+		mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1}, nil)
+		mockRepository.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+		mockOutboxRepository.EXPECT().Create(gomock.Any(), outboxEvent(10, entities.EventTodoDeleted, nil)).Return(nil)
+		mockWebhookRepository.EXPECT().Enqueue(gomock.Any(), outboxEvent(10, entities.EventTodoDeleted, nil)).Return(errors.New("db error"))
+
+		err := service.Delete(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("No event when the change fails", func(t *testing.T) {
+		mockRepository.EXPECT().GetByIdForUpdate(gomock.Any(), 1).Return(&entities.Todo{Id: 1, BoardId: 1}, nil)
+		mockRepository.EXPECT().Delete(gomock.Any(), 1).Return(errors.New("db error"))
+
+		err := service.Delete(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+}
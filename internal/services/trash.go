@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type TrashService struct {
+	repo interfaces.TrashRepository
+	uow  interfaces.UnitOfWork
+}
+
+func NewTrashService(repo interfaces.TrashRepository, uow interfaces.UnitOfWork) *TrashService {
+	return &TrashService{
+		repo: repo,
+		uow:  uow,
+	}
+}
+
+func (ts *TrashService) GetAll(ctx context.Context) (*entities.Trash, error) {
+	return ts.repo.GetAll(ctx)
+}
+
+// RestoreRoom publishes the todos that come back with the room as created, since subscribers
+// were told they were deleted.
+func (ts *TrashService) RestoreRoom(ctx context.Context, id int) error {
+	room, err := ts.repo.GetRoomById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Trash.RestoreRoom(ctx, id); err != nil {
+			return err
+		}
+
+		boards, err := repos.Boards.GetAllByRoomId(ctx, id, true)
+		if err != nil {
+			return err
+		}
+		for _, board := range boards {
+			if err := publishRestored(ctx, repos, board.Id); err != nil {
+				return err
+			}
+		}
+
+		restored := *room
+		restored.DeletedAt = nil
+		return audit(ctx, repos.Audits, entities.AuditEntityRoom, id, entities.AuditActionRestore, room, &restored)
+	})
+}
+
+// RestoreBoard requires the board's room to be restored first. Like RestoreRoom, it publishes
+// the todos that come back with the board.
+func (ts *TrashService) RestoreBoard(ctx context.Context, id int) error {
+	board, err := ts.repo.GetBoardById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = ts.repo.GetRoomById(ctx, board.RoomId)
+	if err := parentRestored(err); err != nil {
+		return err
+	}
+
+	return ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Trash.RestoreBoard(ctx, id); err != nil {
+			return err
+		}
+		if err := publishRestored(ctx, repos, id); err != nil {
+			return err
+		}
+
+		restored := *board
+		restored.DeletedAt = nil
+		return audit(ctx, repos.Audits, entities.AuditEntityBoard, id, entities.AuditActionRestore, board, &restored)
+	})
+}
+
+// RestoreTodo requires the todo's board to be restored first.
+func (ts *TrashService) RestoreTodo(ctx context.Context, id int) error {
+	todo, err := ts.repo.GetTodoById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = ts.repo.GetBoardById(ctx, todo.BoardId)
+	if err := parentRestored(err); err != nil {
+		return err
+	}
+
+	return ts.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Trash.RestoreTodo(ctx, id); err != nil {
+			return err
+		}
+
+		live, err := repos.Todos.GetById(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := publish(ctx, repos, entities.EventTodoCreated, live); err != nil {
+			return err
+		}
+
+		restored := *todo
+		restored.DeletedAt = nil
+		return audit(ctx, repos.Audits, entities.AuditEntityTodo, id, entities.AuditActionRestore, todo, &restored)
+	})
+}
+
+// publishRestored publishes the todos of a board that was just restored. The board's live
+// todos are the ones that came back with it, as those trashed on their own stay in the trash.
+func publishRestored(ctx context.Context, repos *interfaces.Repositories, boardId int) error {
+	todos, err := repos.Todos.GetAllByBoardId(ctx, boardId)
+	if err != nil {
+		return err
+	}
+	for _, todo := range todos {
+		if err := publish(ctx, repos, entities.EventTodoCreated, todo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parentRestored maps the error of looking a parent up in the trash: finding it there
+// means the child cannot be restored yet.
+func parentRestored(err error) error {
+	if err == nil {
+		return entities.ErrTrashParentDeleted
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	return err
+}
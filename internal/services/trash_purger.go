@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type TrashPurger struct {
+	repo interfaces.TrashRepository
+	cfg  config.Trash
+	now  func() time.Time
+}
+
+func NewTrashPurger(repo interfaces.TrashRepository, cfg config.Trash) *TrashPurger {
+	return &TrashPurger{
+		repo: repo,
+		cfg:  cfg,
+		now:  time.Now,
+	}
+}
+
+// Run purges expired trash every purge interval until ctx is canceled.
+func (tp *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(tp.cfg.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := tp.Purge(ctx); err != nil {
+			log.Printf("failed to purge trash: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge permanently deletes what has been in the trash longer than the retention period.
+func (tp *TrashPurger) Purge(ctx context.Context) error {
+	purged, err := tp.repo.Purge(ctx, tp.now().UTC().Add(-tp.cfg.Retention))
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		log.Printf("purged %d items from the trash", purged)
+	}
+
+	return nil
+}
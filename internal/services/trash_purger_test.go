@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPurgeTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockTrashRepository(ctrl)
+
+	now := time.Date(2025, 7, 31, 9, 0, 0, 0, time.UTC)
+	purger := NewTrashPurger(mockRepository, config.Trash{Retention: 30 * 24 * time.Hour})
+	purger.now = func() time.Time { return now }
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to purge what was trashed before the retention period",
+			mockSetup: func() {
+				mockRepository.EXPECT().Purge(gomock.Any(), time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)).Return(3, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to purge - Due to unexpected errors",
+			mockSetup: func() {
+				mockRepository.EXPECT().Purge(gomock.Any(), gomock.Any()).Return(0, errors.New("unexpected error"))
+			},
+			expectedError: errors.New("unexpected error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := purger.Purge(context.Background())
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestTrashPurgerStopsOnCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockTrashRepository(ctrl)
+	mockRepository.EXPECT().Purge(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+
+	purger := NewTrashPurger(mockRepository, config.Trash{PurgeInterval: time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		purger.Run(ctx)
+		close(done)
+	}()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("purger did not stop after cancel")
+	}
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// newRestoreMocks returns the repositories restores publish through. Boards are found in
+// room 1.
+func newRestoreMocks(ctrl *gomock.Controller) (*mock_repository.MockTodoRepository, *mock_repository.MockBoardRepository, *mock_repository.MockOutboxRepository) {
+	mockBoardRepository := mock_repository.NewMockBoardRepository(ctrl)
+	mockBoardRepository.EXPECT().GetById(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id int) (*entities.Board, error) {
+			return &entities.Board{Id: id, RoomId: 1}, nil
+		}).AnyTimes()
+
+	return mock_repository.NewMockTodoRepository(ctrl), mockBoardRepository, mock_repository.NewMockOutboxRepository(ctrl)
+}
+
+func newEnqueueMock(ctrl *gomock.Controller) *mock_repository.MockWebhookRepository {
+	mockWebhookRepository := mock_repository.NewMockWebhookRepository(ctrl)
+	mockWebhookRepository.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return mockWebhookRepository
+}
+
+// expectCreatedEvents expects one todo.created event in room 1 for each of todos.
+func expectCreatedEvents(mockOutboxRepository *mock_repository.MockOutboxRepository, todos ...*entities.Todo) {
+	for _, todo := range todos {
+		mockOutboxRepository.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, event *entities.OutboxEvent) error {
+				expected, err := entities.NewOutboxEvent(1, entities.EventTodoCreated, todo)
+				if err != nil {
+					return err
+				}
+				if event.RoomId != expected.RoomId || event.Type != expected.Type || string(event.Payload) != string(expected.Payload) {
+					return errors.New("unexpected event")
+				}
+
+				return nil
+			})
+	}
+}
+
+func TestRestoreRoomTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockTrashRepository(ctrl)
+	mockTodoRepository, mockBoardRepository, mockOutboxRepository := newRestoreMocks(ctrl)
+	service := NewTrashService(mockRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Trash: mockRepository, Todos: mockTodoRepository, Boards: mockBoardRepository, Outbox: mockOutboxRepository, Webhooks: newEnqueueMock(ctrl)}))
+
+	deletedAt := time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to restore room",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetRoomById(gomock.Any(), 1).Return(&entities.Room{Id: 1, DeletedAt: &deletedAt}, nil)
+				mockRepository.EXPECT().RestoreRoom(gomock.Any(), 1).Return(nil)
+				mockBoardRepository.EXPECT().GetAllByRoomId(gomock.Any(), 1, true).Return([]*entities.Board{{Id: 2, RoomId: 1}, {Id: 4, RoomId: 1}}, nil)
+				mockTodoRepository.EXPECT().GetAllByBoardId(gomock.Any(), 2).Return([]*entities.Todo{{Id: 3, BoardId: 2}}, nil)
+				mockTodoRepository.EXPECT().GetAllByBoardId(gomock.Any(), 4).Return(nil, nil)
+				expectCreatedEvents(mockOutboxRepository, &entities.Todo{Id: 3, BoardId: 2})
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to restore room - Due to the room is not in the trash",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetRoomById(gomock.Any(), 1).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.RestoreRoom(context.Background(), 1)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestRestoreBoardTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockTrashRepository(ctrl)
+	mockTodoRepository, mockBoardRepository, mockOutboxRepository := newRestoreMocks(ctrl)
+	service := NewTrashService(mockRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Trash: mockRepository, Todos: mockTodoRepository, Boards: mockBoardRepository, Outbox: mockOutboxRepository, Webhooks: newEnqueueMock(ctrl)}))
+
+	deletedAt := time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC)
+	board := &entities.Board{Id: 2, RoomId: 1, DeletedAt: &deletedAt}
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to restore board",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetBoardById(gomock.Any(), 2).Return(board, nil)
+				mockRepository.EXPECT().GetRoomById(gomock.Any(), 1).Return(nil, sql.ErrNoRows)
+				mockRepository.EXPECT().RestoreBoard(gomock.Any(), 2).Return(nil)
+				mockTodoRepository.EXPECT().GetAllByBoardId(gomock.Any(), 2).Return([]*entities.Todo{{Id: 3, BoardId: 2}, {Id: 5, BoardId: 2}}, nil)
+				expectCreatedEvents(mockOutboxRepository, &entities.Todo{Id: 3, BoardId: 2}, &entities.Todo{Id: 5, BoardId: 2})
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to restore board - Due to the board is not in the trash",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetBoardById(gomock.Any(), 2).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name: "Failed to restore board - Due to the room is in the trash",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetBoardById(gomock.Any(), 2).Return(board, nil)
+				mockRepository.EXPECT().GetRoomById(gomock.Any(), 1).Return(&entities.Room{Id: 1, DeletedAt: &deletedAt}, nil)
+			},
+			expectedError: entities.ErrTrashParentDeleted,
+		},
+		{
+			name: "Failed to restore board - Due to unexpected errors looking up the room",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetBoardById(gomock.Any(), 2).Return(board, nil)
+				mockRepository.EXPECT().GetRoomById(gomock.Any(), 1).Return(nil, errors.New("unexpected error"))
+			},
+			expectedError: errors.New("unexpected error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.RestoreBoard(context.Background(), 2)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestRestoreTodoTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockTrashRepository(ctrl)
+	mockTodoRepository, mockBoardRepository, mockOutboxRepository := newRestoreMocks(ctrl)
+	service := NewTrashService(mockRepository, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Trash: mockRepository, Todos: mockTodoRepository, Boards: mockBoardRepository, Outbox: mockOutboxRepository, Webhooks: newEnqueueMock(ctrl)}))
+
+	deletedAt := time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC)
+	todo := &entities.Todo{Id: 3, BoardId: 2, DeletedAt: &deletedAt}
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to restore todo",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetTodoById(gomock.Any(), 3).Return(todo, nil)
+				mockRepository.EXPECT().GetBoardById(gomock.Any(), 2).Return(nil, sql.ErrNoRows)
+				mockRepository.EXPECT().RestoreTodo(gomock.Any(), 3).Return(nil)
+				mockTodoRepository.EXPECT().GetById(gomock.Any(), 3).Return(&entities.Todo{Id: 3, BoardId: 2}, nil)
+				expectCreatedEvents(mockOutboxRepository, &entities.Todo{Id: 3, BoardId: 2})
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to restore todo - Due to the todo is not in the trash",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetTodoById(gomock.Any(), 3).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name: "Failed to restore todo - Due to the board is in the trash",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetTodoById(gomock.Any(), 3).Return(todo, nil)
+				mockRepository.EXPECT().GetBoardById(gomock.Any(), 2).Return(&entities.Board{Id: 2, DeletedAt: &deletedAt}, nil)
+			},
+			expectedError: entities.ErrTrashParentDeleted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := service.RestoreTodo(context.Background(), 3)
+
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
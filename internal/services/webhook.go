@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type WebhookService struct {
+	repo     interfaces.WebhookRepository
+	roomRepo interfaces.RoomRepository
+	sender   interfaces.WebhookSender
+	uow      interfaces.UnitOfWork
+	now      func() time.Time
+}
+
+func NewWebhookService(repo interfaces.WebhookRepository, roomRepo interfaces.RoomRepository, sender interfaces.WebhookSender, uow interfaces.UnitOfWork) *WebhookService {
+	return &WebhookService{
+		repo:     repo,
+		roomRepo: roomRepo,
+		sender:   sender,
+		uow:      uow,
+		now:      time.Now,
+	}
+}
+
+func (ws *WebhookService) GetAll(ctx context.Context, roomId int) ([]*entities.Webhook, error) {
+	return ws.repo.GetAllByRoomId(ctx, roomId)
+}
+
+// GetById reports webhooks of other rooms as missing.
+func (ws *WebhookService) GetById(ctx context.Context, roomId, id int) (*entities.Webhook, error) {
+	webhook, err := ws.repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if webhook.RoomId != roomId {
+		return nil, sql.ErrNoRows
+	}
+
+	return webhook, nil
+}
+
+func (ws *WebhookService) Create(ctx context.Context, roomId int, url, secret string, eventTypes []string) (*entities.Webhook, error) {
+	webhook := entities.NewWebhook(roomId, url, secret, eventTypes)
+	if err := webhook.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := ws.roomRepo.GetById(ctx, roomId); err != nil {
+		return nil, err
+	}
+
+	err := ws.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Webhooks.Create(ctx, webhook); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityWebhook, webhook.Id, entities.AuditActionCreate, nil, webhook)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// Update keeps the secret when none is given. Enabling a disabled webhook gives it a fresh
+// run of attempts; the deliveries given up meanwhile are not sent again.
+func (ws *WebhookService) Update(ctx context.Context, roomId, id int, url, secret string, eventTypes []string, enabled bool) (*entities.Webhook, error) {
+	webhook, err := ws.GetById(ctx, roomId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *webhook
+	webhook.UpdateAttributes(url, secret, eventTypes, enabled, ws.now().UTC())
+	if err := webhook.Validate(); err != nil {
+		return nil, err
+	}
+
+	err = ws.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Webhooks.Update(ctx, webhook); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityWebhook, webhook.Id, entities.AuditActionUpdate, &before, webhook)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// Delete drops the webhook's delivery log along with it.
+func (ws *WebhookService) Delete(ctx context.Context, roomId, id int) error {
+	webhook, err := ws.GetById(ctx, roomId, id)
+	if err != nil {
+		return err
+	}
+
+	return ws.uow.Do(ctx, func(repos *interfaces.Repositories) error {
+		if err := repos.Webhooks.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return audit(ctx, repos.Audits, entities.AuditEntityWebhook, id, entities.AuditActionDelete, webhook, nil)
+	})
+}
+
+func (ws *WebhookService) GetDeliveries(ctx context.Context, roomId int, filter *entities.WebhookDeliveryFilter) ([]*entities.WebhookDelivery, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := ws.GetById(ctx, roomId, filter.WebhookId); err != nil {
+		return nil, err
+	}
+
+	return ws.repo.GetDeliveries(ctx, filter)
+}
+
+// SendTest also works for disabled webhooks, so that a receiver can be checked before the
+// webhook is enabled again. Its outcome does not count towards disabling the webhook.
+func (ws *WebhookService) SendTest(ctx context.Context, roomId, id int) (*entities.WebhookDelivery, error) {
+	webhook, err := ws.GetById(ctx, roomId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := &entities.DueWebhookDelivery{
+		WebhookDelivery: entities.WebhookDelivery{
+			WebhookId: webhook.Id,
+			EventType: entities.WebhookEventTest,
+			Payload:   []byte("{}"),
+		},
+		RoomId: webhook.RoomId,
+		URL:    webhook.URL,
+		Secret: webhook.Secret,
+	}
+	if err := ws.repo.CreateDelivery(ctx, &delivery.WebhookDelivery, ws.now().UTC()); err != nil {
+		return nil, err
+	}
+
+	statusCode, sendErr := ws.sender.Send(ctx, delivery)
+	delivery.RecordAttempt(statusCode, sendErr, ws.now().UTC(), nil)
+	// The outcome is recorded even when the request timed out meanwhile, so that the delivery
+	// does not stay claimed.
+	if err := ws.repo.RecordAttempt(context.WithoutCancel(ctx), &delivery.WebhookDelivery); err != nil {
+		return nil, err
+	}
+
+	return &delivery.WebhookDelivery, nil
+}
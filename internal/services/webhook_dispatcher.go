@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+)
+
+type WebhookDispatcher struct {
+	repo   interfaces.WebhookRepository
+	sender interfaces.WebhookSender
+	cfg    config.Webhook
+	now    func() time.Time
+}
+
+func NewWebhookDispatcher(repo interfaces.WebhookRepository, sender interfaces.WebhookSender, cfg config.Webhook) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		repo:   repo,
+		sender: sender,
+		cfg:    cfg,
+		now:    time.Now,
+	}
+}
+
+// Run dispatches due deliveries every poll interval until ctx is canceled. A dispatch in
+// flight is not canceled with ctx, so that the outcomes of sent deliveries are still recorded.
+func (wd *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(wd.cfg.PollInterval)
+	defer ticker.Stop()
+
+	dispatchCtx := context.WithoutCancel(ctx)
+	for {
+		if err := wd.DispatchDue(dispatchCtx); err != nil {
+			log.Printf("failed to dispatch webhooks: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue claims one batch of due deliveries and sends them on cfg.Workers workers, so
+// that a slow receiver holds up no more than one of them.
+func (wd *WebhookDispatcher) DispatchDue(ctx context.Context) error {
+	now := wd.now().UTC()
+
+	deliveries, err := wd.repo.ClaimDue(ctx, now, now.Add(-wd.cfg.ClaimTimeout), wd.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	queue := make(chan *entities.DueWebhookDelivery)
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	for range max(wd.cfg.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for delivery := range queue {
+				if err := wd.deliver(ctx, delivery); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, delivery := range deliveries {
+		queue <- delivery
+	}
+	close(queue)
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// deliver sends the delivery and schedules the next attempt with exponential backoff when
+// it fails, until cfg.MaxAttempts is reached. Failures count towards disabling the webhook.
+func (wd *WebhookDispatcher) deliver(ctx context.Context, delivery *entities.DueWebhookDelivery) error {
+	statusCode, sendErr := wd.sender.Send(ctx, delivery)
+	now := wd.now().UTC()
+
+	var retryAt *time.Time
+	if sendErr != nil {
+		log.Printf("failed to send webhook delivery %d: %v", delivery.Id, sendErr)
+		if attempts := delivery.Attempts + 1; attempts < wd.cfg.MaxAttempts {
+			at := now.Add(entities.WebhookRetryDelay(attempts, wd.cfg.RetryBaseDelay, wd.cfg.RetryMaxDelay))
+			retryAt = &at
+		}
+	}
+
+	delivery.RecordAttempt(statusCode, sendErr, now, retryAt)
+	if err := wd.repo.RecordAttempt(ctx, &delivery.WebhookDelivery); err != nil {
+		return err
+	}
+
+	return wd.repo.RecordResult(ctx, delivery.WebhookId, sendErr == nil, wd.cfg.DisableAfter, now)
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/config"
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDispatchDueWebhooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mock_repository.NewMockWebhookRepository(ctrl)
+	mockSender := mock_repository.NewMockWebhookSender(ctrl)
+
+	now := time.Date(2025, 9, 6, 9, 0, 0, 0, time.UTC)
+	cfg := config.Webhook{
+		BatchSize:      10,
+		Workers:        2,
+		ClaimTimeout:   5 * time.Minute,
+		MaxAttempts:    3,
+		RetryBaseDelay: 30 * time.Second,
+		RetryMaxDelay:  time.Hour,
+		DisableAfter:   20,
+	}
+	dispatcher := NewWebhookDispatcher(mockRepository, mockSender, cfg)
+	dispatcher.now = func() time.Time { return now }
+
+	newDelivery := func(id, attempts int) *entities.DueWebhookDelivery {
+		return &entities.DueWebhookDelivery{
+			WebhookDelivery: entities.WebhookDelivery{Id: id, WebhookId: 10 + id, EventType: entities.EventTodoUpdated, Attempts: attempts},
+			URL:             "https://example.com/hook",
+			Secret:          "secret",
+		}
+	}
+	// recorded matches the outcome of the delivery's attempt.
+	recorded := func(id int, status string, nextAttemptAt *time.Time) gomock.Matcher {
+		return gomock.Cond(func(d *entities.WebhookDelivery) bool {
+			return d.Id == id && d.Status() == status && assert.ObjectsAreEqual(nextAttemptAt, d.NextAttemptAt)
+		})
+	}
+	retryAt := now.Add(30 * time.Second)
+
+	testCases := []struct {
+		name          string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name: "Success to record delivered and retried deliveries",
+			mockSetup: func() {
+				delivered, retried := newDelivery(1, 0), newDelivery(2, 0)
+				mockRepository.EXPECT().ClaimDue(gomock.Any(), now, now.Add(-5*time.Minute), 10).
+					Return([]*entities.DueWebhookDelivery{delivered, retried}, nil)
+				mockSender.EXPECT().Send(gomock.Any(), delivered).Return(http.StatusOK, nil)
+				mockRepository.EXPECT().RecordAttempt(gomock.Any(), recorded(1, entities.WebhookDeliveryDelivered, nil)).Return(nil)
+				mockRepository.EXPECT().RecordResult(gomock.Any(), 11, true, 20, now).Return(nil)
+				mockSender.EXPECT().Send(gomock.Any(), retried).Return(http.StatusInternalServerError, errors.New("webhook responded with 500"))
+				mockRepository.EXPECT().RecordAttempt(gomock.Any(), recorded(2, entities.WebhookDeliveryPending, &retryAt)).Return(nil)
+				mockRepository.EXPECT().RecordResult(gomock.Any(), 12, false, 20, now).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success to give up a delivery after the last attempt",
+			mockSetup: func() {
+				delivery := newDelivery(1, 2)
+				mockRepository.EXPECT().ClaimDue(gomock.Any(), now, now.Add(-5*time.Minute), 10).
+					Return([]*entities.DueWebhookDelivery{delivery}, nil)
+				mockSender.EXPECT().Send(gomock.Any(), delivery).Return(0, errors.New("connection refused"))
+				mockRepository.EXPECT().RecordAttempt(gomock.Any(), recorded(1, entities.WebhookDeliveryFailed, nil)).Return(nil)
+				mockRepository.EXPECT().RecordResult(gomock.Any(), 11, false, 20, now).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed to dispatch - Due to claim error",
+			mockSetup: func() {
+				mockRepository.EXPECT().ClaimDue(gomock.Any(), now, now.Add(-5*time.Minute), 10).
+					Return(nil, errors.New("deadlock"))
+			},
+			expectedError: errors.New("deadlock"),
+		},
+		{
+			name: "Failed to dispatch - Due to the outcome not being recorded",
+			mockSetup: func() {
+				delivery := newDelivery(1, 0)
+				mockRepository.EXPECT().ClaimDue(gomock.Any(), now, now.Add(-5*time.Minute), 10).
+					Return([]*entities.DueWebhookDelivery{delivery}, nil)
+				mockSender.EXPECT().Send(gomock.Any(), delivery).Return(http.StatusOK, nil)
+				mockRepository.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := dispatcher.DispatchDue(context.Background())
+
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
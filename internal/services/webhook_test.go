@@ -0,0 +1,266 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rm-ryou/sample_todo_app/internal/entities"
+	"github.com/rm-ryou/sample_todo_app/internal/interfaces"
+	mock_repository "github.com/rm-ryou/sample_todo_app/internal/interfaces/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestWebhookService(ctrl *gomock.Controller) (*WebhookService, *mock_repository.MockWebhookRepository, *mock_repository.MockRoomRepository, *mock_repository.MockWebhookSender) {
+	mockRepository := mock_repository.NewMockWebhookRepository(ctrl)
+	mockRoomRepository := mock_repository.NewMockRoomRepository(ctrl)
+	mockSender := mock_repository.NewMockWebhookSender(ctrl)
+	service := NewWebhookService(mockRepository, mockRoomRepository, mockSender, newAuditedUnitOfWork(ctrl, &interfaces.Repositories{Webhooks: mockRepository}))
+	service.now = func() time.Time { return testNow }
+
+	return service, mockRepository, mockRoomRepository, mockSender
+}
+
+func TestCreateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, mockRoomRepository, _ := newTestWebhookService(ctrl)
+
+	testCases := []struct {
+		name          string
+		url           string
+		eventTypes    []string
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:       "Success to create webhook",
+			url:        "https://example.com/hook",
+			eventTypes: []string{entities.EventTodoCreated},
+			mockSetup: func() {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Room{Id: 1}, nil)
+				mockRepository.EXPECT().Create(gomock.Any(), &entities.Webhook{
+					RoomId:     1,
+					URL:        "https://example.com/hook",
+					Secret:     "secret",
+					EventTypes: []string{entities.EventTodoCreated},
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to create webhook - Due to a URL without host",
+			url:           "https:///hook",
+			eventTypes:    []string{entities.EventTodoCreated},
+			mockSetup:     func() {},
+			expectedError: entities.ErrInvalidWebhookURL,
+		},
+		{
+			name:          "Failed to create webhook - Due to the test event type",
+			url:           "https://example.com/hook",
+			eventTypes:    []string{entities.WebhookEventTest},
+			mockSetup:     func() {},
+			expectedError: entities.ErrInvalidWebhookEventTypes,
+		},
+		{
+			name:       "Failed to create webhook - Due to missing room",
+			url:        "https://example.com/hook",
+			eventTypes: []string{entities.EventTodoCreated},
+			mockSetup: func() {
+				mockRoomRepository.EXPECT().GetById(gomock.Any(), 1).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			_, err := service.Create(context.Background(), 1, tc.url, "secret", tc.eventTypes)
+
+			assert.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, _, _ := newTestWebhookService(ctrl)
+
+	disabled := func() *entities.Webhook {
+		return &entities.Webhook{Id: 1, RoomId: 1, URL: "https://example.com/hook", Secret: "secret", EventTypes: []string{entities.EventTodoCreated}, FailureCount: 20, DisabledAt: &testNow}
+	}
+
+	testCases := []struct {
+		name          string
+		roomId        int
+		enabled       bool
+		mockSetup     func()
+		expected      *entities.Webhook
+		expectedError error
+	}{
+		{
+			name:    "Success to enable webhook with a fresh run of attempts",
+			roomId:  1,
+			enabled: true,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(disabled(), nil)
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expected:      &entities.Webhook{Id: 1, RoomId: 1, URL: "https://example.com/new", Secret: "secret", EventTypes: []string{entities.EventTodoDeleted}},
+			expectedError: nil,
+		},
+		{
+			name:    "Success to keep webhook disabled",
+			roomId:  1,
+			enabled: false,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(disabled(), nil)
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expected:      &entities.Webhook{Id: 1, RoomId: 1, URL: "https://example.com/new", Secret: "secret", EventTypes: []string{entities.EventTodoDeleted}, FailureCount: 20, DisabledAt: &testNow},
+			expectedError: nil,
+		},
+		{
+			name:    "Failed to update webhook - Due to the webhook belonging to another room",
+			roomId:  2,
+			enabled: true,
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(disabled(), nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			webhook, err := service.Update(context.Background(), tc.roomId, 1, "https://example.com/new", "", []string{entities.EventTodoDeleted}, tc.enabled)
+
+			assert.ErrorIs(t, err, tc.expectedError)
+			assert.Equal(t, tc.expected, webhook)
+		})
+	}
+}
+
+func TestGetWebhookDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, _, _ := newTestWebhookService(ctrl)
+
+	testCases := []struct {
+		name          string
+		filter        *entities.WebhookDeliveryFilter
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:   "Success to get deliveries with the default limit",
+			filter: &entities.WebhookDeliveryFilter{WebhookId: 1},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Webhook{Id: 1, RoomId: 1}, nil)
+				mockRepository.EXPECT().GetDeliveries(gomock.Any(), &entities.WebhookDeliveryFilter{WebhookId: 1, Limit: 50}).Return([]*entities.WebhookDelivery{{Id: 1}}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Failed to get deliveries - Due to a limit above the maximum",
+			filter:        &entities.WebhookDeliveryFilter{WebhookId: 1, Limit: 201},
+			mockSetup:     func() {},
+			expectedError: entities.ErrInvalidWebhookDeliveryFilter,
+		},
+		{
+			name:   "Failed to get deliveries - Due to the webhook belonging to another room",
+			filter: &entities.WebhookDeliveryFilter{WebhookId: 1},
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(&entities.Webhook{Id: 1, RoomId: 2}, nil)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			_, err := service.GetDeliveries(context.Background(), 1, tc.filter)
+
+			assert.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestSendTestWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepository, _, mockSender := newTestWebhookService(ctrl)
+	webhook := &entities.Webhook{Id: 1, RoomId: 1, URL: "https://example.com/hook", Secret: "secret", DisabledAt: &testNow}
+
+	testCases := []struct {
+		name           string
+		mockSetup      func()
+		expectedStatus string
+		expectedError  error
+	}{
+		{
+			name: "Success to send test event to a disabled webhook",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(webhook, nil)
+				mockRepository.EXPECT().CreateDelivery(gomock.Any(), gomock.Any(), testNow).
+					DoAndReturn(func(ctx context.Context, delivery *entities.WebhookDelivery, claimedAt time.Time) error {
+						delivery.Id = 5
+						return nil
+					})
+				mockSender.EXPECT().Send(gomock.Any(), gomock.Cond(func(d *entities.DueWebhookDelivery) bool {
+					return d.Id == 5 && d.EventType == entities.WebhookEventTest && d.URL == webhook.URL && d.Secret == webhook.Secret
+				})).Return(http.StatusNoContent, nil)
+				mockRepository.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus: entities.WebhookDeliveryDelivered,
+			expectedError:  nil,
+		},
+		{
+			name: "Success to record a failed test event without retrying it",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(webhook, nil)
+				mockRepository.EXPECT().CreateDelivery(gomock.Any(), gomock.Any(), testNow).Return(nil)
+				mockSender.EXPECT().Send(gomock.Any(), gomock.Any()).Return(http.StatusGone, errors.New("webhook responded with 410 Gone"))
+				mockRepository.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus: entities.WebhookDeliveryFailed,
+			expectedError:  nil,
+		},
+		{
+			name: "Failed to send test event - Due to missing webhook",
+			mockSetup: func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(nil, sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			delivery, err := service.SendTest(context.Background(), 1, 1)
+
+			assert.ErrorIs(t, err, tc.expectedError)
+			if tc.expectedError == nil {
+				assert.Equal(t, tc.expectedStatus, delivery.Status())
+				assert.Equal(t, 1, delivery.Attempts)
+			}
+		})
+	}
+}
@@ -2,9 +2,14 @@
 CREATE TABLE IF NOT EXISTS `rooms` (
   `id` INT NOT NULL AUTO_INCREMENT,
   `name` VARCHAR(50) NOT NULL,
+  `archived_at` DATETIME,
   `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-  PRIMARY KEY (`id`)
+  `deleted_at` DATETIME,
+  `change_seq` BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  INDEX `idx_deleted_at` (`deleted_at`),
+  INDEX `idx_change_seq` (`change_seq`)
 ) ENGINE=INNODB;
 
 -- Create boards table
@@ -13,23 +18,304 @@ CREATE TABLE IF NOT EXISTS `boards` (
   `room_id` INT NOT NULL,
   `name` VARCHAR(50) NOT NULL,
   `priority` INT NOT NULL DEFAULT 0,
+  `position` INT NOT NULL DEFAULT 0,
+  `archived_at` DATETIME,
   `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  `deleted_at` DATETIME,
+  `deleted_by_cascade` BOOLEAN NOT NULL DEFAULT FALSE,
+  `change_seq` BIGINT NOT NULL DEFAULT 0,
   PRIMARY KEY (`id`),
+  INDEX `idx_deleted_at` (`deleted_at`),
+  INDEX `idx_change_seq` (`change_seq`),
+  INDEX `idx_room_id_position` (`room_id`, `position`),
   FOREIGN KEY (`room_id`) REFERENCES rooms(`id`) ON DELETE CASCADE
 ) ENGINE=INNODB;
 
+-- Create statuses table
+CREATE TABLE IF NOT EXISTS `statuses` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `room_id` INT NOT NULL,
+  `name` VARCHAR(30) NOT NULL,
+  `category` ENUM('todo', 'doing', 'done') NOT NULL,
+  `position` INT NOT NULL DEFAULT 0,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_room_id_position` (`room_id`, `position`),
+  FOREIGN KEY (`room_id`) REFERENCES rooms(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create status_transitions table
+CREATE TABLE IF NOT EXISTS `status_transitions` (
+  `from_status_id` INT NOT NULL,
+  `to_status_id` INT NOT NULL,
+  PRIMARY KEY (`from_status_id`, `to_status_id`),
+  FOREIGN KEY (`from_status_id`) REFERENCES statuses(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`to_status_id`) REFERENCES statuses(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
 -- Create todos table
 CREATE TABLE IF NOT EXISTS `todos` (
   `id` INT NOT NULL AUTO_INCREMENT,
   `board_id` INT NOT NULL,
   `title` VARCHAR(50) NOT NULL,
-  `done` BOOLEAN NOT NULL DEFAULT false,
+  `status_id` INT NOT NULL,
   `priority` INT NOT NULL DEFAULT 0,
+  `rank` VARCHAR(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
   `due_date` DATETIME,
+  `completed_at` DATETIME,
+  `recurrence_rule` VARCHAR(255),
+  `recurrence_timezone` VARCHAR(64),
   `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  `deleted_at` DATETIME,
+  `deleted_by_cascade` BOOLEAN NOT NULL DEFAULT FALSE,
+  `change_seq` BIGINT NOT NULL DEFAULT 0,
   PRIMARY KEY (`id`),
+  INDEX `idx_deleted_at` (`deleted_at`),
+  INDEX `idx_change_seq` (`change_seq`),
   INDEX `idx_board_id` (`board_id`),
+  INDEX `idx_status_id` (`status_id`),
+  INDEX `idx_board_id_rank` (`board_id`, `rank`),
+  FOREIGN KEY (`board_id`) REFERENCES boards(`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_todos_status_id` FOREIGN KEY (`status_id`) REFERENCES statuses(`id`) ON DELETE RESTRICT
+) ENGINE=INNODB;
+
+-- Create checklist_items table
+CREATE TABLE IF NOT EXISTS `checklist_items` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `todo_id` INT NOT NULL,
+  `text` VARCHAR(100) NOT NULL,
+  `checked` BOOLEAN NOT NULL DEFAULT false,
+  `position` INT NOT NULL DEFAULT 0,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_todo_id_position` (`todo_id`, `position`),
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create todo_dependencies table
+CREATE TABLE IF NOT EXISTS `todo_dependencies` (
+  `todo_id` INT NOT NULL,
+  `blocker_id` INT NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`todo_id`, `blocker_id`),
+  INDEX `idx_blocker_id` (`blocker_id`),
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`blocker_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create reminders table
+CREATE TABLE IF NOT EXISTS `reminders` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `todo_id` INT NOT NULL,
+  `remind_at` DATETIME,
+  `offset_minutes` INT,
+  `channel` VARCHAR(20) NOT NULL DEFAULT 'log',
+  `claimed_at` DATETIME,
+  `sent_at` DATETIME,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_todo_id` (`todo_id`),
+  INDEX `idx_sent_at_remind_at` (`sent_at`, `remind_at`),
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create idempotency_keys table
+CREATE TABLE IF NOT EXISTS `idempotency_keys` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `user_id` VARCHAR(64) NOT NULL DEFAULT '',
+  `idempotency_key` VARCHAR(255) NOT NULL,
+  `request_hash` CHAR(64) NOT NULL,
+  `status_code` INT,
+  `response_headers` JSON,
+  `response_body` MEDIUMBLOB,
+  `locked_until` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `expires_at` DATETIME NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_user_id_idempotency_key` (`user_id`, `idempotency_key`),
+  INDEX `idx_expires_at` (`expires_at`)
+) ENGINE=INNODB;
+
+-- Create audit_events table
+CREATE TABLE IF NOT EXISTS `audit_events` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `actor` VARCHAR(64) NOT NULL DEFAULT '',
+  `entity_type` VARCHAR(20) NOT NULL,
+  `entity_id` INT NOT NULL,
+  `action` VARCHAR(10) NOT NULL,
+  `before` JSON,
+  `after` JSON,
+  `request_id` VARCHAR(64) NOT NULL DEFAULT '',
+  `ip` VARCHAR(45) NOT NULL DEFAULT '',
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_entity_type_entity_id` (`entity_type`, `entity_id`),
+  INDEX `idx_actor` (`actor`),
+  INDEX `idx_created_at` (`created_at`)
+) ENGINE=INNODB;
+
+-- Create todo_revisions table
+CREATE TABLE IF NOT EXISTS `todo_revisions` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `todo_id` INT NOT NULL,
+  `version` INT NOT NULL,
+  `title` VARCHAR(50) NOT NULL,
+  `status_id` INT NOT NULL,
+  `priority` INT NOT NULL,
+  `due_date` DATETIME,
+  `recurrence_rule` VARCHAR(255),
+  `recurrence_timezone` VARCHAR(64),
+  `actor` VARCHAR(64) NOT NULL DEFAULT '',
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_todo_id_version` (`todo_id`, `version`),
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create activities table
+CREATE TABLE IF NOT EXISTS `activities` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `room_id` INT NOT NULL,
+  `board_id` INT,
+  `todo_id` INT,
+  `from_board_id` INT,
+  `kind` VARCHAR(20) NOT NULL,
+  `actor` VARCHAR(64) NOT NULL DEFAULT '',
+  `title` VARCHAR(50) NOT NULL DEFAULT '',
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_room_id_id` (`room_id`, `id`),
+  FOREIGN KEY (`room_id`) REFERENCES rooms(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create feed_tokens table
+CREATE TABLE IF NOT EXISTS `feed_tokens` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `actor` VARCHAR(64) NOT NULL,
+  `token_hash` CHAR(64) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_actor` (`actor`),
+  UNIQUE INDEX `uk_token_hash` (`token_hash`)
+) ENGINE=INNODB;
+
+-- Create comments table
+CREATE TABLE IF NOT EXISTS `comments` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `todo_id` INT NOT NULL,
+  `author` VARCHAR(64) NOT NULL,
+  `body` TEXT NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_todo_id_id` (`todo_id`, `id`),
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create room_members table
+CREATE TABLE IF NOT EXISTS `room_members` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `room_id` INT NOT NULL,
+  `actor` VARCHAR(64) NOT NULL,
+  `joined_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_room_id_actor` (`room_id`, `actor`),
+  FOREIGN KEY (`room_id`) REFERENCES rooms(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create event_outbox table
+CREATE TABLE IF NOT EXISTS `event_outbox` (
+  `id` BIGINT NOT NULL,
+  `room_id` INT NOT NULL,
+  `type` VARCHAR(32) NOT NULL,
+  `payload` JSON NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`)
+) ENGINE=INNODB;
+
+-- Create event_relays table
+CREATE TABLE IF NOT EXISTS `event_relays` (
+  `id` CHAR(32) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+  `last_event_id` BIGINT NOT NULL,
+  `seen_at` DATETIME NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=INNODB;
+
+-- Create webhooks table
+CREATE TABLE IF NOT EXISTS `webhooks` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `room_id` INT NOT NULL,
+  `url` VARCHAR(2048) NOT NULL,
+  `secret` VARCHAR(255) NOT NULL,
+  `event_types` JSON NOT NULL,
+  `failure_count` INT NOT NULL DEFAULT 0,
+  `disabled_at` DATETIME,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`room_id`) REFERENCES rooms(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create webhook_deliveries table
+CREATE TABLE IF NOT EXISTS `webhook_deliveries` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `webhook_id` INT NOT NULL,
+  `event_id` BIGINT NOT NULL DEFAULT 0,
+  `event_type` VARCHAR(32) NOT NULL,
+  `payload` JSON NOT NULL,
+  `attempts` INT NOT NULL DEFAULT 0,
+  `status_code` INT,
+  `last_error` VARCHAR(255) NOT NULL DEFAULT '',
+  `next_attempt_at` DATETIME,
+  `claimed_at` DATETIME,
+  `delivered_at` DATETIME,
+  `failed_at` DATETIME,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_webhook_id_id` (`webhook_id`, `id`),
+  INDEX `idx_next_attempt_at` (`next_attempt_at`),
+  FOREIGN KEY (`webhook_id`) REFERENCES webhooks(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create incoming_webhooks table
+CREATE TABLE IF NOT EXISTS `incoming_webhooks` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `board_id` INT NOT NULL,
+  `token_hash` CHAR(64) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_token_hash` (`token_hash`),
   FOREIGN KEY (`board_id`) REFERENCES boards(`id`) ON DELETE CASCADE
 ) ENGINE=INNODB;
+
+-- Create incoming_webhook_keys table
+CREATE TABLE IF NOT EXISTS `incoming_webhook_keys` (
+  `incoming_webhook_id` INT NOT NULL,
+  `external_key` VARCHAR(255) NOT NULL,
+  `todo_id` INT,
+  PRIMARY KEY (`incoming_webhook_id`, `external_key`),
+  FOREIGN KEY (`incoming_webhook_id`) REFERENCES incoming_webhooks(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`todo_id`) REFERENCES todos(`id`) ON DELETE CASCADE
+) ENGINE=INNODB;
+
+-- Create change_sequence table
+CREATE TABLE IF NOT EXISTS `change_sequence` (
+  `id` TINYINT NOT NULL,
+  `value` BIGINT NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=INNODB;
+
+INSERT INTO `change_sequence` (`id`, `value`) VALUES (1, 1);
+
+-- Create tombstones table
+CREATE TABLE IF NOT EXISTS `tombstones` (
+  `entity_type` VARCHAR(20) NOT NULL,
+  `entity_id` INT NOT NULL,
+  `change_seq` BIGINT NOT NULL,
+  PRIMARY KEY (`entity_type`, `entity_id`),
+  INDEX `idx_change_seq` (`change_seq`)
+) ENGINE=INNODB;